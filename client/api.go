@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 )
 
 var (
@@ -79,6 +80,16 @@ func (c *Client) ListDatasetsIdNameOnly(ctx context.Context) ([]*meta.DatasetIdN
 	return c.Meta.ListDatasetsIdNameOnly(ctx)
 }
 
+// ListDatasetInputs returns the input datasets of every dataset in a workspace
+func (c *Client) ListDatasetInputs(ctx context.Context, workspaceId string) ([]meta.DatasetInputs, error) {
+	return c.Meta.ListDatasetInputs(ctx, workspaceId)
+}
+
+// GetDatasetDoctorReport returns the doctor report for a dataset
+func (c *Client) GetDatasetDoctorReport(ctx context.Context, datasetId string, upLevels int) ([]meta.DatasetReport, error) {
+	return c.Meta.GetDatasetDoctorReport(ctx, datasetId, upLevels)
+}
+
 // UpdateSourceDataset updates the existing source dataset
 func (c *Client) UpdateSourceDataset(ctx context.Context, workspaceId string, id string, dataset *meta.DatasetDefinitionInput, table *meta.SourceTableDefinitionInput) (*meta.Dataset, error) {
 	if !c.Flags[flagObs2110] {
@@ -404,6 +415,16 @@ func (c *Client) Query(ctx context.Context, stages []*meta.StageInput, params *m
 	return c.Meta.DatasetQueryOutput(ctx, stages, params)
 }
 
+// CheckQueries compiles a query without running it
+func (c *Client) CheckQueries(ctx context.Context, query *meta.MultiStageQueryInput) ([]meta.ParsedPipeline, error) {
+	return c.Meta.CheckQueries(ctx, query)
+}
+
+// EstimateRematerializationCost estimates the cost of rematerializing datasets
+func (c *Client) EstimateRematerializationCost(ctx context.Context, datasetIds ...string) ([]meta.DatasetCostEstimate, error) {
+	return c.Meta.EstimateRematerializationCost(ctx, datasetIds...)
+}
+
 // CreateMonitorAction creates a monitor action
 func (c *Client) CreateMonitorAction(ctx context.Context, input *meta.MonitorActionInput) (*meta.MonitorAction, error) {
 	if !c.Flags[flagObs2110] {
@@ -578,6 +599,482 @@ func (c *Client) SearchMonitorV2Action(ctx context.Context, workspaceId *string,
 	return c.Meta.SearchMonitorV2Action(ctx, workspaceId, nameExact)
 }
 
+func (c *Client) ListMonitorV2(ctx context.Context, workspaceId *string) ([]meta.MonitorV2, error) {
+	return c.Meta.ListMonitorV2(ctx, workspaceId)
+}
+
+func (c *Client) ListMonitorV2Actions(ctx context.Context, workspaceId *string) ([]meta.MonitorV2Action, error) {
+	return c.Meta.ListMonitorV2Actions(ctx, workspaceId)
+}
+
+// CreateMonitorV2MuteRule creates a monitor v2 mute rule
+func (c *Client) CreateMonitorV2MuteRule(ctx context.Context, workspaceId string, input *meta.MonitorV2MuteRuleInput) (*meta.MonitorV2MuteRule, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateMonitorV2MuteRule(ctx, workspaceId, input)
+}
+
+// UpdateMonitorV2MuteRule updates a monitor v2 mute rule
+func (c *Client) UpdateMonitorV2MuteRule(ctx context.Context, id string, input *meta.MonitorV2MuteRuleInput) (*meta.MonitorV2MuteRule, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateMonitorV2MuteRule(ctx, id, input)
+}
+
+// DeleteMonitorV2MuteRule deletes a monitor v2 mute rule
+func (c *Client) DeleteMonitorV2MuteRule(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteMonitorV2MuteRule(ctx, id)
+}
+
+func (c *Client) SearchMonitorV2Alarms(ctx context.Context, workspaceId string, monitorIds []string, minTime *types.TimeScalar, maxTime *types.TimeScalar, levels []meta.MonitorV2AlarmLevel, active *bool) ([]meta.MonitorV2Alarm, error) {
+	return c.Meta.SearchMonitorV2Alarms(ctx, workspaceId, monitorIds, minTime, maxTime, levels, active)
+}
+
+func (c *Client) GetMonitorV2TemplateDictionary(ctx context.Context, alertType *meta.MonitorV2AlertType, monitorInput *meta.MonitorV2Input, alarmInput *meta.MonitorV2AlarmInput) (types.JsonObject, error) {
+	return c.Meta.GetMonitorV2TemplateDictionary(ctx, alertType, monitorInput, alarmInput)
+}
+
+func (c *Client) RenderMonitorV2Template(ctx context.Context, templateDict types.JsonObject, actionInput *meta.MonitorV2ActionInput) (*meta.MonitorV2RenderedTemplate, error) {
+	return c.Meta.RenderMonitorV2Template(ctx, templateDict, actionInput)
+}
+
+// CreateMonitorMuteRule creates a monitor mute rule
+func (c *Client) CreateMonitorMuteRule(ctx context.Context, input *meta.MonitorMuteRuleInput, monitorIds []string) (*meta.MonitorMuteRule, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateMonitorMuteRule(ctx, input, monitorIds)
+}
+
+// UpdateMonitorMuteRule updates a monitor mute rule
+func (c *Client) UpdateMonitorMuteRule(ctx context.Context, id string, input *meta.MonitorMuteRuleInput, monitorIds []string) (*meta.MonitorMuteRule, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.UpdateMonitorMuteRule(ctx, id, input, monitorIds)
+}
+
+// DeleteMonitorMuteRule deletes a monitor mute rule
+func (c *Client) DeleteMonitorMuteRule(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteMonitorMuteRule(ctx, id)
+}
+
+// GetMonitorMuteRule returns a monitor mute rule by ID
+func (c *Client) GetMonitorMuteRule(ctx context.Context, id string) (*meta.MonitorMuteRule, error) {
+	return c.Meta.GetMonitorMuteRule(ctx, id)
+}
+
+// LookupMonitorMuteRule by name.
+func (c *Client) LookupMonitorMuteRule(ctx context.Context, workspaceId string, name string) (*meta.MonitorMuteRule, error) {
+	return c.Meta.LookupMonitorMuteRule(ctx, workspaceId, name)
+}
+
+// CreateReferenceTable creates a reference table
+func (c *Client) CreateReferenceTable(ctx context.Context, workspaceId string, input *meta.ReferenceTableInput) (*meta.ReferenceTable, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateReferenceTable(ctx, workspaceId, input)
+}
+
+// UpdateReferenceTable updates a reference table
+func (c *Client) UpdateReferenceTable(ctx context.Context, id string, input *meta.ReferenceTableInput) (*meta.ReferenceTable, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.UpdateReferenceTable(ctx, id, input)
+}
+
+// DeleteReferenceTable deletes a reference table
+func (c *Client) DeleteReferenceTable(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteReferenceTable(ctx, id)
+}
+
+// GetReferenceTable returns a reference table by ID
+func (c *Client) GetReferenceTable(ctx context.Context, id string) (*meta.ReferenceTable, error) {
+	return c.Meta.GetReferenceTable(ctx, id)
+}
+
+// LookupReferenceTable by name.
+func (c *Client) LookupReferenceTable(ctx context.Context, workspaceId string, name string) (*meta.ReferenceTable, error) {
+	return c.Meta.LookupReferenceTable(ctx, workspaceId, name)
+}
+
+// CreateInvestigationNotebook creates an investigation notebook
+func (c *Client) CreateInvestigationNotebook(ctx context.Context, workspaceId string, input *meta.InvestigationNotebookInput) (*meta.InvestigationNotebook, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateInvestigationNotebook(ctx, workspaceId, input)
+}
+
+// UpdateInvestigationNotebook updates an investigation notebook
+func (c *Client) UpdateInvestigationNotebook(ctx context.Context, id string, input *meta.InvestigationNotebookInput) (*meta.InvestigationNotebook, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.UpdateInvestigationNotebook(ctx, id, input)
+}
+
+// DeleteInvestigationNotebook deletes an investigation notebook
+func (c *Client) DeleteInvestigationNotebook(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteInvestigationNotebook(ctx, id)
+}
+
+// GetInvestigationNotebook returns an investigation notebook by ID
+func (c *Client) GetInvestigationNotebook(ctx context.Context, id string) (*meta.InvestigationNotebook, error) {
+	return c.Meta.GetInvestigationNotebook(ctx, id)
+}
+
+// LookupInvestigationNotebook by name.
+func (c *Client) LookupInvestigationNotebook(ctx context.Context, workspaceId string, name string) (*meta.InvestigationNotebook, error) {
+	return c.Meta.LookupInvestigationNotebook(ctx, workspaceId, name)
+}
+
+// CreateDataConnection creates a data connection
+func (c *Client) CreateDataConnection(ctx context.Context, workspaceId string, input *meta.DataConnectionInput) (*meta.DataConnection, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateDataConnection(ctx, workspaceId, input)
+}
+
+// UpdateDataConnection updates a data connection
+func (c *Client) UpdateDataConnection(ctx context.Context, id string, input *meta.DataConnectionInput) (*meta.DataConnection, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.UpdateDataConnection(ctx, id, input)
+}
+
+// DeleteDataConnection deletes a data connection
+func (c *Client) DeleteDataConnection(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteDataConnection(ctx, id)
+}
+
+// GetDataConnection returns a data connection by ID
+func (c *Client) GetDataConnection(ctx context.Context, id string) (*meta.DataConnection, error) {
+	return c.Meta.GetDataConnection(ctx, id)
+}
+
+// LookupDataConnection by name.
+func (c *Client) LookupDataConnection(ctx context.Context, workspaceId string, name string) (*meta.DataConnection, error) {
+	return c.Meta.LookupDataConnection(ctx, workspaceId, name)
+}
+
+// LookupDataConnectionModuleVersions returns all versions of a data connection module
+func (c *Client) LookupDataConnectionModuleVersions(ctx context.Context, workspaceId string, id string) ([]*meta.ModuleVersion, error) {
+	return c.Meta.LookupDataConnectionModuleVersions(ctx, workspaceId, id)
+}
+
+// CreateDatasource creates a datasource
+func (c *Client) CreateDatasource(ctx context.Context, workspaceId string, input *meta.DatasourceInput) (*meta.Datasource, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateDatasource(ctx, workspaceId, input)
+}
+
+// UpdateDatasource updates a datasource
+func (c *Client) UpdateDatasource(ctx context.Context, id string, input *meta.DatasourceInput) (*meta.Datasource, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.UpdateDatasource(ctx, id, input)
+}
+
+// DeleteDatasource deletes a datasource
+func (c *Client) DeleteDatasource(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteDatasource(ctx, id)
+}
+
+// GetDatasource returns a datasource by ID
+func (c *Client) GetDatasource(ctx context.Context, id string) (*meta.Datasource, error) {
+	return c.Meta.GetDatasource(ctx, id)
+}
+
+// LookupDatasource by name.
+func (c *Client) LookupDatasource(ctx context.Context, workspaceId string, name string) (*meta.Datasource, error) {
+	return c.Meta.LookupDatasource(ctx, workspaceId, name)
+}
+
+// CreateApiToken creates an API token
+func (c *Client) CreateApiToken(ctx context.Context, input *meta.AuthtokenInput, owningUser *types.UserIdScalar) (*meta.ApiToken, string, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.CreateApiToken(ctx, input, owningUser)
+}
+
+// UpdateApiToken updates an API token
+func (c *Client) UpdateApiToken(ctx context.Context, id string, input *meta.AuthtokenInput) (*meta.ApiToken, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateApiToken(ctx, id, input)
+}
+
+// DeleteApiToken deletes an API token
+func (c *Client) DeleteApiToken(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteApiToken(ctx, id)
+}
+
+// GetApiToken returns an API token by ID
+func (c *Client) GetApiToken(ctx context.Context, id string) (*meta.ApiToken, error) {
+	return c.Meta.GetApiToken(ctx, id)
+}
+
+// SearchApiTokens returns all API tokens, optionally restricted to those owned by a user
+func (c *Client) SearchApiTokens(ctx context.Context, user *types.UserIdScalar) ([]meta.ApiToken, error) {
+	return c.Meta.SearchApiTokens(ctx, user)
+}
+
+// CreateIncident creates a incident
+func (c *Client) CreateIncident(ctx context.Context, workspaceId string, input *meta.IncidentInput) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateIncident(ctx, workspaceId, input)
+}
+
+// UpdateIncident updates a incident
+func (c *Client) UpdateIncident(ctx context.Context, id string, input *meta.IncidentInput) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.UpdateIncident(ctx, id, input)
+}
+
+// DeleteIncident deletes a incident
+func (c *Client) DeleteIncident(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteIncident(ctx, id)
+}
+
+// GetIncident returns a incident by ID
+func (c *Client) GetIncident(ctx context.Context, id string) (*meta.Incident, error) {
+	return c.Meta.GetIncident(ctx, id)
+}
+
+// LookupIncident by name.
+func (c *Client) LookupIncident(ctx context.Context, workspaceId string, name string) (*meta.Incident, error) {
+	return c.Meta.LookupIncident(ctx, workspaceId, name)
+}
+
+// GetIncidentsForStatus returns all incidents in a given status
+func (c *Client) GetIncidentsForStatus(ctx context.Context, status meta.IncidentStatus, startingAt *types.TimeScalar, endingAt *types.TimeScalar) ([]meta.Incident, error) {
+	return c.Meta.GetIncidentsForStatus(ctx, status, startingAt, endingAt)
+}
+
+// AddIncidentUsers adds users to an incident
+func (c *Client) AddIncidentUsers(ctx context.Context, id string, users []types.UserIdScalar) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.AddIncidentUsers(ctx, id, users)
+}
+
+// AddIncidentSlackChannels adds slack channels to an incident
+func (c *Client) AddIncidentSlackChannels(ctx context.Context, id string, slackChannels []meta.IncidentSlackchannelInput) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.AddIncidentSlackChannels(ctx, id, slackChannels)
+}
+
+// AddIncidentWorksheets adds worksheets to an incident
+func (c *Client) AddIncidentWorksheets(ctx context.Context, id string, worksheets []string) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.AddIncidentWorksheets(ctx, id, worksheets)
+}
+
+// AddIncidentDashboards adds dashboards to an incident
+func (c *Client) AddIncidentDashboards(ctx context.Context, id string, dashboards []string) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.AddIncidentDashboards(ctx, id, dashboards)
+}
+
+// RemoveIncidentUsers removes users from an incident
+func (c *Client) RemoveIncidentUsers(ctx context.Context, id string, users []types.UserIdScalar) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.RemoveIncidentUsers(ctx, id, users)
+}
+
+// RemoveIncidentSlackChannels removes slack channels from an incident
+func (c *Client) RemoveIncidentSlackChannels(ctx context.Context, id string, slackChannels []meta.IncidentSlackchannelInput) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.RemoveIncidentSlackChannels(ctx, id, slackChannels)
+}
+
+// RemoveIncidentWorksheets removes worksheets from an incident
+func (c *Client) RemoveIncidentWorksheets(ctx context.Context, id string, worksheets []string) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.RemoveIncidentWorksheets(ctx, id, worksheets)
+}
+
+// RemoveIncidentDashboards removes dashboards from an incident
+func (c *Client) RemoveIncidentDashboards(ctx context.Context, id string, dashboards []string) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.RemoveIncidentDashboards(ctx, id, dashboards)
+}
+
+// SearchDatasets returns datasets in a workspace matching any of the provided
+// names and all of the provided correlation tags
+func (c *Client) SearchDatasets(ctx context.Context, workspaceId string, labelMatches []string, correlationTags []string) ([]meta.SearchedObject, error) {
+	return c.Meta.SearchDatasets(ctx, workspaceId, labelMatches, correlationTags)
+}
+
+// SearchMetrics returns metrics in a workspace matching the search term
+func (c *Client) SearchMetrics(ctx context.Context, workspaceId string, match string, correlationTags []string) ([]meta.SearchedMetric, error) {
+	return c.Meta.SearchMetrics(ctx, workspaceId, match, correlationTags)
+}
+
+// SearchDashboards returns dashboards matching the search terms
+func (c *Client) SearchDashboards(ctx context.Context, terms meta.DWSearchInput) ([]meta.SearchedObject, error) {
+	return c.Meta.SearchDashboards(ctx, terms)
+}
+
+// SearchWorksheets returns worksheets matching the search terms
+func (c *Client) SearchWorksheets(ctx context.Context, terms meta.DWSearchInput) ([]meta.SearchedObject, error) {
+	return c.Meta.SearchWorksheets(ctx, terms)
+}
+
+// CreateAccelerationJob creates an acceleration job
+func (c *Client) CreateAccelerationJob(ctx context.Context, input *meta.AccelerationJobInput) (*meta.AccelerationJob, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.CreateAccelerationJob(ctx, input)
+}
+
+// GetAccelerationJob returns an acceleration job by ID
+func (c *Client) GetAccelerationJob(ctx context.Context, id string) (*meta.AccelerationJob, error) {
+	return c.Meta.GetAccelerationJob(ctx, id)
+}
+
+// GetDatasetAccelerationStatus returns the acceleration status of a dataset
+func (c *Client) GetDatasetAccelerationStatus(ctx context.Context, datasetId string) (*meta.DatasetAccelerationStatus, error) {
+	return c.Meta.GetDatasetAccelerationStatus(ctx, datasetId)
+}
+
+// CancelAccelerationJob cancels an acceleration job
+func (c *Client) CancelAccelerationJob(ctx context.Context, id string) (*meta.AccelerationJob, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.CancelAccelerationJob(ctx, id)
+}
+
 // CreateMonitorActionAttachment creates a monitor action attachment
 func (c *Client) CreateMonitorActionAttachment(ctx context.Context, input *meta.MonitorActionAttachmentInput) (*meta.MonitorActionAttachment, error) {
 	if !c.Flags[flagObs2110] {
@@ -624,6 +1121,11 @@ func (c *Client) LookupMonitor(ctx context.Context, workspaceId string, id strin
 	return c.Meta.LookupMonitor(ctx, workspaceId, id)
 }
 
+// ListMonitors returns all monitors in a workspace
+func (c *Client) ListMonitors(ctx context.Context, workspaceId string) ([]meta.Monitor, error) {
+	return c.Meta.ListMonitors(ctx, workspaceId)
+}
+
 // CreateBoard creates a board
 func (c *Client) CreateBoard(ctx context.Context, dsid string, boardType meta.BoardType, input *meta.BoardInput) (*meta.Board, error) {
 	if !c.Flags[flagObs2110] {
@@ -702,6 +1204,11 @@ func (c *Client) GetPoller(ctx context.Context, id string) (*meta.Poller, error)
 	return c.Meta.GetPoller(ctx, id)
 }
 
+// ListPollers returns all pollers in a workspace
+func (c *Client) ListPollers(ctx context.Context, workspaceId string) ([]meta.Poller, error) {
+	return c.Meta.ListPollers(ctx, workspaceId)
+}
+
 // CreateWorkspace creates a workspace
 func (c *Client) CreateWorkspace(ctx context.Context, input *meta.WorkspaceInput) (*meta.Workspace, error) {
 	if !c.Flags[flagObs2110] {
@@ -766,6 +1273,11 @@ func (c *Client) LookupDatastream(ctx context.Context, workspaceID string, name
 	return c.Meta.LookupDatastream(ctx, workspaceID, name)
 }
 
+// ListDatastreams returns all datastreams in a workspace
+func (c *Client) ListDatastreams(ctx context.Context, workspaceId string) ([]meta.Datastream, error) {
+	return c.Meta.ListDatastreams(ctx, workspaceId)
+}
+
 // CreateDatastreamToken creates a datastream token
 func (c *Client) CreateDatastreamToken(ctx context.Context, datastreamId string, input *meta.DatastreamTokenInput, password *string) (*meta.DatastreamToken, error) {
 	if !c.Flags[flagObs2110] {
@@ -923,6 +1435,15 @@ func (c *Client) UpdateFolder(ctx context.Context, id string, input *meta.Folder
 	return c.Meta.UpdateFolder(ctx, id, input)
 }
 
+// SaveFolderSettings saves the layered settings of a folder
+func (c *Client) SaveFolderSettings(ctx context.Context, workspaceId string, id string, input *meta.LayeredFolderInput) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.SaveFolderSettings(ctx, workspaceId, id, input)
+}
+
 // DeleteFolder
 func (c *Client) DeleteFolder(ctx context.Context, id string) error {
 	if !c.Flags[flagObs2110] {
@@ -1296,6 +1817,11 @@ func (c *Client) GetRbacStatement(ctx context.Context, id string) (*meta.RbacSta
 	return c.Meta.GetRbacStatement(ctx, id)
 }
 
+// ListRbacStatements returns all RBAC statements
+func (c *Client) ListRbacStatements(ctx context.Context) ([]meta.RbacStatement, error) {
+	return c.Meta.ListRbacStatements(ctx)
+}
+
 // CreateFiledrop creates a filedrop
 func (c *Client) CreateFiledrop(ctx context.Context, workspaceId string, datastreamId string, input *meta.FiledropInput) (*meta.Filedrop, error) {
 	if !c.Flags[flagObs2110] {
@@ -1378,6 +1904,10 @@ func (c *Client) GetDatasetOutboundShare(ctx context.Context, id string) (*meta.
 	return c.Meta.GetDatasetOutboundShare(ctx, id)
 }
 
+func (c *Client) ListDatasetOutboundShares(ctx context.Context, workspaceId *string) ([]meta.DatasetOutboundShare, error) {
+	return c.Meta.ListDatasetOutboundShares(ctx, workspaceId)
+}
+
 func (c *Client) CreateDatasetOutboundShare(ctx context.Context, workspaceId string, datasetId string, shareId string, input *meta.DatasetOutboundShareInput) (*meta.DatasetOutboundShare, error) {
 	if !c.Flags[flagObs2110] {
 		c.obs2110.Lock()
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/meta"
 	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	"github.com/observeinc/terraform-provider-observe/client/oid"
 )
@@ -30,14 +31,24 @@ type ResourceCache struct {
 }
 
 func NewResourceCache(ctx context.Context, kinds KindSet, client *observe.Client) (ResourceCache, error) {
-	var cache = ResourceCache{idToLabel: make(map[Ref]ResourceCacheEntry)}
 	// special case: one workspace per customer, always needed for lookup
 	workspaces, err := client.ListWorkspaces(ctx)
 	if err != nil {
-		return cache, err
+		return ResourceCache{idToLabel: make(map[Ref]ResourceCacheEntry)}, err
 	}
-	cache.addEntry(KindWorkspace, workspaces[0].Label, workspaces[0].Oid().String(), nil, make(map[string]struct{}))
-	cache.workspaceOid = workspaces[0].Oid()
+	return newResourceCache(ctx, kinds, client, workspaces[0], false)
+}
+
+// NewWorkspaceResourceCache is like NewResourceCache, but binds the provided
+// workspace, and only caches datasets contained in it
+func NewWorkspaceResourceCache(ctx context.Context, kinds KindSet, client *observe.Client, workspace *meta.Workspace) (ResourceCache, error) {
+	return newResourceCache(ctx, kinds, client, workspace, true)
+}
+
+func newResourceCache(ctx context.Context, kinds KindSet, client *observe.Client, workspace *meta.Workspace, scoped bool) (ResourceCache, error) {
+	var cache = ResourceCache{idToLabel: make(map[Ref]ResourceCacheEntry)}
+	cache.addEntry(KindWorkspace, workspace.Label, workspace.Oid().String(), nil, make(map[string]struct{}))
+	cache.workspaceOid = workspace.Oid()
 	cache.workspaceEntry = cache.LookupId(KindWorkspace, cache.workspaceOid.String())
 
 	for resourceKind := range kinds {
@@ -46,12 +57,22 @@ func NewResourceCache(ctx context.Context, kinds KindSet, client *observe.Client
 		disambiguator := 1
 		switch resourceKind {
 		case KindDataset:
-			datasets, err := client.ListDatasetsIdNameOnly(ctx)
-			if err != nil {
-				return cache, err
-			}
-			for _, ds := range datasets {
-				cache.addEntry(KindDataset, ds.Name, ds.Id, &disambiguator, existingResourceNames)
+			if scoped {
+				datasets, err := client.SearchDatasets(ctx, workspace.Id, nil, nil)
+				if err != nil {
+					return cache, err
+				}
+				for _, ds := range datasets {
+					cache.addEntry(KindDataset, ds.Name, ds.Id, &disambiguator, existingResourceNames)
+				}
+			} else {
+				datasets, err := client.ListDatasetsIdNameOnly(ctx)
+				if err != nil {
+					return cache, err
+				}
+				for _, ds := range datasets {
+					cache.addEntry(KindDataset, ds.Name, ds.Id, &disambiguator, existingResourceNames)
+				}
 			}
 		case KindWorksheet:
 			worksheets, err := client.ListWorksheetIdLabelOnly(ctx, cache.workspaceOid.Id)
@@ -75,7 +96,7 @@ func NewResourceCache(ctx context.Context, kinds KindSet, client *observe.Client
 }
 
 func (c *ResourceCache) addEntry(kind Kind, label string, id string, disambiguator *int, existingNames map[string]struct{}) {
-	resourceName := sanitizeIdentifier(label)
+	resourceName := SanitizeIdentifier(label)
 	if _, found := existingNames[resourceName]; found {
 		resourceName = fmt.Sprintf("%s_%d", resourceName, *disambiguator)
 		*disambiguator++
@@ -96,6 +117,11 @@ func (c *ResourceCache) LookupId(kind Kind, id string) *ResourceCacheEntry {
 	return &maybeEnt
 }
 
+// Remove drops an entry, so that references to the object are no longer bound
+func (c *ResourceCache) Remove(kind Kind, id string) {
+	delete(c.idToLabel, Ref{kind: kind, key: id})
+}
+
 type Generator struct {
 	Enabled         bool
 	resourceType    string
@@ -103,6 +129,7 @@ type Generator struct {
 	enabledBindings KindSet
 	bindings        Mapping
 	cache           ResourceCache
+	references      map[Kind]string
 }
 
 func NewGenerator(ctx context.Context, enabled bool, resourceType string, resourceName string,
@@ -126,6 +153,24 @@ func NewGenerator(ctx context.Context, enabled bool, resourceType string, resour
 	}, nil
 }
 
+// NewReferenceGenerator returns a generator which replaces ids with references
+// to the terraform objects managing them, rather than with local variables.
+// The addresses map each enabled kind to a resource type or data source address,
+// e.g. "observe_dataset" or "data.observe_workspace".
+func NewReferenceGenerator(cache ResourceCache, addresses map[Kind]string) Generator {
+	enabledBindings := make(KindSet)
+	for kind := range addresses {
+		enabledBindings[kind] = struct{}{}
+	}
+	return Generator{
+		Enabled:         true,
+		enabledBindings: enabledBindings,
+		bindings:        NewMapping(),
+		cache:           cache,
+		references:      addresses,
+	}
+}
+
 // lookup by kind and id, if valid and enabled then return a loval variable reference,
 // otherwise return the id (no-op)
 func (g *Generator) TryBind(kind Kind, id string) string {
@@ -143,6 +188,12 @@ func (g *Generator) TryBind(kind Kind, id string) string {
 			return id
 		}
 	}
+	if g.references != nil {
+		if _, ok := g.references[kind]; !ok {
+			return id
+		}
+		return g.fmtTfReference(kind, e.TfName)
+	}
 	// process into local var ref
 	terraformLocal := g.fmtTfLocalVar(kind, e.TfName)
 	g.bindings[Ref{kind: kind, key: e.Label}] = Target{
@@ -227,13 +278,36 @@ func (g *Generator) InsertBindingsObjectJson(jsonData *types.JsonObject) (*types
 }
 
 func (g *Generator) fmtTfLocalVar(kind Kind, targetTfName string) string {
-	return sanitizeIdentifier(fmt.Sprintf("binding__%s_%s__%s_%s", g.resourceType, g.resourceName, kind, targetTfName))
+	return SanitizeIdentifier(fmt.Sprintf("binding__%s_%s__%s_%s", g.resourceType, g.resourceName, kind, targetTfName))
 }
 
 func (g *Generator) fmtTfLocalVarRef(tfLocalVar string) string {
 	return fmt.Sprintf("${local.%s}", tfLocalVar)
 }
 
+// TryBindOid is like TryBind, but for fields which hold an OID rather than an id.
+// Only reference generators distinguish between the two.
+func (g *Generator) TryBindOid(kind Kind, id string) string {
+	if !g.Enabled || g.references == nil || kind == KindWorkspace {
+		return g.TryBind(kind, id)
+	}
+	e := g.cache.LookupId(kind, id)
+	if _, ok := g.references[kind]; !ok || e == nil {
+		return id
+	}
+	return fmt.Sprintf("${%s.%s.oid}", g.references[kind], e.TfName)
+}
+
+// fmtTfReference formats a reference to the object managed under tfName. Since
+// workspaces are bound by OID, they reference the oid attribute.
+func (g *Generator) fmtTfReference(kind Kind, tfName string) string {
+	attr := "id"
+	if kind == KindWorkspace {
+		attr = "oid"
+	}
+	return fmt.Sprintf("${%s.%s.%s}", g.references[kind], tfName, attr)
+}
+
 func resolveKeyToKinds(key string) []Kind {
 	switch key {
 	case "id":
@@ -303,7 +377,8 @@ func transformJson(data []byte, f func(data *interface{}) error) ([]byte, error)
 	return serialized, nil
 }
 
-func sanitizeIdentifier(name string) string {
+// SanitizeIdentifier converts an object name into a valid terraform identifier
+func SanitizeIdentifier(name string) string {
 	path := strings.Split(name, "/")
 
 	shortForm := strings.ToLower(path[len(path)-1])
@@ -171,3 +171,42 @@ func TestInsertBindingsObjectJson(t *testing.T) {
 		t.Fatalf("expected %#v, got %#v", expected, output)
 	}
 }
+
+func TestReferenceGenerator(t *testing.T) {
+	cache := prepareResourceCacheFixture()
+	// e.g. a dataset which could not be exported
+	cache.addEntry(KindDataset, "dataset_3", "41000300", new(int), make(map[string]struct{}))
+	cache.Remove(KindDataset, "41000300")
+	g := NewReferenceGenerator(cache, map[Kind]string{
+		KindDataset:   "observe_dataset",
+		KindWorkspace: "data.observe_workspace",
+	})
+	testcases := []struct {
+		binding  string
+		expected string
+	}{
+		{g.TryBind(KindDataset, "41000123"), "${observe_dataset.dataset_1.id}"},
+		{g.TryBindOid(KindDataset, "41000200"), "${observe_dataset.dataset_2.oid}"},
+		{g.TryBind(KindWorkspace, "o:::workspace:41000001"), "${data.observe_workspace.workspace_1.oid}"},
+		{g.TryBindOid(KindWorkspace, "o:::workspace:41000001"), "${data.observe_workspace.workspace_1.oid}"},
+		// no address configured
+		{g.TryBind(KindWorksheet, "41000201"), "41000201"},
+		{g.TryBindOid(KindUser, "41000100"), "41000100"},
+		// not cached
+		{g.TryBindOid(KindDataset, "41000999"), "41000999"},
+		{g.TryBind(KindDataset, "41000300"), "41000300"},
+	}
+	for _, tt := range testcases {
+		if tt.binding != tt.expected {
+			t.Errorf("expected binding %s, got actual binding %s", tt.expected, tt.binding)
+		}
+	}
+
+	output, err := g.GenerateJson([]byte(`{"datasetId":"41000123","id":"41000201"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"datasetId":"${observe_dataset.dataset_1.id}","id":"41000201"}`; string(output) != expected {
+		t.Fatalf("expected %s, got %s", expected, output)
+	}
+}
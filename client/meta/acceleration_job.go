@@ -0,0 +1,73 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+)
+
+// EstimateRematerializationCost estimates the cost of rematerializing the
+// provided datasets, including any datasets downstream of them
+func (client *Client) EstimateRematerializationCost(ctx context.Context, datasetIds ...string) ([]DatasetCostEstimate, error) {
+	var job RematerializationInput
+	for _, id := range datasetIds {
+		job.Requests = append(job.Requests, RematerializationRequest{DatasetId: id})
+	}
+	resp, err := estimateRematerializationCost(ctx, client.Gql, job)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Estimates, nil
+}
+
+type accelerationJobResponse interface {
+	GetJob() AccelerationJob
+}
+
+func accelerationJobOrError(a accelerationJobResponse, err error) (*AccelerationJob, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := a.GetJob()
+	return &result, nil
+}
+
+func (client *Client) CreateAccelerationJob(ctx context.Context, input *AccelerationJobInput) (*AccelerationJob, error) {
+	resp, err := createAccelerationJob(ctx, client.Gql, *input)
+	return accelerationJobOrError(resp, err)
+}
+
+func (client *Client) GetAccelerationJob(ctx context.Context, id string) (*AccelerationJob, error) {
+	resp, err := getAccelerationJob(ctx, client.Gql, id)
+	return accelerationJobOrError(resp, err)
+}
+
+func (client *Client) CancelAccelerationJob(ctx context.Context, id string) (*AccelerationJob, error) {
+	resp, err := cancelAccelerationJob(ctx, client.Gql, id)
+	return accelerationJobOrError(resp, err)
+}
+
+// DatasetAccelerationStatus combines the acceleration info of a dataset with
+// the progress of any acceleration jobs running against it
+type DatasetAccelerationStatus struct {
+	DatasetAccelerationInfo
+	Progress    float64
+	RunningJobs []string
+}
+
+func (client *Client) GetDatasetAccelerationStatus(ctx context.Context, datasetId string) (*DatasetAccelerationStatus, error) {
+	resp, err := getDatasetAccelerationStatus(ctx, client.Gql, datasetId)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Dataset == nil {
+		return nil, fmt.Errorf("dataset %s not found", datasetId)
+	}
+	result := &DatasetAccelerationStatus{
+		DatasetAccelerationInfo: resp.Dataset.AccelerationInfo,
+		Progress:                resp.Status.Progress,
+	}
+	for _, job := range resp.Status.RunningJobs {
+		result.RunningJobs = append(result.RunningJobs, job.JobId)
+	}
+	return result, nil
+}
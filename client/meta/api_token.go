@@ -0,0 +1,61 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	oid "github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type apiTokenResponse interface {
+	GetApiToken() ApiToken
+}
+
+func apiTokenOrError(a apiTokenResponse, err error) (*ApiToken, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := a.GetApiToken()
+	return &result, nil
+}
+
+// CreateApiToken returns the newly created token along with its secret,
+// which cannot be retrieved again afterwards.
+func (client *Client) CreateApiToken(ctx context.Context, input *AuthtokenInput, owningUser *types.UserIdScalar) (*ApiToken, string, error) {
+	resp, err := createApiToken(ctx, client.Gql, *input, owningUser)
+	if err != nil {
+		return nil, "", err
+	}
+	result := resp.Result.GetApiToken()
+	return &result, resp.Result.Secret, nil
+}
+
+func (client *Client) GetApiToken(ctx context.Context, id string) (*ApiToken, error) {
+	resp, err := getApiToken(ctx, client.Gql, id)
+	return apiTokenOrError(resp, err)
+}
+
+func (client *Client) UpdateApiToken(ctx context.Context, id string, input *AuthtokenInput) (*ApiToken, error) {
+	resp, err := updateApiToken(ctx, client.Gql, id, *input)
+	return apiTokenOrError(resp, err)
+}
+
+func (client *Client) DeleteApiToken(ctx context.Context, id string) error {
+	resp, err := deleteApiToken(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) SearchApiTokens(ctx context.Context, user *types.UserIdScalar) ([]ApiToken, error) {
+	resp, err := searchApiTokens(ctx, client.Gql, user)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ApiTokens, nil
+}
+
+func (a *ApiToken) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   a.Id,
+		Type: oid.TypeApiToken,
+	}
+}
@@ -0,0 +1,76 @@
+package meta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	oid "github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type dataConnectionResponse interface {
+	GetDataConnection() DataConnection
+}
+
+func dataConnectionOrError(d dataConnectionResponse, err error) (*DataConnection, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := d.GetDataConnection()
+	return &result, nil
+}
+
+func (client *Client) CreateDataConnection(ctx context.Context, workspaceId string, input *DataConnectionInput) (*DataConnection, error) {
+	resp, err := createDataConnection(ctx, client.Gql, workspaceId, *input)
+	return dataConnectionOrError(resp, err)
+}
+
+func (client *Client) GetDataConnection(ctx context.Context, id string) (*DataConnection, error) {
+	resp, err := getDataConnection(ctx, client.Gql, id)
+	return dataConnectionOrError(resp, err)
+}
+
+func (client *Client) UpdateDataConnection(ctx context.Context, id string, input *DataConnectionInput) (*DataConnection, error) {
+	resp, err := updateDataConnection(ctx, client.Gql, id, *input)
+	return dataConnectionOrError(resp, err)
+}
+
+func (client *Client) DeleteDataConnection(ctx context.Context, id string) error {
+	resp, err := deleteDataConnection(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) LookupDataConnection(ctx context.Context, workspaceId string, name string) (*DataConnection, error) {
+	resp, err := searchDataConnection(ctx, client.Gql, &workspaceId, nil, &name, nil)
+	if err != nil {
+		return nil, err
+	}
+	results := resp.DataConnections.Results
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected exactly one data connection named %q, found %d", name, len(results))
+	}
+	return &results[0], nil
+}
+
+// LookupDataConnectionModuleVersions returns all published versions of a data connection module
+func (client *Client) LookupDataConnectionModuleVersions(ctx context.Context, workspaceId string, id string) ([]*ModuleVersion, error) {
+	resp, err := lookupDataConnectionModuleVersions(ctx, client.Gql, id, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.ModuleVersions) == 0 {
+		return nil, errors.New("no module versions found")
+	}
+	versions := make([]*ModuleVersion, 0, len(resp.ModuleVersions))
+	for _, v := range resp.ModuleVersions {
+		versions = append(versions, &ModuleVersion{Version: v.Version})
+	}
+	return versions, nil
+}
+
+func (d *DataConnection) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   d.Id,
+		Type: oid.TypeDataConnection,
+	}
+}
@@ -2,6 +2,7 @@ package meta
 
 import (
 	"context"
+	"fmt"
 
 	oid "github.com/observeinc/terraform-provider-observe/client/oid"
 )
@@ -88,6 +89,31 @@ func (client *Client) ListDatasetsIdNameOnly(ctx context.Context) (ds []*Dataset
 	return result, nil
 }
 
+// ListDatasetInputs returns the input datasets of every dataset in a workspace
+func (client *Client) ListDatasetInputs(ctx context.Context, workspaceId string) ([]DatasetInputs, error) {
+	resp, err := listDatasetInputs(ctx, client.Gql, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Datasets == nil {
+		return nil, fmt.Errorf("workspace %s not found", workspaceId)
+	}
+	return resp.Datasets.Datasets, nil
+}
+
+// GetDatasetDoctorReport returns the doctor report for a dataset, and for its
+// ancestors up to upLevels away
+func (client *Client) GetDatasetDoctorReport(ctx context.Context, datasetId string, upLevels int) ([]DatasetReport, error) {
+	resp, err := getDatasetDoctorReport(ctx, client.Gql, datasetId, &upLevels)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Report == nil {
+		return nil, fmt.Errorf("dataset %s not found", datasetId)
+	}
+	return resp.Report.Datasets, nil
+}
+
 func (client *Client) SaveSourceDataset(ctx context.Context, workspaceId string, input *DatasetDefinitionInput, sourceInput *SourceTableDefinitionInput) (*Dataset, error) {
 	resp, err := saveSourceDataset(ctx, client.Gql, workspaceId, *input, *sourceInput, DefaultDependencyHandling())
 	return datasetOrError(resp.Dataset, err)
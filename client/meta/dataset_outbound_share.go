@@ -38,6 +38,14 @@ func (client *Client) DeleteDatasetOutboundShare(ctx context.Context, id string)
 	return resultStatusError(resp, err)
 }
 
+func (client *Client) ListDatasetOutboundShares(ctx context.Context, workspaceId *string) ([]DatasetOutboundShare, error) {
+	resp, err := searchDatasetOutboundShare(ctx, client.Gql, workspaceId, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.DatasetOutboundShares.Results, nil
+}
+
 func (p *DatasetOutboundShare) Oid() *oid.OID {
 	return &oid.OID{
 		Id:   p.Id,
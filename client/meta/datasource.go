@@ -0,0 +1,59 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	oid "github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type datasourceResponse interface {
+	GetDatasource() Datasource
+}
+
+func datasourceOrError(d datasourceResponse, err error) (*Datasource, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := d.GetDatasource()
+	return &result, nil
+}
+
+func (client *Client) CreateDatasource(ctx context.Context, workspaceId string, input *DatasourceInput) (*Datasource, error) {
+	resp, err := createDatasource(ctx, client.Gql, workspaceId, *input)
+	return datasourceOrError(resp, err)
+}
+
+func (client *Client) GetDatasource(ctx context.Context, id string) (*Datasource, error) {
+	resp, err := getDatasource(ctx, client.Gql, id)
+	return datasourceOrError(resp, err)
+}
+
+func (client *Client) UpdateDatasource(ctx context.Context, id string, input *DatasourceInput) (*Datasource, error) {
+	resp, err := updateDatasource(ctx, client.Gql, id, *input)
+	return datasourceOrError(resp, err)
+}
+
+func (client *Client) DeleteDatasource(ctx context.Context, id string) error {
+	resp, err := deleteDatasource(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) LookupDatasource(ctx context.Context, workspaceId string, name string) (*Datasource, error) {
+	resp, err := searchDatasource(ctx, client.Gql, &workspaceId, nil, &name, nil)
+	if err != nil {
+		return nil, err
+	}
+	results := resp.Datasources.Results
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected exactly one datasource named %q, found %d", name, len(results))
+	}
+	return &results[0], nil
+}
+
+func (d *Datasource) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   d.Id,
+		Type: oid.TypeDatasource,
+	}
+}
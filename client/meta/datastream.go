@@ -44,6 +44,14 @@ func (client *Client) LookupDatastream(ctx context.Context, workspaceId, name st
 	return datastreamOrError(resp.Datastream, err)
 }
 
+func (client *Client) ListDatastreams(ctx context.Context, workspaceId string) ([]Datastream, error) {
+	resp, err := listDatastreams(ctx, client.Gql, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Datastreams, nil
+}
+
 func (d *Datastream) Oid() *oid.OID {
 	return &oid.OID{
 		Id:   d.Id,
@@ -43,6 +43,11 @@ func (client *Client) LookupFolder(ctx context.Context, workspaceId, name string
 	return folderOrError(resp.Folder, err)
 }
 
+func (client *Client) SaveFolderSettings(ctx context.Context, workspaceId, id string, input *LayeredFolderInput) error {
+	_, err := saveFolderSettings(ctx, client.Gql, workspaceId, id, *input)
+	return err
+}
+
 func (f *Folder) Oid() *oid.OID {
 	// Shameful hack: Use the workspace ID as the ID, and use the actual folder ID as the version
 	return &oid.OID{
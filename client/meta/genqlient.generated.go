@@ -11,6 +11,165 @@ import (
 	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 )
 
+// AccelerationJob includes the GraphQL fields of AccelerationJob requested by the fragment AccelerationJob.
+// The GraphQL type's documentation follows.
+//
+// This is the acceleration job returned from the backend.
+type AccelerationJob struct {
+	// A unique identifier for the acceleration job. An invalid jobId might be
+	// returned for a failed create operation or a dry run create operation.
+	JobId string `json:"jobId"`
+	// Optional context provided by the caller.
+	Context *string `json:"context"`
+	// When the acceleration job was created.
+	CreatedDate types.TimeScalar `json:"createdDate"`
+	// Current state of the acceleration job.
+	State AccelerationJobState `json:"state"`
+	// When the state of the acceleration job was last updated.
+	StateLastUpdatedDate types.TimeScalar `json:"stateLastUpdatedDate"`
+	// Percentage of the acceleration job that has completed.
+	Progress float64 `json:"progress"`
+	// Optional value of the credits used for this acceleration job summed for all datasets
+	Credits *float64 `json:"credits"`
+	// Status of the requests in this job. One per dataset.
+	DatasetStatuses []AccelerationJobDatasetStatusesAccelerationRequestStatus `json:"datasetStatuses"`
+}
+
+// GetJobId returns AccelerationJob.JobId, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetJobId() string { return v.JobId }
+
+// GetContext returns AccelerationJob.Context, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetContext() *string { return v.Context }
+
+// GetCreatedDate returns AccelerationJob.CreatedDate, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetCreatedDate() types.TimeScalar { return v.CreatedDate }
+
+// GetState returns AccelerationJob.State, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetState() AccelerationJobState { return v.State }
+
+// GetStateLastUpdatedDate returns AccelerationJob.StateLastUpdatedDate, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetStateLastUpdatedDate() types.TimeScalar { return v.StateLastUpdatedDate }
+
+// GetProgress returns AccelerationJob.Progress, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetProgress() float64 { return v.Progress }
+
+// GetCredits returns AccelerationJob.Credits, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetCredits() *float64 { return v.Credits }
+
+// GetDatasetStatuses returns AccelerationJob.DatasetStatuses, and is useful for accessing the field via an interface.
+func (v *AccelerationJob) GetDatasetStatuses() []AccelerationJobDatasetStatusesAccelerationRequestStatus {
+	return v.DatasetStatuses
+}
+
+// AccelerationJobDatasetStatusesAccelerationRequestStatus includes the requested fields of the GraphQL type AccelerationRequestStatus.
+// The GraphQL type's documentation follows.
+//
+// This is the status of the acceleration request for a particular dataset in an
+// accleration job returned from the backend.
+type AccelerationJobDatasetStatusesAccelerationRequestStatus struct {
+	DatasetId string `json:"datasetId"`
+	// Whether the dataset is directly requested in the owning acceleration job.
+	IsDirect bool `json:"isDirect"`
+	// Percentage of the acceleration request that is completed. 1 means fully
+	// completed.
+	Progress float64 `json:"progress"`
+	// Optional credits used for this particular dataset in the parent acceleration job.
+	Credits *float64 `json:"credits"`
+}
+
+// GetDatasetId returns AccelerationJobDatasetStatusesAccelerationRequestStatus.DatasetId, and is useful for accessing the field via an interface.
+func (v *AccelerationJobDatasetStatusesAccelerationRequestStatus) GetDatasetId() string {
+	return v.DatasetId
+}
+
+// GetIsDirect returns AccelerationJobDatasetStatusesAccelerationRequestStatus.IsDirect, and is useful for accessing the field via an interface.
+func (v *AccelerationJobDatasetStatusesAccelerationRequestStatus) GetIsDirect() bool {
+	return v.IsDirect
+}
+
+// GetProgress returns AccelerationJobDatasetStatusesAccelerationRequestStatus.Progress, and is useful for accessing the field via an interface.
+func (v *AccelerationJobDatasetStatusesAccelerationRequestStatus) GetProgress() float64 {
+	return v.Progress
+}
+
+// GetCredits returns AccelerationJobDatasetStatusesAccelerationRequestStatus.Credits, and is useful for accessing the field via an interface.
+func (v *AccelerationJobDatasetStatusesAccelerationRequestStatus) GetCredits() *float64 {
+	return v.Credits
+}
+
+type AccelerationJobInput struct {
+	// An acceleration job contains a collection of acceleration requests on
+	// individual datasets. It is OK to have duplicate or overlapping requests.
+	// Backend will handle that.
+	Requests []AccelerationRequestInput `json:"requests"`
+	// Optional context provided by the caller.
+	Context *string `json:"context,omitempty"`
+	// If dryRun is set to true, the created job won't actually be added to the
+	// system for acceleration. The returned job will have an invalid id (all zero
+	// UUID). The dry run can be used to peek what the created job would look like
+	// before actually creating it. Note that it's not guaranteed the job ID will be
+	// the same between a dry run and a real run. The other fields could also change
+	// if the dry run and real run are far apart in time.
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// GetRequests returns AccelerationJobInput.Requests, and is useful for accessing the field via an interface.
+func (v *AccelerationJobInput) GetRequests() []AccelerationRequestInput { return v.Requests }
+
+// GetContext returns AccelerationJobInput.Context, and is useful for accessing the field via an interface.
+func (v *AccelerationJobInput) GetContext() *string { return v.Context }
+
+// GetDryRun returns AccelerationJobInput.DryRun, and is useful for accessing the field via an interface.
+func (v *AccelerationJobInput) GetDryRun() *bool { return v.DryRun }
+
+type AccelerationJobState string
+
+const (
+	AccelerationJobStateRunning   AccelerationJobState = "RUNNING"
+	AccelerationJobStateCompleted AccelerationJobState = "COMPLETED"
+	AccelerationJobStateCancelled AccelerationJobState = "CANCELLED"
+)
+
+type AccelerationRequestInput struct {
+	// The ID of the dataset to be accelerated in this request.
+	DatasetId string `json:"datasetId"`
+	// The time ranges to be accelerated. It is OK to have duplicate or overlapping
+	// ranges. Backend will handle that. DatasetInfo.unacceleratedWindows can be used
+	// as intervals directly.
+	Intervals []TimeRangeInput `json:"intervals"`
+}
+
+// GetDatasetId returns AccelerationRequestInput.DatasetId, and is useful for accessing the field via an interface.
+func (v *AccelerationRequestInput) GetDatasetId() string { return v.DatasetId }
+
+// GetIntervals returns AccelerationRequestInput.Intervals, and is useful for accessing the field via an interface.
+func (v *AccelerationRequestInput) GetIntervals() []TimeRangeInput { return v.Intervals }
+
+type AccelerationState string
+
+const (
+	// Dataset is newly created/updated and acceleration has just started. It can
+	// be queried through inlining.
+	AccelerationStateInitializing AccelerationState = "Initializing"
+	// Normal operation, we are actively accelerating new data as they come in.
+	AccelerationStateLive AccelerationState = "Live"
+	// Like normal operation (Live), but additionally this dataset is updated as fast
+	// as possible. As long as this dataset is in live mode, the freshness goal is
+	// reduced to "zero" and reset to the original value again afterwards.
+	AccelerationStateLivemode AccelerationState = "LiveMode"
+	// Acceleration is unavailable because the dataset or its upstream dataset is
+	// broken (has compilation error). The dataset cannot be queried.
+	AccelerationStateUnavailable AccelerationState = "Unavailable"
+	// Acceleration is intentionally disabled, and the dataset can still be queried
+	// (through inlining). This covers the case where the dataset is not accelerable or
+	// acceleration is explicitly disabled.
+	AccelerationStateDisabled AccelerationState = "Disabled"
+	// Acceleration is failing at runtime. As a result querying the dataset may
+	// return outdated results. This is critical error and usually cannot be fixed
+	// by the user.
+	AccelerationStateError AccelerationState = "Error"
+)
+
 type ActionInput struct {
 	Name             *string               `json:"name"`
 	IconUrl          *string               `json:"iconUrl"`
@@ -81,6 +240,50 @@ const (
 	AggregationModeSampled AggregationMode = "Sampled"
 )
 
+// ApiToken includes the GraphQL fields of Authtoken requested by the fragment ApiToken.
+type ApiToken struct {
+	Id               string              `json:"id"`
+	Name             string              `json:"name"`
+	Description      *string             `json:"description"`
+	Disabled         bool                `json:"disabled"`
+	Expiration       types.TimeScalar    `json:"expiration"`
+	ExtensionSeconds types.Int64Scalar   `json:"extensionSeconds"`
+	Kind             AuthtokenKind       `json:"kind"`
+	User             *types.UserIdScalar `json:"user"`
+	CreatedDate      types.TimeScalar    `json:"createdDate"`
+	UpdatedDate      types.TimeScalar    `json:"updatedDate"`
+}
+
+// GetId returns ApiToken.Id, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetId() string { return v.Id }
+
+// GetName returns ApiToken.Name, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetName() string { return v.Name }
+
+// GetDescription returns ApiToken.Description, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetDescription() *string { return v.Description }
+
+// GetDisabled returns ApiToken.Disabled, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetDisabled() bool { return v.Disabled }
+
+// GetExpiration returns ApiToken.Expiration, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetExpiration() types.TimeScalar { return v.Expiration }
+
+// GetExtensionSeconds returns ApiToken.ExtensionSeconds, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetExtensionSeconds() types.Int64Scalar { return v.ExtensionSeconds }
+
+// GetKind returns ApiToken.Kind, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetKind() AuthtokenKind { return v.Kind }
+
+// GetUser returns ApiToken.User, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetUser() *types.UserIdScalar { return v.User }
+
+// GetCreatedDate returns ApiToken.CreatedDate, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetCreatedDate() types.TimeScalar { return v.CreatedDate }
+
+// GetUpdatedDate returns ApiToken.UpdatedDate, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetUpdatedDate() types.TimeScalar { return v.UpdatedDate }
+
 // App includes the GraphQL fields of App requested by the fragment App.
 // The GraphQL type's documentation follows.
 //
@@ -252,6 +455,38 @@ func (v *AppVariableInput) GetName() string { return v.Name }
 // GetValue returns AppVariableInput.Value, and is useful for accessing the field via an interface.
 func (v *AppVariableInput) GetValue() string { return v.Value }
 
+type AuthtokenInput struct {
+	Name             string            `json:"name"`
+	Description      *string           `json:"description,omitempty"`
+	Disabled         bool              `json:"disabled"`
+	ExtensionSeconds types.Int64Scalar `json:"extensionSeconds"`
+	Expiration       types.TimeScalar  `json:"expiration"`
+}
+
+// GetName returns AuthtokenInput.Name, and is useful for accessing the field via an interface.
+func (v *AuthtokenInput) GetName() string { return v.Name }
+
+// GetDescription returns AuthtokenInput.Description, and is useful for accessing the field via an interface.
+func (v *AuthtokenInput) GetDescription() *string { return v.Description }
+
+// GetDisabled returns AuthtokenInput.Disabled, and is useful for accessing the field via an interface.
+func (v *AuthtokenInput) GetDisabled() bool { return v.Disabled }
+
+// GetExtensionSeconds returns AuthtokenInput.ExtensionSeconds, and is useful for accessing the field via an interface.
+func (v *AuthtokenInput) GetExtensionSeconds() types.Int64Scalar { return v.ExtensionSeconds }
+
+// GetExpiration returns AuthtokenInput.Expiration, and is useful for accessing the field via an interface.
+func (v *AuthtokenInput) GetExpiration() types.TimeScalar { return v.Expiration }
+
+type AuthtokenKind string
+
+const (
+	AuthtokenKindDatastream AuthtokenKind = "Datastream"
+	AuthtokenKindLogin      AuthtokenKind = "Login"
+	AuthtokenKindApi        AuthtokenKind = "Api"
+	AuthtokenKindSso        AuthtokenKind = "Sso"
+)
+
 // Board includes the GraphQL fields of Board requested by the fragment Board.
 type Board struct {
 	Id        string           `json:"id"`
@@ -901,6 +1136,20 @@ const (
 	CompareFunctionIsnotnull      CompareFunction = "IsNotNull"
 )
 
+// A very low confidence indicates the there was no data to perform the cost estimation. A low confidence
+// indicates that the estimate is made using incomplete data. A medium confidence indicates that the
+// backfill cost estimation is made using the ongoing data and the prediction is decent but could be
+// improved if there was backfill data available. A high confidence indicates that we had all the
+// appropriate backfill data to make a good estimation.
+type ConfidenceCostEstimate string
+
+const (
+	ConfidenceCostEstimateVerylow ConfidenceCostEstimate = "VeryLow"
+	ConfidenceCostEstimateLow     ConfidenceCostEstimate = "Low"
+	ConfidenceCostEstimateMedium  ConfidenceCostEstimate = "Medium"
+	ConfidenceCostEstimateHigh    ConfidenceCostEstimate = "High"
+)
+
 type CursorCacheMode string
 
 const (
@@ -915,6 +1164,42 @@ const (
 	CursorCacheModeCacheifmoredata CursorCacheMode = "CacheIfMoreData"
 )
 
+// Same search input used for Dashboards and Worksheets, hence, DWSearchInput.
+type DWSearchInput struct {
+	Name          []string               `json:"name,omitempty"`
+	WorkspaceId   []string               `json:"workspaceId,omitempty"`
+	WorkspaceName []string               `json:"workspaceName,omitempty"`
+	FolderId      []string               `json:"folderId,omitempty"`
+	FolderName    []string               `json:"folderName,omitempty"`
+	User          []types.UserIdScalar   `json:"user,omitempty"`
+	Parameter     []ParameterSearchInput `json:"parameter,omitempty"`
+	Input         []InputSearchInput     `json:"input,omitempty"`
+}
+
+// GetName returns DWSearchInput.Name, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetName() []string { return v.Name }
+
+// GetWorkspaceId returns DWSearchInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetWorkspaceId() []string { return v.WorkspaceId }
+
+// GetWorkspaceName returns DWSearchInput.WorkspaceName, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetWorkspaceName() []string { return v.WorkspaceName }
+
+// GetFolderId returns DWSearchInput.FolderId, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetFolderId() []string { return v.FolderId }
+
+// GetFolderName returns DWSearchInput.FolderName, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetFolderName() []string { return v.FolderName }
+
+// GetUser returns DWSearchInput.User, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetUser() []types.UserIdScalar { return v.User }
+
+// GetParameter returns DWSearchInput.Parameter, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetParameter() []ParameterSearchInput { return v.Parameter }
+
+// GetInput returns DWSearchInput.Input, and is useful for accessing the field via an interface.
+func (v *DWSearchInput) GetInput() []InputSearchInput { return v.Input }
+
 // Dashboard includes the GraphQL fields of Dashboard requested by the fragment Dashboard.
 type Dashboard struct {
 	Id              string                                     `json:"id"`
@@ -1238,6 +1523,128 @@ func (v *DashboardStagesStageQueryInputInputDefinition) GetDatasetPath() *string
 // GetStageId returns DashboardStagesStageQueryInputInputDefinition.StageId, and is useful for accessing the field via an interface.
 func (v *DashboardStagesStageQueryInputInputDefinition) GetStageId() *string { return v.StageId }
 
+// DataConnection includes the GraphQL fields of DataConnection requested by the fragment DataConnection.
+type DataConnection struct {
+	Id          string                  `json:"id"`
+	WorkspaceId string                  `json:"workspaceId"`
+	FolderId    string                  `json:"folderId"`
+	Name        string                  `json:"name"`
+	IconUrl     *string                 `json:"iconUrl"`
+	Description *string                 `json:"description"`
+	ModuleID    string                  `json:"moduleID"`
+	Version     string                  `json:"version"`
+	Variables   []DataVariable          `json:"variables"`
+	Outputs     []DataConnectionOutputs `json:"outputs"`
+}
+
+// GetId returns DataConnection.Id, and is useful for accessing the field via an interface.
+func (v *DataConnection) GetId() string { return v.Id }
+
+// GetWorkspaceId returns DataConnection.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *DataConnection) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetFolderId returns DataConnection.FolderId, and is useful for accessing the field via an interface.
+func (v *DataConnection) GetFolderId() string { return v.FolderId }
+
+// GetName returns DataConnection.Name, and is useful for accessing the field via an interface.
+func (v *DataConnection) GetName() string { return v.Name }
+
+// GetIconUrl returns DataConnection.IconUrl, and is useful for accessing the field via an interface.
+func (v *DataConnection) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns DataConnection.Description, and is useful for accessing the field via an interface.
+func (v *DataConnection) GetDescription() *string { return v.Description }
+
+// GetModuleID returns DataConnection.ModuleID, and is useful for accessing the field via an interface.
+func (v *DataConnection) GetModuleID() string { return v.ModuleID }
+
+// GetVersion returns DataConnection.Version, and is useful for accessing the field via an interface.
+func (v *DataConnection) GetVersion() string { return v.Version }
+
+// GetVariables returns DataConnection.Variables, and is useful for accessing the field via an interface.
+func (v *DataConnection) GetVariables() []DataVariable { return v.Variables }
+
+// GetOutputs returns DataConnection.Outputs, and is useful for accessing the field via an interface.
+func (v *DataConnection) GetOutputs() []DataConnectionOutputs { return v.Outputs }
+
+type DataConnectionInput struct {
+	ModuleID    string              `json:"moduleID"`
+	Version     string              `json:"version"`
+	Variables   []DataVariableInput `json:"variables"`
+	Name        string              `json:"name"`
+	IconUrl     *string             `json:"iconUrl,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	ManagedById *string             `json:"managedById,omitempty"`
+	FolderId    *string             `json:"folderId,omitempty"`
+}
+
+// GetModuleID returns DataConnectionInput.ModuleID, and is useful for accessing the field via an interface.
+func (v *DataConnectionInput) GetModuleID() string { return v.ModuleID }
+
+// GetVersion returns DataConnectionInput.Version, and is useful for accessing the field via an interface.
+func (v *DataConnectionInput) GetVersion() string { return v.Version }
+
+// GetVariables returns DataConnectionInput.Variables, and is useful for accessing the field via an interface.
+func (v *DataConnectionInput) GetVariables() []DataVariableInput { return v.Variables }
+
+// GetName returns DataConnectionInput.Name, and is useful for accessing the field via an interface.
+func (v *DataConnectionInput) GetName() string { return v.Name }
+
+// GetIconUrl returns DataConnectionInput.IconUrl, and is useful for accessing the field via an interface.
+func (v *DataConnectionInput) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns DataConnectionInput.Description, and is useful for accessing the field via an interface.
+func (v *DataConnectionInput) GetDescription() *string { return v.Description }
+
+// GetManagedById returns DataConnectionInput.ManagedById, and is useful for accessing the field via an interface.
+func (v *DataConnectionInput) GetManagedById() *string { return v.ManagedById }
+
+// GetFolderId returns DataConnectionInput.FolderId, and is useful for accessing the field via an interface.
+func (v *DataConnectionInput) GetFolderId() *string { return v.FolderId }
+
+// DataConnectionOutputs includes the requested fields of the GraphQL type DataConnectionOutputs.
+type DataConnectionOutputs struct {
+	Name   string `json:"name"`
+	Target string `json:"target"`
+}
+
+// GetName returns DataConnectionOutputs.Name, and is useful for accessing the field via an interface.
+func (v *DataConnectionOutputs) GetName() string { return v.Name }
+
+// GetTarget returns DataConnectionOutputs.Target, and is useful for accessing the field via an interface.
+func (v *DataConnectionOutputs) GetTarget() string { return v.Target }
+
+// DataVariable includes the GraphQL fields of DataVariable requested by the fragment DataVariable.
+type DataVariable struct {
+	Name      string  `json:"name"`
+	Value     *string `json:"value"`
+	Sensitive *bool   `json:"sensitive"`
+}
+
+// GetName returns DataVariable.Name, and is useful for accessing the field via an interface.
+func (v *DataVariable) GetName() string { return v.Name }
+
+// GetValue returns DataVariable.Value, and is useful for accessing the field via an interface.
+func (v *DataVariable) GetValue() *string { return v.Value }
+
+// GetSensitive returns DataVariable.Sensitive, and is useful for accessing the field via an interface.
+func (v *DataVariable) GetSensitive() *bool { return v.Sensitive }
+
+type DataVariableInput struct {
+	Name  string  `json:"name"`
+	Title *string `json:"title,omitempty"`
+	Value *string `json:"value"`
+}
+
+// GetName returns DataVariableInput.Name, and is useful for accessing the field via an interface.
+func (v *DataVariableInput) GetName() string { return v.Name }
+
+// GetTitle returns DataVariableInput.Title, and is useful for accessing the field via an interface.
+func (v *DataVariableInput) GetTitle() *string { return v.Title }
+
+// GetValue returns DataVariableInput.Value, and is useful for accessing the field via an interface.
+func (v *DataVariableInput) GetValue() *string { return v.Value }
+
 // Dataset includes the GraphQL fields of Dataset requested by the fragment Dataset.
 type Dataset struct {
 	WorkspaceId          string             `json:"workspaceId"`
@@ -1324,6 +1731,94 @@ func (v *Dataset) GetCorrelationTagMappings() []DatasetCorrelationTagMappingsCor
 	return v.CorrelationTagMappings
 }
 
+// DatasetAccelerationInfo includes the GraphQL fields of AccelerationInfo requested by the fragment DatasetAccelerationInfo.
+type DatasetAccelerationInfo struct {
+	State AccelerationState `json:"state"`
+	// Staleness of the dataset (averaged over some moving window). 5min means we
+	// may not return data received in the last 5 minutes. A float value in
+	// seconds.
+	// Empty if alwaysAccelerated is true.
+	StalenessSeconds *float64 `json:"stalenessSeconds"`
+	// The actual target staleness target of the dataset. Note that this can be
+	// higher than the configured staleness target, due to decaying or credit
+	// manager overrides. Also if this value is different from the field above,
+	// it means the dataset is freshness decayed.
+	// Empty if alwaysAccelerated is true.
+	TargetStalenessSeconds *float64 `json:"targetStalenessSeconds"`
+	// Configured staleness target of the dataset. 2min means the staleness of
+	// the dataset should not exceed 2mins. May differ from the originally
+	// configured value of the dataset if Dataset.freshnessDesired is nil, in
+	// which case we fill in a default, or if there is a layered setting override.
+	//
+	// This can be empty if alwaysAccelerated is true, the dataset is
+	// initializing, or there is an internal error processing the dataset. This
+	// should be filled in for datasets that are disabled or have compilation
+	// errors, though.
+	ConfiguredTargetStalenessSeconds *float64 `json:"configuredTargetStalenessSeconds"`
+	// The freshness time of the dataset.
+	FreshnessTime *types.TimeScalar `json:"freshnessTime"`
+	// Acceleration errors. Only not null if the state is "Error". Note that right
+	// now it only includes acceleration error of the particular dataset, but in
+	// the future shall include upstream dataset's errors.
+	Errors []DatasetAccelerationInfoErrorsAccelerationError `json:"errors"`
+}
+
+// GetState returns DatasetAccelerationInfo.State, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfo) GetState() AccelerationState { return v.State }
+
+// GetStalenessSeconds returns DatasetAccelerationInfo.StalenessSeconds, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfo) GetStalenessSeconds() *float64 { return v.StalenessSeconds }
+
+// GetTargetStalenessSeconds returns DatasetAccelerationInfo.TargetStalenessSeconds, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfo) GetTargetStalenessSeconds() *float64 {
+	return v.TargetStalenessSeconds
+}
+
+// GetConfiguredTargetStalenessSeconds returns DatasetAccelerationInfo.ConfiguredTargetStalenessSeconds, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfo) GetConfiguredTargetStalenessSeconds() *float64 {
+	return v.ConfiguredTargetStalenessSeconds
+}
+
+// GetFreshnessTime returns DatasetAccelerationInfo.FreshnessTime, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfo) GetFreshnessTime() *types.TimeScalar { return v.FreshnessTime }
+
+// GetErrors returns DatasetAccelerationInfo.Errors, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfo) GetErrors() []DatasetAccelerationInfoErrorsAccelerationError {
+	return v.Errors
+}
+
+// DatasetAccelerationInfoErrorsAccelerationError includes the requested fields of the GraphQL type AccelerationError.
+type DatasetAccelerationInfoErrorsAccelerationError struct {
+	// The dataset that has the acceleration error.
+	DatasetId   string `json:"datasetId"`
+	DatasetName string `json:"datasetName"`
+	// Internal transform ID where the acceleration error occurs.
+	TransformId *string `json:"transformId"`
+	// When did the error last occur
+	Time types.TimeScalar `json:"time"`
+	// Error text
+	ErrorText string `json:"errorText"`
+}
+
+// GetDatasetId returns DatasetAccelerationInfoErrorsAccelerationError.DatasetId, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfoErrorsAccelerationError) GetDatasetId() string { return v.DatasetId }
+
+// GetDatasetName returns DatasetAccelerationInfoErrorsAccelerationError.DatasetName, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfoErrorsAccelerationError) GetDatasetName() string {
+	return v.DatasetName
+}
+
+// GetTransformId returns DatasetAccelerationInfoErrorsAccelerationError.TransformId, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfoErrorsAccelerationError) GetTransformId() *string {
+	return v.TransformId
+}
+
+// GetTime returns DatasetAccelerationInfoErrorsAccelerationError.Time, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfoErrorsAccelerationError) GetTime() types.TimeScalar { return v.Time }
+
+// GetErrorText returns DatasetAccelerationInfoErrorsAccelerationError.ErrorText, and is useful for accessing the field via an interface.
+func (v *DatasetAccelerationInfoErrorsAccelerationError) GetErrorText() string { return v.ErrorText }
+
 // DatasetCorrelationTagMappingsCorrelationTagMapping includes the requested fields of the GraphQL type CorrelationTagMapping.
 type DatasetCorrelationTagMappingsCorrelationTagMapping struct {
 	Tag  string                                                          `json:"tag"`
@@ -1354,6 +1849,43 @@ func (v *DatasetCorrelationTagMappingsCorrelationTagMappingPathLinkField) GetPat
 	return v.Path
 }
 
+// DatasetCostEstimate includes the GraphQL fields of DatasetCostEstimate requested by the fragment DatasetCostEstimate.
+type DatasetCostEstimate struct {
+	DatasetId string `json:"datasetId"`
+	// Cost estimate OCCs of materializing the dataset for the given input window.
+	AbsoluteCostEstimate float64 `json:"absoluteCostEstimate"`
+	// Additional cost OCCs of materializing the dataset on top of already existing acceleration requests.
+	// To given an example, User 1 issues a request to backfill dataset for last 10 days. User 2 then issues
+	// a request to backfill the same dataset for the last 20 days.
+	// For User 1, absoluteCostEstimate and additionalCostEstimate are same i.e. of 10 days.
+	// For User 2, absoluteCostEstimate corresponds to backfilling 20 days and additionalCostEstimate
+	// corresponds to backfilling for 10 days.
+	AdditionalCostEstimate float64 `json:"additionalCostEstimate"`
+	// Confidence for the cost estimation of the absolute cost estimate of the dataset.
+	ConfidenceAbsoluteCostEstimate ConfidenceCostEstimate `json:"confidenceAbsoluteCostEstimate"`
+	// Confidence for the cost estimation of the additional cost estimate of the dataset.
+	ConfidenceAdditionalCostEstimate ConfidenceCostEstimate `json:"confidenceAdditionalCostEstimate"`
+}
+
+// GetDatasetId returns DatasetCostEstimate.DatasetId, and is useful for accessing the field via an interface.
+func (v *DatasetCostEstimate) GetDatasetId() string { return v.DatasetId }
+
+// GetAbsoluteCostEstimate returns DatasetCostEstimate.AbsoluteCostEstimate, and is useful for accessing the field via an interface.
+func (v *DatasetCostEstimate) GetAbsoluteCostEstimate() float64 { return v.AbsoluteCostEstimate }
+
+// GetAdditionalCostEstimate returns DatasetCostEstimate.AdditionalCostEstimate, and is useful for accessing the field via an interface.
+func (v *DatasetCostEstimate) GetAdditionalCostEstimate() float64 { return v.AdditionalCostEstimate }
+
+// GetConfidenceAbsoluteCostEstimate returns DatasetCostEstimate.ConfidenceAbsoluteCostEstimate, and is useful for accessing the field via an interface.
+func (v *DatasetCostEstimate) GetConfidenceAbsoluteCostEstimate() ConfidenceCostEstimate {
+	return v.ConfidenceAbsoluteCostEstimate
+}
+
+// GetConfidenceAdditionalCostEstimate returns DatasetCostEstimate.ConfidenceAdditionalCostEstimate, and is useful for accessing the field via an interface.
+func (v *DatasetCostEstimate) GetConfidenceAdditionalCostEstimate() ConfidenceCostEstimate {
+	return v.ConfidenceAdditionalCostEstimate
+}
+
 type DatasetDefinitionInput struct {
 	Dataset  DatasetInput                    `json:"dataset"`
 	Schema   []DatasetFieldDefInput          `json:"schema"`
@@ -1545,6 +2077,34 @@ func (v *DatasetInput) GetOnDemandMaterializationLength() *types.Int64Scalar {
 // GetManagedById returns DatasetInput.ManagedById, and is useful for accessing the field via an interface.
 func (v *DatasetInput) GetManagedById() *string { return v.ManagedById }
 
+// DatasetInputs includes the GraphQL fields of Dataset requested by the fragment DatasetInputs.
+type DatasetInputs struct {
+	Id          string                                   `json:"id"`
+	Name        string                                   `json:"name"`
+	WorkspaceId string                                   `json:"workspaceId"`
+	Inputs      []DatasetInputsInputsDatasetInputDataset `json:"inputs"`
+}
+
+// GetId returns DatasetInputs.Id, and is useful for accessing the field via an interface.
+func (v *DatasetInputs) GetId() string { return v.Id }
+
+// GetName returns DatasetInputs.Name, and is useful for accessing the field via an interface.
+func (v *DatasetInputs) GetName() string { return v.Name }
+
+// GetWorkspaceId returns DatasetInputs.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *DatasetInputs) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetInputs returns DatasetInputs.Inputs, and is useful for accessing the field via an interface.
+func (v *DatasetInputs) GetInputs() []DatasetInputsInputsDatasetInputDataset { return v.Inputs }
+
+// DatasetInputsInputsDatasetInputDataset includes the requested fields of the GraphQL type DatasetInputDataset.
+type DatasetInputsInputsDatasetInputDataset struct {
+	DatasetId string `json:"datasetId"`
+}
+
+// GetDatasetId returns DatasetInputsInputsDatasetInputDataset.DatasetId, and is useful for accessing the field via an interface.
+func (v *DatasetInputsInputsDatasetInputDataset) GetDatasetId() string { return v.DatasetId }
+
 type DatasetLinkSchemaInput struct {
 	TargetDataset    *types.Int64Scalar `json:"targetDataset"`
 	TargetStageLabel *string            `json:"targetStageLabel"`
@@ -1677,6 +2237,107 @@ func (v *DatasetOutboundShareStatus) GetState() DatasetOutboundShareState { retu
 // GetError returns DatasetOutboundShareStatus.Error, and is useful for accessing the field via an interface.
 func (v *DatasetOutboundShareStatus) GetError() *string { return v.Error }
 
+// DatasetReport includes the GraphQL fields of DatasetReport requested by the fragment DatasetReport.
+type DatasetReport struct {
+	// The dataset this report is for
+	DatasetId    string `json:"datasetId"`
+	DatasetLabel string `json:"datasetLabel"`
+	// If the doctor has comments, they go here -- this may include anything
+	// from "this is not accelerable because of stage X" to "the given name is
+	// not advised" to "the function name X is deprecated, use Y instead."
+	DoctorComments []string `json:"doctorComments"`
+	// each stage may have errors and warnings
+	StageNotes []DatasetReportStageNotesDatasetStageNote `json:"stageNotes"`
+	// inputDatasets are datasets bound as data inputs to this dataset
+	InputDatasets []string `json:"inputDatasets"`
+	// accelerationInfo is convenient
+	AccelerationInfo    *DatasetAccelerationInfo                         `json:"accelerationInfo"`
+	OngoingErrorReason  *DatasetReportOngoingErrorReasonReportEventInfo  `json:"ongoingErrorReason"`
+	BackfillErrorReason *DatasetReportBackfillErrorReasonReportEventInfo `json:"backfillErrorReason"`
+}
+
+// GetDatasetId returns DatasetReport.DatasetId, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetDatasetId() string { return v.DatasetId }
+
+// GetDatasetLabel returns DatasetReport.DatasetLabel, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetDatasetLabel() string { return v.DatasetLabel }
+
+// GetDoctorComments returns DatasetReport.DoctorComments, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetDoctorComments() []string { return v.DoctorComments }
+
+// GetStageNotes returns DatasetReport.StageNotes, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetStageNotes() []DatasetReportStageNotesDatasetStageNote {
+	return v.StageNotes
+}
+
+// GetInputDatasets returns DatasetReport.InputDatasets, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetInputDatasets() []string { return v.InputDatasets }
+
+// GetAccelerationInfo returns DatasetReport.AccelerationInfo, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetAccelerationInfo() *DatasetAccelerationInfo { return v.AccelerationInfo }
+
+// GetOngoingErrorReason returns DatasetReport.OngoingErrorReason, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetOngoingErrorReason() *DatasetReportOngoingErrorReasonReportEventInfo {
+	return v.OngoingErrorReason
+}
+
+// GetBackfillErrorReason returns DatasetReport.BackfillErrorReason, and is useful for accessing the field via an interface.
+func (v *DatasetReport) GetBackfillErrorReason() *DatasetReportBackfillErrorReasonReportEventInfo {
+	return v.BackfillErrorReason
+}
+
+// DatasetReportBackfillErrorReasonReportEventInfo includes the requested fields of the GraphQL type ReportEventInfo.
+type DatasetReportBackfillErrorReasonReportEventInfo struct {
+	Text string           `json:"text"`
+	Time types.TimeScalar `json:"time"`
+}
+
+// GetText returns DatasetReportBackfillErrorReasonReportEventInfo.Text, and is useful for accessing the field via an interface.
+func (v *DatasetReportBackfillErrorReasonReportEventInfo) GetText() string { return v.Text }
+
+// GetTime returns DatasetReportBackfillErrorReasonReportEventInfo.Time, and is useful for accessing the field via an interface.
+func (v *DatasetReportBackfillErrorReasonReportEventInfo) GetTime() types.TimeScalar { return v.Time }
+
+// DatasetReportOngoingErrorReasonReportEventInfo includes the requested fields of the GraphQL type ReportEventInfo.
+type DatasetReportOngoingErrorReasonReportEventInfo struct {
+	Text string           `json:"text"`
+	Time types.TimeScalar `json:"time"`
+}
+
+// GetText returns DatasetReportOngoingErrorReasonReportEventInfo.Text, and is useful for accessing the field via an interface.
+func (v *DatasetReportOngoingErrorReasonReportEventInfo) GetText() string { return v.Text }
+
+// GetTime returns DatasetReportOngoingErrorReasonReportEventInfo.Time, and is useful for accessing the field via an interface.
+func (v *DatasetReportOngoingErrorReasonReportEventInfo) GetTime() types.TimeScalar { return v.Time }
+
+// DatasetReportStageNotesDatasetStageNote includes the requested fields of the GraphQL type DatasetStageNote.
+type DatasetReportStageNotesDatasetStageNote struct {
+	StageId  string                                                           `json:"stageId"`
+	Errors   []PipelineSymbol                                                 `json:"errors"`
+	Warnings []DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarning `json:"warnings"`
+}
+
+// GetStageId returns DatasetReportStageNotesDatasetStageNote.StageId, and is useful for accessing the field via an interface.
+func (v *DatasetReportStageNotesDatasetStageNote) GetStageId() string { return v.StageId }
+
+// GetErrors returns DatasetReportStageNotesDatasetStageNote.Errors, and is useful for accessing the field via an interface.
+func (v *DatasetReportStageNotesDatasetStageNote) GetErrors() []PipelineSymbol { return v.Errors }
+
+// GetWarnings returns DatasetReportStageNotesDatasetStageNote.Warnings, and is useful for accessing the field via an interface.
+func (v *DatasetReportStageNotesDatasetStageNote) GetWarnings() []DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarning {
+	return v.Warnings
+}
+
+// DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarning includes the requested fields of the GraphQL type PipelineWarning.
+type DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarning struct {
+	Symbol PipelineSymbol `json:"symbol"`
+}
+
+// GetSymbol returns DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarning.Symbol, and is useful for accessing the field via an interface.
+func (v *DatasetReportStageNotesDatasetStageNoteWarningsPipelineWarning) GetSymbol() PipelineSymbol {
+	return v.Symbol
+}
+
 // DatasetSourceTableSourceTableDefinition includes the requested fields of the GraphQL type SourceTableDefinition.
 type DatasetSourceTableSourceTableDefinition struct {
 	Schema                string                                                                            `json:"schema"`
@@ -1870,6 +2531,123 @@ func (v *DatasetTypedefInput) GetFields() []DatasetFieldDefInput { return v.Fiel
 // GetLinkDesc returns DatasetTypedefInput.LinkDesc, and is useful for accessing the field via an interface.
 func (v *DatasetTypedefInput) GetLinkDesc() *DatasetLinkSchemaInput { return v.LinkDesc }
 
+// Datasource includes the GraphQL fields of Datasource requested by the fragment Datasource.
+type Datasource struct {
+	Id                    string           `json:"id"`
+	WorkspaceId           string           `json:"workspaceId"`
+	FolderId              string           `json:"folderId"`
+	Name                  string           `json:"name"`
+	IconUrl               *string          `json:"iconUrl"`
+	Description           *string          `json:"description"`
+	DataConnectionID      string           `json:"dataConnectionID"`
+	DatastreamID          string           `json:"datastreamID"`
+	DatastreamTokenID     string           `json:"datastreamTokenID"`
+	Type                  string           `json:"type"`
+	Status                DatasourceStatus `json:"status"`
+	Variables             []DataVariable   `json:"variables"`
+	ClientStackAttributes []DataVariable   `json:"clientStackAttributes"`
+}
+
+// GetId returns Datasource.Id, and is useful for accessing the field via an interface.
+func (v *Datasource) GetId() string { return v.Id }
+
+// GetWorkspaceId returns Datasource.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *Datasource) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetFolderId returns Datasource.FolderId, and is useful for accessing the field via an interface.
+func (v *Datasource) GetFolderId() string { return v.FolderId }
+
+// GetName returns Datasource.Name, and is useful for accessing the field via an interface.
+func (v *Datasource) GetName() string { return v.Name }
+
+// GetIconUrl returns Datasource.IconUrl, and is useful for accessing the field via an interface.
+func (v *Datasource) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns Datasource.Description, and is useful for accessing the field via an interface.
+func (v *Datasource) GetDescription() *string { return v.Description }
+
+// GetDataConnectionID returns Datasource.DataConnectionID, and is useful for accessing the field via an interface.
+func (v *Datasource) GetDataConnectionID() string { return v.DataConnectionID }
+
+// GetDatastreamID returns Datasource.DatastreamID, and is useful for accessing the field via an interface.
+func (v *Datasource) GetDatastreamID() string { return v.DatastreamID }
+
+// GetDatastreamTokenID returns Datasource.DatastreamTokenID, and is useful for accessing the field via an interface.
+func (v *Datasource) GetDatastreamTokenID() string { return v.DatastreamTokenID }
+
+// GetType returns Datasource.Type, and is useful for accessing the field via an interface.
+func (v *Datasource) GetType() string { return v.Type }
+
+// GetStatus returns Datasource.Status, and is useful for accessing the field via an interface.
+func (v *Datasource) GetStatus() DatasourceStatus { return v.Status }
+
+// GetVariables returns Datasource.Variables, and is useful for accessing the field via an interface.
+func (v *Datasource) GetVariables() []DataVariable { return v.Variables }
+
+// GetClientStackAttributes returns Datasource.ClientStackAttributes, and is useful for accessing the field via an interface.
+func (v *Datasource) GetClientStackAttributes() []DataVariable { return v.ClientStackAttributes }
+
+type DatasourceInput struct {
+	DataConnectionID      string              `json:"dataConnectionID"`
+	DatastreamID          string              `json:"datastreamID"`
+	DatastreamTokenID     string              `json:"datastreamTokenID"`
+	ClientStackAttributes []DataVariableInput `json:"clientStackAttributes"`
+	Variables             []DataVariableInput `json:"variables"`
+	Name                  string              `json:"name"`
+	IconUrl               *string             `json:"iconUrl,omitempty"`
+	Description           *string             `json:"description,omitempty"`
+	ManagedById           *string             `json:"managedById,omitempty"`
+	FolderId              *string             `json:"folderId,omitempty"`
+}
+
+// GetDataConnectionID returns DatasourceInput.DataConnectionID, and is useful for accessing the field via an interface.
+func (v *DatasourceInput) GetDataConnectionID() string { return v.DataConnectionID }
+
+// GetDatastreamID returns DatasourceInput.DatastreamID, and is useful for accessing the field via an interface.
+func (v *DatasourceInput) GetDatastreamID() string { return v.DatastreamID }
+
+// GetDatastreamTokenID returns DatasourceInput.DatastreamTokenID, and is useful for accessing the field via an interface.
+func (v *DatasourceInput) GetDatastreamTokenID() string { return v.DatastreamTokenID }
+
+// GetClientStackAttributes returns DatasourceInput.ClientStackAttributes, and is useful for accessing the field via an interface.
+func (v *DatasourceInput) GetClientStackAttributes() []DataVariableInput {
+	return v.ClientStackAttributes
+}
+
+// GetVariables returns DatasourceInput.Variables, and is useful for accessing the field via an interface.
+func (v *DatasourceInput) GetVariables() []DataVariableInput { return v.Variables }
+
+// GetName returns DatasourceInput.Name, and is useful for accessing the field via an interface.
+func (v *DatasourceInput) GetName() string { return v.Name }
+
+// GetIconUrl returns DatasourceInput.IconUrl, and is useful for accessing the field via an interface.
+func (v *DatasourceInput) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns DatasourceInput.Description, and is useful for accessing the field via an interface.
+func (v *DatasourceInput) GetDescription() *string { return v.Description }
+
+// GetManagedById returns DatasourceInput.ManagedById, and is useful for accessing the field via an interface.
+func (v *DatasourceInput) GetManagedById() *string { return v.ManagedById }
+
+// GetFolderId returns DatasourceInput.FolderId, and is useful for accessing the field via an interface.
+func (v *DatasourceInput) GetFolderId() *string { return v.FolderId }
+
+type DatasourceState string
+
+const (
+	DatasourceStateError   DatasourceState = "Error"
+	DatasourceStatePending DatasourceState = "Pending"
+	DatasourceStateRunning DatasourceState = "Running"
+)
+
+// DatasourceStatus includes the requested fields of the GraphQL type DatasourceStatus.
+type DatasourceStatus struct {
+	State DatasourceState `json:"state"`
+}
+
+// GetState returns DatasourceStatus.State, and is useful for accessing the field via an interface.
+func (v *DatasourceStatus) GetState() DatasourceState { return v.State }
+
 // Datastream includes the GraphQL fields of Datastream requested by the fragment Datastream.
 type Datastream struct {
 	Id          string  `json:"id"`
@@ -2688,11 +3466,12 @@ const (
 
 // Folder includes the GraphQL fields of Folder requested by the fragment Folder.
 type Folder struct {
-	Id          string  `json:"id"`
-	Name        string  `json:"name"`
-	IconUrl     *string `json:"iconUrl"`
-	Description *string `json:"description"`
-	WorkspaceId string  `json:"workspaceId"`
+	Id                string                               `json:"id"`
+	Name              string                               `json:"name"`
+	IconUrl           *string                              `json:"iconUrl"`
+	Description       *string                              `json:"description"`
+	WorkspaceId       string                               `json:"workspaceId"`
+	EffectiveSettings FolderEffectiveSettingsLayeredFolder `json:"effectiveSettings"`
 }
 
 // GetId returns Folder.Id, and is useful for accessing the field via an interface.
@@ -2710,6 +3489,31 @@ func (v *Folder) GetDescription() *string { return v.Description }
 // GetWorkspaceId returns Folder.WorkspaceId, and is useful for accessing the field via an interface.
 func (v *Folder) GetWorkspaceId() string { return v.WorkspaceId }
 
+// GetEffectiveSettings returns Folder.EffectiveSettings, and is useful for accessing the field via an interface.
+func (v *Folder) GetEffectiveSettings() FolderEffectiveSettingsLayeredFolder {
+	return v.EffectiveSettings
+}
+
+// FolderEffectiveSettingsLayeredFolder includes the requested fields of the GraphQL type LayeredFolder.
+type FolderEffectiveSettingsLayeredFolder struct {
+	Scanner FolderEffectiveSettingsLayeredFolderScanner `json:"scanner"`
+}
+
+// GetScanner returns FolderEffectiveSettingsLayeredFolder.Scanner, and is useful for accessing the field via an interface.
+func (v *FolderEffectiveSettingsLayeredFolder) GetScanner() FolderEffectiveSettingsLayeredFolderScanner {
+	return v.Scanner
+}
+
+// FolderEffectiveSettingsLayeredFolderScanner includes the requested fields of the GraphQL type LayeredFolderScanner.
+type FolderEffectiveSettingsLayeredFolderScanner struct {
+	PowerLevel *types.Int64Scalar `json:"powerLevel"`
+}
+
+// GetPowerLevel returns FolderEffectiveSettingsLayeredFolderScanner.PowerLevel, and is useful for accessing the field via an interface.
+func (v *FolderEffectiveSettingsLayeredFolderScanner) GetPowerLevel() *types.Int64Scalar {
+	return v.PowerLevel
+}
+
 type FolderInput struct {
 	Name             *string             `json:"name"`
 	Description      *string             `json:"description"`
@@ -2765,29 +3569,155 @@ func (v *HttpRequestConfig) GetHeaders() *types.JsonObject { return v.Headers }
 // GetParams returns HttpRequestConfig.Params, and is useful for accessing the field via an interface.
 func (v *HttpRequestConfig) GetParams() *types.JsonObject { return v.Params }
 
-// IngestInfo includes the GraphQL fields of IngestInfo requested by the fragment IngestInfo.
-// The GraphQL type's documentation follows.
-//
-// Information on the data ingestion endpoint, full URL format is:
-// <scheme>://<customerId>.collect.<domain>:<port>/
-type IngestInfo struct {
-	CollectUrl string `json:"collectUrl"`
-	Domain     string `json:"domain"`
-	Scheme     string `json:"scheme"`
-	Port       string `json:"port"`
+// Incident includes the GraphQL fields of Incident requested by the fragment Incident.
+type Incident struct {
+	Id            string                                      `json:"id"`
+	WorkspaceId   string                                      `json:"workspaceId"`
+	FolderId      string                                      `json:"folderId"`
+	Name          string                                      `json:"name"`
+	IconUrl       *string                                     `json:"iconUrl"`
+	Description   *string                                     `json:"description"`
+	Status        IncidentStatus                              `json:"status"`
+	InactiveTime  *types.TimeScalar                           `json:"inactiveTime"`
+	ClosedTime    *types.TimeScalar                           `json:"closedTime"`
+	Users         []IncidentUsersUserInfo                     `json:"users"`
+	SlackChannels []IncidentSlackChannelsIncidentSlackchannel `json:"slackChannels"`
+	Worksheets    []string                                    `json:"worksheets"`
+	Dashboards    []string                                    `json:"dashboards"`
 }
 
-// GetCollectUrl returns IngestInfo.CollectUrl, and is useful for accessing the field via an interface.
-func (v *IngestInfo) GetCollectUrl() string { return v.CollectUrl }
+// GetId returns Incident.Id, and is useful for accessing the field via an interface.
+func (v *Incident) GetId() string { return v.Id }
 
-// GetDomain returns IngestInfo.Domain, and is useful for accessing the field via an interface.
-func (v *IngestInfo) GetDomain() string { return v.Domain }
+// GetWorkspaceId returns Incident.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *Incident) GetWorkspaceId() string { return v.WorkspaceId }
 
-// GetScheme returns IngestInfo.Scheme, and is useful for accessing the field via an interface.
-func (v *IngestInfo) GetScheme() string { return v.Scheme }
+// GetFolderId returns Incident.FolderId, and is useful for accessing the field via an interface.
+func (v *Incident) GetFolderId() string { return v.FolderId }
 
-// GetPort returns IngestInfo.Port, and is useful for accessing the field via an interface.
-func (v *IngestInfo) GetPort() string { return v.Port }
+// GetName returns Incident.Name, and is useful for accessing the field via an interface.
+func (v *Incident) GetName() string { return v.Name }
+
+// GetIconUrl returns Incident.IconUrl, and is useful for accessing the field via an interface.
+func (v *Incident) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns Incident.Description, and is useful for accessing the field via an interface.
+func (v *Incident) GetDescription() *string { return v.Description }
+
+// GetStatus returns Incident.Status, and is useful for accessing the field via an interface.
+func (v *Incident) GetStatus() IncidentStatus { return v.Status }
+
+// GetInactiveTime returns Incident.InactiveTime, and is useful for accessing the field via an interface.
+func (v *Incident) GetInactiveTime() *types.TimeScalar { return v.InactiveTime }
+
+// GetClosedTime returns Incident.ClosedTime, and is useful for accessing the field via an interface.
+func (v *Incident) GetClosedTime() *types.TimeScalar { return v.ClosedTime }
+
+// GetUsers returns Incident.Users, and is useful for accessing the field via an interface.
+func (v *Incident) GetUsers() []IncidentUsersUserInfo { return v.Users }
+
+// GetSlackChannels returns Incident.SlackChannels, and is useful for accessing the field via an interface.
+func (v *Incident) GetSlackChannels() []IncidentSlackChannelsIncidentSlackchannel {
+	return v.SlackChannels
+}
+
+// GetWorksheets returns Incident.Worksheets, and is useful for accessing the field via an interface.
+func (v *Incident) GetWorksheets() []string { return v.Worksheets }
+
+// GetDashboards returns Incident.Dashboards, and is useful for accessing the field via an interface.
+func (v *Incident) GetDashboards() []string { return v.Dashboards }
+
+type IncidentInput struct {
+	Status      IncidentStatus `json:"status"`
+	Name        string         `json:"name"`
+	IconUrl     *string        `json:"iconUrl,omitempty"`
+	Description *string        `json:"description,omitempty"`
+	ManagedById *string        `json:"managedById,omitempty"`
+	FolderId    *string        `json:"folderId,omitempty"`
+}
+
+// GetStatus returns IncidentInput.Status, and is useful for accessing the field via an interface.
+func (v *IncidentInput) GetStatus() IncidentStatus { return v.Status }
+
+// GetName returns IncidentInput.Name, and is useful for accessing the field via an interface.
+func (v *IncidentInput) GetName() string { return v.Name }
+
+// GetIconUrl returns IncidentInput.IconUrl, and is useful for accessing the field via an interface.
+func (v *IncidentInput) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns IncidentInput.Description, and is useful for accessing the field via an interface.
+func (v *IncidentInput) GetDescription() *string { return v.Description }
+
+// GetManagedById returns IncidentInput.ManagedById, and is useful for accessing the field via an interface.
+func (v *IncidentInput) GetManagedById() *string { return v.ManagedById }
+
+// GetFolderId returns IncidentInput.FolderId, and is useful for accessing the field via an interface.
+func (v *IncidentInput) GetFolderId() *string { return v.FolderId }
+
+// IncidentSlackChannelsIncidentSlackchannel includes the requested fields of the GraphQL type IncidentSlackchannel.
+type IncidentSlackChannelsIncidentSlackchannel struct {
+	ConnectionID   string `json:"connectionID"`
+	SlackchannelID string `json:"slackchannelID"`
+}
+
+// GetConnectionID returns IncidentSlackChannelsIncidentSlackchannel.ConnectionID, and is useful for accessing the field via an interface.
+func (v *IncidentSlackChannelsIncidentSlackchannel) GetConnectionID() string { return v.ConnectionID }
+
+// GetSlackchannelID returns IncidentSlackChannelsIncidentSlackchannel.SlackchannelID, and is useful for accessing the field via an interface.
+func (v *IncidentSlackChannelsIncidentSlackchannel) GetSlackchannelID() string {
+	return v.SlackchannelID
+}
+
+type IncidentSlackchannelInput struct {
+	ConnectionID   string `json:"connectionID"`
+	SlackchannelID string `json:"slackchannelID"`
+}
+
+// GetConnectionID returns IncidentSlackchannelInput.ConnectionID, and is useful for accessing the field via an interface.
+func (v *IncidentSlackchannelInput) GetConnectionID() string { return v.ConnectionID }
+
+// GetSlackchannelID returns IncidentSlackchannelInput.SlackchannelID, and is useful for accessing the field via an interface.
+func (v *IncidentSlackchannelInput) GetSlackchannelID() string { return v.SlackchannelID }
+
+type IncidentStatus string
+
+const (
+	IncidentStatusActive   IncidentStatus = "Active"
+	IncidentStatusClosed   IncidentStatus = "Closed"
+	IncidentStatusInactive IncidentStatus = "Inactive"
+)
+
+// IncidentUsersUserInfo includes the requested fields of the GraphQL type UserInfo.
+type IncidentUsersUserInfo struct {
+	UserId types.UserIdScalar `json:"userId"`
+}
+
+// GetUserId returns IncidentUsersUserInfo.UserId, and is useful for accessing the field via an interface.
+func (v *IncidentUsersUserInfo) GetUserId() types.UserIdScalar { return v.UserId }
+
+// IngestInfo includes the GraphQL fields of IngestInfo requested by the fragment IngestInfo.
+// The GraphQL type's documentation follows.
+//
+// Information on the data ingestion endpoint, full URL format is:
+// <scheme>://<customerId>.collect.<domain>:<port>/
+type IngestInfo struct {
+	CollectUrl string `json:"collectUrl"`
+	Domain     string `json:"domain"`
+	Scheme     string `json:"scheme"`
+	Port       string `json:"port"`
+}
+
+// GetCollectUrl returns IngestInfo.CollectUrl, and is useful for accessing the field via an interface.
+func (v *IngestInfo) GetCollectUrl() string { return v.CollectUrl }
+
+// GetDomain returns IngestInfo.Domain, and is useful for accessing the field via an interface.
+func (v *IngestInfo) GetDomain() string { return v.Domain }
+
+// GetScheme returns IngestInfo.Scheme, and is useful for accessing the field via an interface.
+func (v *IngestInfo) GetScheme() string { return v.Scheme }
+
+// GetPort returns IngestInfo.Port, and is useful for accessing the field via an interface.
+func (v *IngestInfo) GetPort() string { return v.Port }
 
 type InputDefinitionInput struct {
 	// Assign the short and unique user mnemonic for this input, used in @tableref expressions
@@ -2861,6 +3791,124 @@ const (
 	InputRoleReference InputRole = "Reference"
 )
 
+type InputSearchInput struct {
+	// name is a dataset path, which gets resolved to ID before matching. Not resolved means no match.
+	Name []string `json:"name"`
+	Id   []string `json:"id"`
+}
+
+// GetName returns InputSearchInput.Name, and is useful for accessing the field via an interface.
+func (v *InputSearchInput) GetName() []string { return v.Name }
+
+// GetId returns InputSearchInput.Id, and is useful for accessing the field via an interface.
+func (v *InputSearchInput) GetId() []string { return v.Id }
+
+// InvestigationNotebook includes the GraphQL fields of InvestigationNotebook requested by the fragment InvestigationNotebook.
+type InvestigationNotebook struct {
+	Id          string  `json:"id"`
+	WorkspaceId string  `json:"workspaceId"`
+	FolderId    string  `json:"folderId"`
+	Name        string  `json:"name"`
+	IconUrl     *string `json:"iconUrl"`
+	Description *string `json:"description"`
+	// The ID of the incident that this notebook is associated with. We will allocate a new Incident automatically if not specified on create.
+	IncidentID *string `json:"incidentID"`
+	// The runbook associated with this notebook (if any)
+	Runbook *InvestigationNotebookRunbookNotebookRunbookInfo `json:"runbook"`
+	// The list of blocks in this notebook
+	Blocks []NotebookBlock `json:"blocks"`
+}
+
+// GetId returns InvestigationNotebook.Id, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebook) GetId() string { return v.Id }
+
+// GetWorkspaceId returns InvestigationNotebook.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebook) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetFolderId returns InvestigationNotebook.FolderId, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebook) GetFolderId() string { return v.FolderId }
+
+// GetName returns InvestigationNotebook.Name, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebook) GetName() string { return v.Name }
+
+// GetIconUrl returns InvestigationNotebook.IconUrl, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebook) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns InvestigationNotebook.Description, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebook) GetDescription() *string { return v.Description }
+
+// GetIncidentID returns InvestigationNotebook.IncidentID, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebook) GetIncidentID() *string { return v.IncidentID }
+
+// GetRunbook returns InvestigationNotebook.Runbook, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebook) GetRunbook() *InvestigationNotebookRunbookNotebookRunbookInfo {
+	return v.Runbook
+}
+
+// GetBlocks returns InvestigationNotebook.Blocks, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebook) GetBlocks() []NotebookBlock { return v.Blocks }
+
+type InvestigationNotebookInput struct {
+	Alert       *NotebookAlertInfoInput   `json:"alert,omitempty"`
+	Runbook     *NotebookRunbookInfoInput `json:"runbook,omitempty"`
+	IncidentID  *string                   `json:"incidentID,omitempty"`
+	Blocks      []NotebookBlockInput      `json:"blocks"`
+	Name        string                    `json:"name"`
+	IconUrl     *string                   `json:"iconUrl,omitempty"`
+	Description *string                   `json:"description,omitempty"`
+	ManagedById *string                   `json:"managedById,omitempty"`
+	FolderId    *string                   `json:"folderId,omitempty"`
+}
+
+// GetAlert returns InvestigationNotebookInput.Alert, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebookInput) GetAlert() *NotebookAlertInfoInput { return v.Alert }
+
+// GetRunbook returns InvestigationNotebookInput.Runbook, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebookInput) GetRunbook() *NotebookRunbookInfoInput { return v.Runbook }
+
+// GetIncidentID returns InvestigationNotebookInput.IncidentID, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebookInput) GetIncidentID() *string { return v.IncidentID }
+
+// GetBlocks returns InvestigationNotebookInput.Blocks, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebookInput) GetBlocks() []NotebookBlockInput { return v.Blocks }
+
+// GetName returns InvestigationNotebookInput.Name, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebookInput) GetName() string { return v.Name }
+
+// GetIconUrl returns InvestigationNotebookInput.IconUrl, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebookInput) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns InvestigationNotebookInput.Description, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebookInput) GetDescription() *string { return v.Description }
+
+// GetManagedById returns InvestigationNotebookInput.ManagedById, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebookInput) GetManagedById() *string { return v.ManagedById }
+
+// GetFolderId returns InvestigationNotebookInput.FolderId, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebookInput) GetFolderId() *string { return v.FolderId }
+
+// InvestigationNotebookRunbookNotebookRunbookInfo includes the requested fields of the GraphQL type NotebookRunbookInfo.
+type InvestigationNotebookRunbookNotebookRunbookInfo struct {
+	Url string `json:"url"`
+}
+
+// GetUrl returns InvestigationNotebookRunbookNotebookRunbookInfo.Url, and is useful for accessing the field via an interface.
+func (v *InvestigationNotebookRunbookNotebookRunbookInfo) GetUrl() string { return v.Url }
+
+type LayeredFolderInput struct {
+	Scanner *LayeredFolderScannerInput `json:"scanner"`
+}
+
+// GetScanner returns LayeredFolderInput.Scanner, and is useful for accessing the field via an interface.
+func (v *LayeredFolderInput) GetScanner() *LayeredFolderScannerInput { return v.Scanner }
+
+type LayeredFolderScannerInput struct {
+	PowerLevel *types.Int64Scalar `json:"powerLevel"`
+}
+
+// GetPowerLevel returns LayeredFolderScannerInput.PowerLevel, and is useful for accessing the field via an interface.
+func (v *LayeredFolderScannerInput) GetPowerLevel() *types.Int64Scalar { return v.PowerLevel }
+
 // LayeredSettingRecord includes the GraphQL fields of LayeredSettingRecord requested by the fragment LayeredSettingRecord.
 // The GraphQL type's documentation follows.
 //
@@ -3789,80 +4837,176 @@ func (v *MonitorInput) GetNotificationSpec() *NotificationSpecificationInput {
 // GetChannels returns MonitorInput.Channels, and is useful for accessing the field via an interface.
 func (v *MonitorInput) GetChannels() []string { return v.Channels }
 
-// MonitorNotificationSpecNotificationSpecification includes the requested fields of the GraphQL type NotificationSpecification.
-type MonitorNotificationSpecNotificationSpecification struct {
-	// should these go in each applicable Rule instead?
-	Merge             *NotificationMerge     `json:"merge"`
-	Importance        NotificationImportance `json:"importance"`
-	NotifyOnReminder  *bool                  `json:"notifyOnReminder"`
-	ReminderFrequency types.DurationScalar   `json:"reminderFrequency"`
-	NotifyOnClose     *bool                  `json:"notifyOnClose"`
+// MonitorMuteRule includes the GraphQL fields of MonitorMuteRule requested by the fragment MonitorMuteRule.
+type MonitorMuteRule struct {
+	Id          string  `json:"id"`
+	WorkspaceId string  `json:"workspaceId"`
+	FolderId    string  `json:"folderId"`
+	Name        string  `json:"name"`
+	IconUrl     *string `json:"iconUrl"`
+	Description *string `json:"description"`
+	// Apply the mute rule from this date, defaulting to now when missing.
+	StartDate *types.TimeScalar `json:"startDate"`
+	// How long the mute rule will apply, starting from startDate. Empty duration mutes the monitor indefinitely.
+	Duration *types.DurationScalar                             `json:"duration"`
+	Filter   *MonitorMuteRuleFilterMonitorMuteRuleFilterObject `json:"-"`
 }
 
-// GetMerge returns MonitorNotificationSpecNotificationSpecification.Merge, and is useful for accessing the field via an interface.
-func (v *MonitorNotificationSpecNotificationSpecification) GetMerge() *NotificationMerge {
-	return v.Merge
+// GetId returns MonitorMuteRule.Id, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetId() string { return v.Id }
+
+// GetWorkspaceId returns MonitorMuteRule.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetFolderId returns MonitorMuteRule.FolderId, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetFolderId() string { return v.FolderId }
+
+// GetName returns MonitorMuteRule.Name, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetName() string { return v.Name }
+
+// GetIconUrl returns MonitorMuteRule.IconUrl, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns MonitorMuteRule.Description, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetDescription() *string { return v.Description }
+
+// GetStartDate returns MonitorMuteRule.StartDate, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetStartDate() *types.TimeScalar { return v.StartDate }
+
+// GetDuration returns MonitorMuteRule.Duration, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetDuration() *types.DurationScalar { return v.Duration }
+
+// GetFilter returns MonitorMuteRule.Filter, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRule) GetFilter() *MonitorMuteRuleFilterMonitorMuteRuleFilterObject {
+	return v.Filter
 }
 
-// GetImportance returns MonitorNotificationSpecNotificationSpecification.Importance, and is useful for accessing the field via an interface.
-func (v *MonitorNotificationSpecNotificationSpecification) GetImportance() NotificationImportance {
-	return v.Importance
+func (v *MonitorMuteRule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*MonitorMuteRule
+		Filter json.RawMessage `json:"filter"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.MonitorMuteRule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Filter
+		src := firstPass.Filter
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(MonitorMuteRuleFilterMonitorMuteRuleFilterObject)
+			err = __unmarshalMonitorMuteRuleFilterMonitorMuteRuleFilterObject(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal MonitorMuteRule.Filter: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetNotifyOnReminder returns MonitorNotificationSpecNotificationSpecification.NotifyOnReminder, and is useful for accessing the field via an interface.
-func (v *MonitorNotificationSpecNotificationSpecification) GetNotifyOnReminder() *bool {
-	return v.NotifyOnReminder
+type __premarshalMonitorMuteRule struct {
+	Id string `json:"id"`
+
+	WorkspaceId string `json:"workspaceId"`
+
+	FolderId string `json:"folderId"`
+
+	Name string `json:"name"`
+
+	IconUrl *string `json:"iconUrl"`
+
+	Description *string `json:"description"`
+
+	StartDate *types.TimeScalar `json:"startDate"`
+
+	Duration *types.DurationScalar `json:"duration"`
+
+	Filter json.RawMessage `json:"filter"`
 }
 
-// GetReminderFrequency returns MonitorNotificationSpecNotificationSpecification.ReminderFrequency, and is useful for accessing the field via an interface.
-func (v *MonitorNotificationSpecNotificationSpecification) GetReminderFrequency() types.DurationScalar {
-	return v.ReminderFrequency
+func (v *MonitorMuteRule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetNotifyOnClose returns MonitorNotificationSpecNotificationSpecification.NotifyOnClose, and is useful for accessing the field via an interface.
-func (v *MonitorNotificationSpecNotificationSpecification) GetNotifyOnClose() *bool {
-	return v.NotifyOnClose
+func (v *MonitorMuteRule) __premarshalJSON() (*__premarshalMonitorMuteRule, error) {
+	var retval __premarshalMonitorMuteRule
+
+	retval.Id = v.Id
+	retval.WorkspaceId = v.WorkspaceId
+	retval.FolderId = v.FolderId
+	retval.Name = v.Name
+	retval.IconUrl = v.IconUrl
+	retval.Description = v.Description
+	retval.StartDate = v.StartDate
+	retval.Duration = v.Duration
+	{
+
+		dst := &retval.Filter
+		src := v.Filter
+		if src != nil {
+			var err error
+			*dst, err = __marshalMonitorMuteRuleFilterMonitorMuteRuleFilterObject(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal MonitorMuteRule.Filter: %w", err)
+			}
+		}
+	}
+	return &retval, nil
 }
 
-// MonitorQueryMultiStageQuery includes the requested fields of the GraphQL type MultiStageQuery.
-type MonitorQueryMultiStageQuery struct {
-	OutputStage string       `json:"outputStage"`
-	Stages      []StageQuery `json:"stages"`
+// MonitorMuteRuleFilterMonitorMuteRuleFilterAll includes the requested fields of the GraphQL type MonitorMuteRuleFilterAll.
+type MonitorMuteRuleFilterMonitorMuteRuleFilterAll struct {
+	Typename   *string                   `json:"__typename"`
+	FilterType MonitorMuteRuleFilterType `json:"filterType"`
 }
 
-// GetOutputStage returns MonitorQueryMultiStageQuery.OutputStage, and is useful for accessing the field via an interface.
-func (v *MonitorQueryMultiStageQuery) GetOutputStage() string { return v.OutputStage }
+// GetTypename returns MonitorMuteRuleFilterMonitorMuteRuleFilterAll.Typename, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterAll) GetTypename() *string { return v.Typename }
 
-// GetStages returns MonitorQueryMultiStageQuery.Stages, and is useful for accessing the field via an interface.
-func (v *MonitorQueryMultiStageQuery) GetStages() []StageQuery { return v.Stages }
+// GetFilterType returns MonitorMuteRuleFilterMonitorMuteRuleFilterAll.FilterType, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterAll) GetFilterType() MonitorMuteRuleFilterType {
+	return v.FilterType
+}
 
-// MonitorRule includes the requested fields of the GraphQL interface MonitorRule.
+// MonitorMuteRuleFilterMonitorMuteRuleFilterObject includes the requested fields of the GraphQL interface MonitorMuteRuleFilterObject.
 //
-// MonitorRule is implemented by the following types:
-// MonitorRuleMonitorRuleChange
-// MonitorRuleMonitorRuleCount
-// MonitorRuleMonitorRuleFacet
-// MonitorRuleMonitorRuleLog
-// MonitorRuleMonitorRulePromote
-// MonitorRuleMonitorRuleThreshold
-type MonitorRule interface {
-	implementsGraphQLInterfaceMonitorRule()
+// MonitorMuteRuleFilterMonitorMuteRuleFilterObject is implemented by the following types:
+// MonitorMuteRuleFilterMonitorMuteRuleFilterAll
+// MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn
+// MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource
+type MonitorMuteRuleFilterMonitorMuteRuleFilterObject interface {
+	implementsGraphQLInterfaceMonitorMuteRuleFilterMonitorMuteRuleFilterObject()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
-	// GetSourceColumn returns the interface-field "sourceColumn" from its implementation.
-	GetSourceColumn() string
-	// GetGroupByGroups returns the interface-field "groupByGroups" from its implementation.
-	GetGroupByGroups() []MonitorRuleGroupByGroupsMonitorGroupInfo
+	// GetFilterType returns the interface-field "filterType" from its implementation.
+	GetFilterType() MonitorMuteRuleFilterType
 }
 
-func (v *MonitorRuleMonitorRuleChange) implementsGraphQLInterfaceMonitorRule()    {}
-func (v *MonitorRuleMonitorRuleCount) implementsGraphQLInterfaceMonitorRule()     {}
-func (v *MonitorRuleMonitorRuleFacet) implementsGraphQLInterfaceMonitorRule()     {}
-func (v *MonitorRuleMonitorRuleLog) implementsGraphQLInterfaceMonitorRule()       {}
-func (v *MonitorRuleMonitorRulePromote) implementsGraphQLInterfaceMonitorRule()   {}
-func (v *MonitorRuleMonitorRuleThreshold) implementsGraphQLInterfaceMonitorRule() {}
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterAll) implementsGraphQLInterfaceMonitorMuteRuleFilterMonitorMuteRuleFilterObject() {
+}
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn) implementsGraphQLInterfaceMonitorMuteRuleFilterMonitorMuteRuleFilterObject() {
+}
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource) implementsGraphQLInterfaceMonitorMuteRuleFilterMonitorMuteRuleFilterObject() {
+}
 
-func __unmarshalMonitorRule(b []byte, v *MonitorRule) error {
+func __unmarshalMonitorMuteRuleFilterMonitorMuteRuleFilterObject(b []byte, v *MonitorMuteRuleFilterMonitorMuteRuleFilterObject) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -3876,55 +5020,344 @@ func __unmarshalMonitorRule(b []byte, v *MonitorRule) error {
 	}
 
 	switch tn.TypeName {
-	case "MonitorRuleChange":
-		*v = new(MonitorRuleMonitorRuleChange)
-		return json.Unmarshal(b, *v)
-	case "MonitorRuleCount":
-		*v = new(MonitorRuleMonitorRuleCount)
-		return json.Unmarshal(b, *v)
-	case "MonitorRuleFacet":
-		*v = new(MonitorRuleMonitorRuleFacet)
-		return json.Unmarshal(b, *v)
-	case "MonitorRuleLog":
-		*v = new(MonitorRuleMonitorRuleLog)
+	case "MonitorMuteRuleFilterAll":
+		*v = new(MonitorMuteRuleFilterMonitorMuteRuleFilterAll)
 		return json.Unmarshal(b, *v)
-	case "MonitorRulePromote":
-		*v = new(MonitorRuleMonitorRulePromote)
+	case "MonitorMuteRuleFilterPerColumn":
+		*v = new(MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn)
 		return json.Unmarshal(b, *v)
-	case "MonitorRuleThreshold":
-		*v = new(MonitorRuleMonitorRuleThreshold)
+	case "MonitorMuteRuleFilterPerResource":
+		*v = new(MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing MonitorRule.__typename")
+			"response was missing MonitorMuteRuleFilterObject.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for MonitorRule: "%v"`, tn.TypeName)
+			`unexpected concrete type for MonitorMuteRuleFilterMonitorMuteRuleFilterObject: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalMonitorRule(v *MonitorRule) ([]byte, error) {
+func __marshalMonitorMuteRuleFilterMonitorMuteRuleFilterObject(v *MonitorMuteRuleFilterMonitorMuteRuleFilterObject) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *MonitorRuleMonitorRuleChange:
-		typename = "MonitorRuleChange"
+	case *MonitorMuteRuleFilterMonitorMuteRuleFilterAll:
+		typename = "MonitorMuteRuleFilterAll"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*MonitorRuleMonitorRuleChange
+			*MonitorMuteRuleFilterMonitorMuteRuleFilterAll
 		}{typename, v}
 		return json.Marshal(result)
-	case *MonitorRuleMonitorRuleCount:
-		typename = "MonitorRuleCount"
+	case *MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn:
+		typename = "MonitorMuteRuleFilterPerColumn"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*MonitorRuleMonitorRuleCount
+			*MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn
 		}{typename, v}
 		return json.Marshal(result)
-	case *MonitorRuleMonitorRuleFacet:
-		typename = "MonitorRuleFacet"
+	case *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource:
+		typename = "MonitorMuteRuleFilterPerResource"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for MonitorMuteRuleFilterMonitorMuteRuleFilterObject: "%T"`, v)
+	}
+}
+
+// MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn includes the requested fields of the GraphQL type MonitorMuteRuleFilterPerColumn.
+type MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn struct {
+	Typename   *string                   `json:"__typename"`
+	FilterType MonitorMuteRuleFilterType `json:"filterType"`
+	ColumnID   string                    `json:"columnID"`
+	Values     []string                  `json:"values"`
+}
+
+// GetTypename returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn.Typename, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn) GetTypename() *string {
+	return v.Typename
+}
+
+// GetFilterType returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn.FilterType, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn) GetFilterType() MonitorMuteRuleFilterType {
+	return v.FilterType
+}
+
+// GetColumnID returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn.ColumnID, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn) GetColumnID() string { return v.ColumnID }
+
+// GetValues returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn.Values, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn) GetValues() []string { return v.Values }
+
+// MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource includes the requested fields of the GraphQL type MonitorMuteRuleFilterPerResource.
+type MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource struct {
+	Typename   *string                   `json:"__typename"`
+	FilterType MonitorMuteRuleFilterType `json:"filterType"`
+	// resourceIds expect datasetId and the primaryKeyValue.name to be the same across all provided resource ids objects.
+	ResourceIds []MonitorMuteRuleResourceId `json:"resourceIds"`
+}
+
+// GetTypename returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource.Typename, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource) GetTypename() *string {
+	return v.Typename
+}
+
+// GetFilterType returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource.FilterType, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource) GetFilterType() MonitorMuteRuleFilterType {
+	return v.FilterType
+}
+
+// GetResourceIds returns MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource.ResourceIds, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource) GetResourceIds() []MonitorMuteRuleResourceId {
+	return v.ResourceIds
+}
+
+type MonitorMuteRuleFilterPerColumnInput struct {
+	ColumnID string   `json:"columnID"`
+	Values   []string `json:"values"`
+}
+
+// GetColumnID returns MonitorMuteRuleFilterPerColumnInput.ColumnID, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterPerColumnInput) GetColumnID() string { return v.ColumnID }
+
+// GetValues returns MonitorMuteRuleFilterPerColumnInput.Values, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterPerColumnInput) GetValues() []string { return v.Values }
+
+type MonitorMuteRuleFilterPerResourceInput struct {
+	ResourceIds []ResourceIdInput `json:"resourceIds"`
+}
+
+// GetResourceIds returns MonitorMuteRuleFilterPerResourceInput.ResourceIds, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleFilterPerResourceInput) GetResourceIds() []ResourceIdInput {
+	return v.ResourceIds
+}
+
+type MonitorMuteRuleFilterType string
+
+const (
+	MonitorMuteRuleFilterTypeFilterall         MonitorMuteRuleFilterType = "FilterAll"
+	MonitorMuteRuleFilterTypeFilterpercolumn   MonitorMuteRuleFilterType = "FilterPerColumn"
+	MonitorMuteRuleFilterTypeFilterperresource MonitorMuteRuleFilterType = "FilterPerResource"
+)
+
+type MonitorMuteRuleInput struct {
+	StartDate         *types.TimeScalar                      `json:"startDate,omitempty"`
+	Duration          *types.DurationScalar                  `json:"duration,omitempty"`
+	FilterPerColumn   *MonitorMuteRuleFilterPerColumnInput   `json:"filterPerColumn,omitempty"`
+	FilterPerResource *MonitorMuteRuleFilterPerResourceInput `json:"filterPerResource,omitempty"`
+	WorkspaceId       string                                 `json:"workspaceId"`
+	Name              string                                 `json:"name"`
+	IconUrl           *string                                `json:"iconUrl,omitempty"`
+	Description       *string                                `json:"description,omitempty"`
+	ManagedById       *string                                `json:"managedById,omitempty"`
+	FolderId          *string                                `json:"folderId,omitempty"`
+}
+
+// GetStartDate returns MonitorMuteRuleInput.StartDate, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetStartDate() *types.TimeScalar { return v.StartDate }
+
+// GetDuration returns MonitorMuteRuleInput.Duration, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetDuration() *types.DurationScalar { return v.Duration }
+
+// GetFilterPerColumn returns MonitorMuteRuleInput.FilterPerColumn, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetFilterPerColumn() *MonitorMuteRuleFilterPerColumnInput {
+	return v.FilterPerColumn
+}
+
+// GetFilterPerResource returns MonitorMuteRuleInput.FilterPerResource, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetFilterPerResource() *MonitorMuteRuleFilterPerResourceInput {
+	return v.FilterPerResource
+}
+
+// GetWorkspaceId returns MonitorMuteRuleInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetName returns MonitorMuteRuleInput.Name, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetName() string { return v.Name }
+
+// GetIconUrl returns MonitorMuteRuleInput.IconUrl, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns MonitorMuteRuleInput.Description, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetDescription() *string { return v.Description }
+
+// GetManagedById returns MonitorMuteRuleInput.ManagedById, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetManagedById() *string { return v.ManagedById }
+
+// GetFolderId returns MonitorMuteRuleInput.FolderId, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleInput) GetFolderId() *string { return v.FolderId }
+
+// MonitorMuteRuleResourceId includes the GraphQL fields of ResourceId requested by the fragment MonitorMuteRuleResourceId.
+type MonitorMuteRuleResourceId struct {
+	DatasetId       string                                                   `json:"datasetId"`
+	PrimaryKeyValue []MonitorMuteRuleResourceIdPrimaryKeyValueColumnAndValue `json:"primaryKeyValue"`
+}
+
+// GetDatasetId returns MonitorMuteRuleResourceId.DatasetId, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleResourceId) GetDatasetId() string { return v.DatasetId }
+
+// GetPrimaryKeyValue returns MonitorMuteRuleResourceId.PrimaryKeyValue, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleResourceId) GetPrimaryKeyValue() []MonitorMuteRuleResourceIdPrimaryKeyValueColumnAndValue {
+	return v.PrimaryKeyValue
+}
+
+// MonitorMuteRuleResourceIdPrimaryKeyValueColumnAndValue includes the requested fields of the GraphQL type ColumnAndValue.
+type MonitorMuteRuleResourceIdPrimaryKeyValueColumnAndValue struct {
+	Name  string  `json:"name"`
+	Value *string `json:"value"`
+}
+
+// GetName returns MonitorMuteRuleResourceIdPrimaryKeyValueColumnAndValue.Name, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleResourceIdPrimaryKeyValueColumnAndValue) GetName() string { return v.Name }
+
+// GetValue returns MonitorMuteRuleResourceIdPrimaryKeyValueColumnAndValue.Value, and is useful for accessing the field via an interface.
+func (v *MonitorMuteRuleResourceIdPrimaryKeyValueColumnAndValue) GetValue() *string { return v.Value }
+
+// MonitorNotificationSpecNotificationSpecification includes the requested fields of the GraphQL type NotificationSpecification.
+type MonitorNotificationSpecNotificationSpecification struct {
+	// should these go in each applicable Rule instead?
+	Merge             *NotificationMerge     `json:"merge"`
+	Importance        NotificationImportance `json:"importance"`
+	NotifyOnReminder  *bool                  `json:"notifyOnReminder"`
+	ReminderFrequency types.DurationScalar   `json:"reminderFrequency"`
+	NotifyOnClose     *bool                  `json:"notifyOnClose"`
+}
+
+// GetMerge returns MonitorNotificationSpecNotificationSpecification.Merge, and is useful for accessing the field via an interface.
+func (v *MonitorNotificationSpecNotificationSpecification) GetMerge() *NotificationMerge {
+	return v.Merge
+}
+
+// GetImportance returns MonitorNotificationSpecNotificationSpecification.Importance, and is useful for accessing the field via an interface.
+func (v *MonitorNotificationSpecNotificationSpecification) GetImportance() NotificationImportance {
+	return v.Importance
+}
+
+// GetNotifyOnReminder returns MonitorNotificationSpecNotificationSpecification.NotifyOnReminder, and is useful for accessing the field via an interface.
+func (v *MonitorNotificationSpecNotificationSpecification) GetNotifyOnReminder() *bool {
+	return v.NotifyOnReminder
+}
+
+// GetReminderFrequency returns MonitorNotificationSpecNotificationSpecification.ReminderFrequency, and is useful for accessing the field via an interface.
+func (v *MonitorNotificationSpecNotificationSpecification) GetReminderFrequency() types.DurationScalar {
+	return v.ReminderFrequency
+}
+
+// GetNotifyOnClose returns MonitorNotificationSpecNotificationSpecification.NotifyOnClose, and is useful for accessing the field via an interface.
+func (v *MonitorNotificationSpecNotificationSpecification) GetNotifyOnClose() *bool {
+	return v.NotifyOnClose
+}
+
+// MonitorQueryMultiStageQuery includes the requested fields of the GraphQL type MultiStageQuery.
+type MonitorQueryMultiStageQuery struct {
+	OutputStage string       `json:"outputStage"`
+	Stages      []StageQuery `json:"stages"`
+}
+
+// GetOutputStage returns MonitorQueryMultiStageQuery.OutputStage, and is useful for accessing the field via an interface.
+func (v *MonitorQueryMultiStageQuery) GetOutputStage() string { return v.OutputStage }
+
+// GetStages returns MonitorQueryMultiStageQuery.Stages, and is useful for accessing the field via an interface.
+func (v *MonitorQueryMultiStageQuery) GetStages() []StageQuery { return v.Stages }
+
+// MonitorRule includes the requested fields of the GraphQL interface MonitorRule.
+//
+// MonitorRule is implemented by the following types:
+// MonitorRuleMonitorRuleChange
+// MonitorRuleMonitorRuleCount
+// MonitorRuleMonitorRuleFacet
+// MonitorRuleMonitorRuleLog
+// MonitorRuleMonitorRulePromote
+// MonitorRuleMonitorRuleThreshold
+type MonitorRule interface {
+	implementsGraphQLInterfaceMonitorRule()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+	// GetSourceColumn returns the interface-field "sourceColumn" from its implementation.
+	GetSourceColumn() string
+	// GetGroupByGroups returns the interface-field "groupByGroups" from its implementation.
+	GetGroupByGroups() []MonitorRuleGroupByGroupsMonitorGroupInfo
+}
+
+func (v *MonitorRuleMonitorRuleChange) implementsGraphQLInterfaceMonitorRule()    {}
+func (v *MonitorRuleMonitorRuleCount) implementsGraphQLInterfaceMonitorRule()     {}
+func (v *MonitorRuleMonitorRuleFacet) implementsGraphQLInterfaceMonitorRule()     {}
+func (v *MonitorRuleMonitorRuleLog) implementsGraphQLInterfaceMonitorRule()       {}
+func (v *MonitorRuleMonitorRulePromote) implementsGraphQLInterfaceMonitorRule()   {}
+func (v *MonitorRuleMonitorRuleThreshold) implementsGraphQLInterfaceMonitorRule() {}
+
+func __unmarshalMonitorRule(b []byte, v *MonitorRule) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "MonitorRuleChange":
+		*v = new(MonitorRuleMonitorRuleChange)
+		return json.Unmarshal(b, *v)
+	case "MonitorRuleCount":
+		*v = new(MonitorRuleMonitorRuleCount)
+		return json.Unmarshal(b, *v)
+	case "MonitorRuleFacet":
+		*v = new(MonitorRuleMonitorRuleFacet)
+		return json.Unmarshal(b, *v)
+	case "MonitorRuleLog":
+		*v = new(MonitorRuleMonitorRuleLog)
+		return json.Unmarshal(b, *v)
+	case "MonitorRulePromote":
+		*v = new(MonitorRuleMonitorRulePromote)
+		return json.Unmarshal(b, *v)
+	case "MonitorRuleThreshold":
+		*v = new(MonitorRuleMonitorRuleThreshold)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing MonitorRule.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for MonitorRule: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalMonitorRule(v *MonitorRule) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *MonitorRuleMonitorRuleChange:
+		typename = "MonitorRuleChange"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*MonitorRuleMonitorRuleChange
+		}{typename, v}
+		return json.Marshal(result)
+	case *MonitorRuleMonitorRuleCount:
+		typename = "MonitorRuleCount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*MonitorRuleMonitorRuleCount
+		}{typename, v}
+		return json.Marshal(result)
+	case *MonitorRuleMonitorRuleFacet:
+		typename = "MonitorRuleFacet"
 
 		result := struct {
 			TypeName string `json:"__typename"`
@@ -4437,6 +5870,8 @@ type MonitorV2 struct {
 	// contain the action definition regardless of whether the definition is
 	// shared or provided inline.
 	ActionRules []MonitorV2ActionRule `json:"actionRules"`
+	// Mutes is a list of mute rules currently linked to this monitor.
+	Mutes []MonitorV2MuteRule `json:"mutes"`
 }
 
 // GetId returns MonitorV2.Id, and is useful for accessing the field via an interface.
@@ -4478,6 +5913,9 @@ func (v *MonitorV2) GetDefinition() MonitorV2Definition { return v.Definition }
 // GetActionRules returns MonitorV2.ActionRules, and is useful for accessing the field via an interface.
 func (v *MonitorV2) GetActionRules() []MonitorV2ActionRule { return v.ActionRules }
 
+// GetMutes returns MonitorV2.Mutes, and is useful for accessing the field via an interface.
+func (v *MonitorV2) GetMutes() []MonitorV2MuteRule { return v.Mutes }
+
 // MonitorV2Action includes the GraphQL fields of MonitorV2Action requested by the fragment MonitorV2Action.
 type MonitorV2Action struct {
 	// The inline field determines whether the object is inlined within another object or not. If not inlined, it can be shared with other objects.
@@ -4630,6 +6068,95 @@ const (
 	MonitorV2ActionTypeWebhook   MonitorV2ActionType = "Webhook"
 )
 
+// MonitorV2Alarm includes the GraphQL fields of MonitorV2Alarm requested by the fragment MonitorV2Alarm.
+type MonitorV2Alarm struct {
+	Id string `json:"id"`
+	// Start is the earliest timestamp for which the monitor has generated detection events.
+	// It is not the authoritative start time of the monitor's criteria, rather represents
+	// the current conclusion about when the criteria began matching.
+	Start types.TimeScalar `json:"start"`
+	// End is the latest timestamp for which the monitor is projecting the criteria are
+	// met. If the active flag is false, this value can still be extended due to late-arriving data
+	// but it currently represents the monitor's current conclusion about when the criteria were
+	// no longer satisfied. If the active flag is true, then this is just the latest time for
+	// which the criteria are met.
+	End *types.TimeScalar `json:"end"`
+	// Captured values describe the value captured from the monitor output dataset. It can contain
+	// the groupBy columns, linkPrimaryKey coluns, aggregation columns, or the regular columns.
+	CapturedValues []MonitorV2CapturedValue `json:"capturedValues"`
+	// IsActive indicates if the monitor is tracking this Alarm as not having yet satisified the
+	// crtieria to conclude the alarm is done. This can be for recent alarms but also can be
+	// for old alarms that have been extended due to late arriving data and have not been ended again.
+	// note: For now, this should always be true as the only feature supported is listing
+	// active alarms. Historical analysis should be done via the Monitoring datastream.
+	// note: The retention of these alarms is restricted to 28 days.
+	IsActive bool `json:"isActive"`
+	// Level is the severity the user configured in the monitor to be alerted on.
+	Level MonitorV2AlarmLevel `json:"level"`
+	// Grouping hash shows which group this alarm originates from based on the group by values.
+	GroupingHash types.Int64Scalar `json:"groupingHash"`
+	// monitorVersion on the alarm object shows the version of the monitor at the time this alarm was created.
+	MonitorVersion types.Int64Scalar `json:"monitorVersion"`
+	// The monitor that generated this alarm.
+	Monitor *MonitorV2AlarmMonitorMonitorV2ForAlarm `json:"monitor"`
+}
+
+// GetId returns MonitorV2Alarm.Id, and is useful for accessing the field via an interface.
+func (v *MonitorV2Alarm) GetId() string { return v.Id }
+
+// GetStart returns MonitorV2Alarm.Start, and is useful for accessing the field via an interface.
+func (v *MonitorV2Alarm) GetStart() types.TimeScalar { return v.Start }
+
+// GetEnd returns MonitorV2Alarm.End, and is useful for accessing the field via an interface.
+func (v *MonitorV2Alarm) GetEnd() *types.TimeScalar { return v.End }
+
+// GetCapturedValues returns MonitorV2Alarm.CapturedValues, and is useful for accessing the field via an interface.
+func (v *MonitorV2Alarm) GetCapturedValues() []MonitorV2CapturedValue { return v.CapturedValues }
+
+// GetIsActive returns MonitorV2Alarm.IsActive, and is useful for accessing the field via an interface.
+func (v *MonitorV2Alarm) GetIsActive() bool { return v.IsActive }
+
+// GetLevel returns MonitorV2Alarm.Level, and is useful for accessing the field via an interface.
+func (v *MonitorV2Alarm) GetLevel() MonitorV2AlarmLevel { return v.Level }
+
+// GetGroupingHash returns MonitorV2Alarm.GroupingHash, and is useful for accessing the field via an interface.
+func (v *MonitorV2Alarm) GetGroupingHash() types.Int64Scalar { return v.GroupingHash }
+
+// GetMonitorVersion returns MonitorV2Alarm.MonitorVersion, and is useful for accessing the field via an interface.
+func (v *MonitorV2Alarm) GetMonitorVersion() types.Int64Scalar { return v.MonitorVersion }
+
+// GetMonitor returns MonitorV2Alarm.Monitor, and is useful for accessing the field via an interface.
+func (v *MonitorV2Alarm) GetMonitor() *MonitorV2AlarmMonitorMonitorV2ForAlarm { return v.Monitor }
+
+type MonitorV2AlarmInput struct {
+	Id             string                        `json:"id"`
+	Start          types.TimeScalar              `json:"start"`
+	End            *types.TimeScalar             `json:"end,omitempty"`
+	CapturedValues []MonitorV2CapturedValueInput `json:"capturedValues"`
+	IsActive       bool                          `json:"isActive"`
+	Level          MonitorV2AlarmLevel           `json:"level"`
+}
+
+// GetId returns MonitorV2AlarmInput.Id, and is useful for accessing the field via an interface.
+func (v *MonitorV2AlarmInput) GetId() string { return v.Id }
+
+// GetStart returns MonitorV2AlarmInput.Start, and is useful for accessing the field via an interface.
+func (v *MonitorV2AlarmInput) GetStart() types.TimeScalar { return v.Start }
+
+// GetEnd returns MonitorV2AlarmInput.End, and is useful for accessing the field via an interface.
+func (v *MonitorV2AlarmInput) GetEnd() *types.TimeScalar { return v.End }
+
+// GetCapturedValues returns MonitorV2AlarmInput.CapturedValues, and is useful for accessing the field via an interface.
+func (v *MonitorV2AlarmInput) GetCapturedValues() []MonitorV2CapturedValueInput {
+	return v.CapturedValues
+}
+
+// GetIsActive returns MonitorV2AlarmInput.IsActive, and is useful for accessing the field via an interface.
+func (v *MonitorV2AlarmInput) GetIsActive() bool { return v.IsActive }
+
+// GetLevel returns MonitorV2AlarmInput.Level, and is useful for accessing the field via an interface.
+func (v *MonitorV2AlarmInput) GetLevel() MonitorV2AlarmLevel { return v.Level }
+
 type MonitorV2AlarmLevel string
 
 const (
@@ -4640,6 +6167,87 @@ const (
 	MonitorV2AlarmLevelWarning       MonitorV2AlarmLevel = "Warning"
 )
 
+// MonitorV2AlarmMonitorMonitorV2ForAlarm includes the requested fields of the GraphQL type MonitorV2ForAlarm.
+type MonitorV2AlarmMonitorMonitorV2ForAlarm struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetId returns MonitorV2AlarmMonitorMonitorV2ForAlarm.Id, and is useful for accessing the field via an interface.
+func (v *MonitorV2AlarmMonitorMonitorV2ForAlarm) GetId() string { return v.Id }
+
+// GetName returns MonitorV2AlarmMonitorMonitorV2ForAlarm.Name, and is useful for accessing the field via an interface.
+func (v *MonitorV2AlarmMonitorMonitorV2ForAlarm) GetName() string { return v.Name }
+
+// MonitorV2AlertType simply describes what type of alert template dictionary you'd like to generate
+// as part of the monitorV2TemplateDictionary method. This MonitorV2AlertType is what shows up as the
+// type of alert for the user -- New, Reminder, or Ended.
+type MonitorV2AlertType string
+
+const (
+	MonitorV2AlertTypeEnded    MonitorV2AlertType = "Ended"
+	MonitorV2AlertTypeNew      MonitorV2AlertType = "New"
+	MonitorV2AlertTypeReminder MonitorV2AlertType = "Reminder"
+)
+
+type MonitorV2BooleanOperator string
+
+const (
+	MonitorV2BooleanOperatorAnd MonitorV2BooleanOperator = "And"
+	MonitorV2BooleanOperatorOr  MonitorV2BooleanOperator = "Or"
+)
+
+// MonitorV2CapturedValue includes the GraphQL fields of MonitorV2CapturedValue requested by the fragment MonitorV2CapturedValue.
+type MonitorV2CapturedValue struct {
+	// Types capture the type of this column for the alarm. If the captured value has a groupby type,
+	// it will be a column that was part of the groupings in the monitor. If the captured value has an aggregation
+	// type, it will be the column that's used to capture the aggregated value for the count or threshold monitor.
+	Types []MonitorV2CapturedValueType `json:"types"`
+	// Includes all the metadata surrounding the column for either the link or the normal colum path.
+	Column MonitorV2Column `json:"column"`
+	// Value is the value of the captured column in the dataset.
+	Value *string `json:"value"`
+}
+
+// GetTypes returns MonitorV2CapturedValue.Types, and is useful for accessing the field via an interface.
+func (v *MonitorV2CapturedValue) GetTypes() []MonitorV2CapturedValueType { return v.Types }
+
+// GetColumn returns MonitorV2CapturedValue.Column, and is useful for accessing the field via an interface.
+func (v *MonitorV2CapturedValue) GetColumn() MonitorV2Column { return v.Column }
+
+// GetValue returns MonitorV2CapturedValue.Value, and is useful for accessing the field via an interface.
+func (v *MonitorV2CapturedValue) GetValue() *string { return v.Value }
+
+type MonitorV2CapturedValueInput struct {
+	Types  []MonitorV2CapturedValueType `json:"types"`
+	Column MonitorV2ColumnInput         `json:"column"`
+	Value  *string                      `json:"value"`
+}
+
+// GetTypes returns MonitorV2CapturedValueInput.Types, and is useful for accessing the field via an interface.
+func (v *MonitorV2CapturedValueInput) GetTypes() []MonitorV2CapturedValueType { return v.Types }
+
+// GetColumn returns MonitorV2CapturedValueInput.Column, and is useful for accessing the field via an interface.
+func (v *MonitorV2CapturedValueInput) GetColumn() MonitorV2ColumnInput { return v.Column }
+
+// GetValue returns MonitorV2CapturedValueInput.Value, and is useful for accessing the field via an interface.
+func (v *MonitorV2CapturedValueInput) GetValue() *string { return v.Value }
+
+// MonitorV2CapturedType describes the type of column that's captured in the dataset which the monitor observes over.
+// There are 3 types:
+// 1. GroupBy:         If a monitor is grouped by this particular column, this will be one of the types that's tagged.
+// 2. LinkSourceField: If this column is one of the source columns used to produce the link column that's grouped,
+// this type will be the one that's tagged.
+// 3. Aggregation:     If this column is the aggregation column used for count or threshold strategy type, this will
+// be the type that's tagged.
+type MonitorV2CapturedValueType string
+
+const (
+	MonitorV2CapturedValueTypeAggregation     MonitorV2CapturedValueType = "Aggregation"
+	MonitorV2CapturedValueTypeGroupby         MonitorV2CapturedValueType = "GroupBy"
+	MonitorV2CapturedValueTypeLinksourcefield MonitorV2CapturedValueType = "LinkSourceField"
+)
+
 // MonitorV2Column includes the GraphQL fields of MonitorV2Column requested by the fragment MonitorV2Column.
 type MonitorV2Column struct {
 	// Link Column is for link typed column which the user wants to group by.
@@ -4732,6 +6340,27 @@ func (v *MonitorV2Comparison) GetCompareFn() MonitorV2ComparisonFunction { retur
 // GetCompareValue returns MonitorV2Comparison.CompareValue, and is useful for accessing the field via an interface.
 func (v *MonitorV2Comparison) GetCompareValue() PrimitiveValue { return v.CompareValue }
 
+type MonitorV2ComparisonExpressionInput struct {
+	CompareTerms   []MonitorV2ComparisonTermInput       `json:"compareTerms"`
+	SubExpressions []MonitorV2ComparisonExpressionInput `json:"subExpressions"`
+	Operator       MonitorV2BooleanOperator             `json:"operator"`
+}
+
+// GetCompareTerms returns MonitorV2ComparisonExpressionInput.CompareTerms, and is useful for accessing the field via an interface.
+func (v *MonitorV2ComparisonExpressionInput) GetCompareTerms() []MonitorV2ComparisonTermInput {
+	return v.CompareTerms
+}
+
+// GetSubExpressions returns MonitorV2ComparisonExpressionInput.SubExpressions, and is useful for accessing the field via an interface.
+func (v *MonitorV2ComparisonExpressionInput) GetSubExpressions() []MonitorV2ComparisonExpressionInput {
+	return v.SubExpressions
+}
+
+// GetOperator returns MonitorV2ComparisonExpressionInput.Operator, and is useful for accessing the field via an interface.
+func (v *MonitorV2ComparisonExpressionInput) GetOperator() MonitorV2BooleanOperator {
+	return v.Operator
+}
+
 type MonitorV2ComparisonFunction string
 
 const (
@@ -4754,6 +6383,17 @@ func (v *MonitorV2ComparisonInput) GetCompareFn() MonitorV2ComparisonFunction {
 // GetCompareValue returns MonitorV2ComparisonInput.CompareValue, and is useful for accessing the field via an interface.
 func (v *MonitorV2ComparisonInput) GetCompareValue() PrimitiveValueInput { return v.CompareValue }
 
+type MonitorV2ComparisonTermInput struct {
+	Comparison MonitorV2ComparisonInput `json:"comparison"`
+	Column     MonitorV2ColumnInput     `json:"column"`
+}
+
+// GetComparison returns MonitorV2ComparisonTermInput.Comparison, and is useful for accessing the field via an interface.
+func (v *MonitorV2ComparisonTermInput) GetComparison() MonitorV2ComparisonInput { return v.Comparison }
+
+// GetColumn returns MonitorV2ComparisonTermInput.Column, and is useful for accessing the field via an interface.
+func (v *MonitorV2ComparisonTermInput) GetColumn() MonitorV2ColumnInput { return v.Column }
+
 // MonitorV2CountRule includes the GraphQL fields of MonitorV2CountRule requested by the fragment MonitorV2CountRule.
 type MonitorV2CountRule struct {
 	// CompareValues is a list of comparisons that provide an implicit AND where all comparisons must match.
@@ -5072,16 +6712,153 @@ func (v *MonitorV2LinkColumnMetaInput) GetDstFields() []string { return v.DstFie
 // GetTargetDataset returns MonitorV2LinkColumnMetaInput.TargetDataset, and is useful for accessing the field via an interface.
 func (v *MonitorV2LinkColumnMetaInput) GetTargetDataset() *types.Int64Scalar { return v.TargetDataset }
 
-// MonitorV2PromoteRule includes the GraphQL fields of MonitorV2PromoteRule requested by the fragment MonitorV2PromoteRule.
-type MonitorV2PromoteRule struct {
-	// If this field has been specified, it means there are values in the columns that we want to assign severity by.
-	// When multiple column comparisons are specified within one promote rule, it will act as an AND condition. When defined
-	// through separate promote rules, it will be treated as an OR condition.
-	// If the field is left as an empty array by the frontend, all the rows of the dataset will be considered as an alert.
-	// For example, if the field is left empty and the level of the MonitorV2Rule is set at critical, all the rows of the dataset
-	// will be treated as a critical alert.
-	CompareColumns []MonitorV2ColumnComparison `json:"compareColumns"`
-}
+// MonitorV2MuteRule includes the GraphQL fields of MonitorV2MuteRule requested by the fragment MonitorV2MuteRule.
+type MonitorV2MuteRule struct {
+	Id          string  `json:"id"`
+	WorkspaceId string  `json:"workspaceId"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	// MonitorID is an optional identifer you assign to bind this mute rule to a single monitor.
+	// Leaving this null makes the rule global (evaluates against all notifications of all monitors).
+	MonitorID *string                   `json:"monitorID"`
+	Schedule  MonitorV2MuteRuleSchedule `json:"schedule"`
+	// ValidFrom is calculated dynamically from the schedule based on the type and the clock.
+	// For a recurring schedule, this may be in the future but it could also be in the past
+	// if the current mute interval is not yet expired.
+	ValidFrom types.TimeScalar `json:"validFrom"`
+	// ValidTo is the countpart to ValidFrom and is optional. When this is null, the mute never expires.
+	ValidTo *types.TimeScalar `json:"validTo"`
+}
+
+// GetId returns MonitorV2MuteRule.Id, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRule) GetId() string { return v.Id }
+
+// GetWorkspaceId returns MonitorV2MuteRule.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRule) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetName returns MonitorV2MuteRule.Name, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRule) GetName() string { return v.Name }
+
+// GetDescription returns MonitorV2MuteRule.Description, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRule) GetDescription() *string { return v.Description }
+
+// GetMonitorID returns MonitorV2MuteRule.MonitorID, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRule) GetMonitorID() *string { return v.MonitorID }
+
+// GetSchedule returns MonitorV2MuteRule.Schedule, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRule) GetSchedule() MonitorV2MuteRuleSchedule { return v.Schedule }
+
+// GetValidFrom returns MonitorV2MuteRule.ValidFrom, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRule) GetValidFrom() types.TimeScalar { return v.ValidFrom }
+
+// GetValidTo returns MonitorV2MuteRule.ValidTo, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRule) GetValidTo() *types.TimeScalar { return v.ValidTo }
+
+type MonitorV2MuteRuleInput struct {
+	Schedule    MonitorV2MuteRuleScheduleInput      `json:"schedule"`
+	Criteria    *MonitorV2ComparisonExpressionInput `json:"criteria,omitempty"`
+	MonitorID   *string                             `json:"monitorID"`
+	Name        string                              `json:"name"`
+	IconUrl     *string                             `json:"iconUrl,omitempty"`
+	Description *string                             `json:"description,omitempty"`
+	ManagedById *string                             `json:"managedById,omitempty"`
+	FolderId    *string                             `json:"folderId,omitempty"`
+}
+
+// GetSchedule returns MonitorV2MuteRuleInput.Schedule, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleInput) GetSchedule() MonitorV2MuteRuleScheduleInput { return v.Schedule }
+
+// GetCriteria returns MonitorV2MuteRuleInput.Criteria, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleInput) GetCriteria() *MonitorV2ComparisonExpressionInput { return v.Criteria }
+
+// GetMonitorID returns MonitorV2MuteRuleInput.MonitorID, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleInput) GetMonitorID() *string { return v.MonitorID }
+
+// GetName returns MonitorV2MuteRuleInput.Name, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleInput) GetName() string { return v.Name }
+
+// GetIconUrl returns MonitorV2MuteRuleInput.IconUrl, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleInput) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns MonitorV2MuteRuleInput.Description, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleInput) GetDescription() *string { return v.Description }
+
+// GetManagedById returns MonitorV2MuteRuleInput.ManagedById, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleInput) GetManagedById() *string { return v.ManagedById }
+
+// GetFolderId returns MonitorV2MuteRuleInput.FolderId, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleInput) GetFolderId() *string { return v.FolderId }
+
+// MonitorV2MuteRuleSchedule includes the requested fields of the GraphQL type MonitorV2MuteRuleSchedule.
+type MonitorV2MuteRuleSchedule struct {
+	Type    MonitorV2MuteScheduleType                                     `json:"type"`
+	OneTime *MonitorV2MuteRuleScheduleOneTimeMonitorV2OneTimeMuteSchedule `json:"oneTime"`
+}
+
+// GetType returns MonitorV2MuteRuleSchedule.Type, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleSchedule) GetType() MonitorV2MuteScheduleType { return v.Type }
+
+// GetOneTime returns MonitorV2MuteRuleSchedule.OneTime, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleSchedule) GetOneTime() *MonitorV2MuteRuleScheduleOneTimeMonitorV2OneTimeMuteSchedule {
+	return v.OneTime
+}
+
+type MonitorV2MuteRuleScheduleInput struct {
+	Type    MonitorV2MuteScheduleType          `json:"type"`
+	OneTime *MonitorV2OneTimeMuteScheduleInput `json:"oneTime,omitempty"`
+}
+
+// GetType returns MonitorV2MuteRuleScheduleInput.Type, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleScheduleInput) GetType() MonitorV2MuteScheduleType { return v.Type }
+
+// GetOneTime returns MonitorV2MuteRuleScheduleInput.OneTime, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleScheduleInput) GetOneTime() *MonitorV2OneTimeMuteScheduleInput {
+	return v.OneTime
+}
+
+// MonitorV2MuteRuleScheduleOneTimeMonitorV2OneTimeMuteSchedule includes the requested fields of the GraphQL type MonitorV2OneTimeMuteSchedule.
+type MonitorV2MuteRuleScheduleOneTimeMonitorV2OneTimeMuteSchedule struct {
+	StartTime types.TimeScalar  `json:"startTime"`
+	EndTime   *types.TimeScalar `json:"endTime"`
+}
+
+// GetStartTime returns MonitorV2MuteRuleScheduleOneTimeMonitorV2OneTimeMuteSchedule.StartTime, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleScheduleOneTimeMonitorV2OneTimeMuteSchedule) GetStartTime() types.TimeScalar {
+	return v.StartTime
+}
+
+// GetEndTime returns MonitorV2MuteRuleScheduleOneTimeMonitorV2OneTimeMuteSchedule.EndTime, and is useful for accessing the field via an interface.
+func (v *MonitorV2MuteRuleScheduleOneTimeMonitorV2OneTimeMuteSchedule) GetEndTime() *types.TimeScalar {
+	return v.EndTime
+}
+
+type MonitorV2MuteScheduleType string
+
+const (
+	MonitorV2MuteScheduleTypeOnetime MonitorV2MuteScheduleType = "OneTime"
+)
+
+type MonitorV2OneTimeMuteScheduleInput struct {
+	StartTime types.TimeScalar  `json:"startTime"`
+	EndTime   *types.TimeScalar `json:"endTime,omitempty"`
+}
+
+// GetStartTime returns MonitorV2OneTimeMuteScheduleInput.StartTime, and is useful for accessing the field via an interface.
+func (v *MonitorV2OneTimeMuteScheduleInput) GetStartTime() types.TimeScalar { return v.StartTime }
+
+// GetEndTime returns MonitorV2OneTimeMuteScheduleInput.EndTime, and is useful for accessing the field via an interface.
+func (v *MonitorV2OneTimeMuteScheduleInput) GetEndTime() *types.TimeScalar { return v.EndTime }
+
+// MonitorV2PromoteRule includes the GraphQL fields of MonitorV2PromoteRule requested by the fragment MonitorV2PromoteRule.
+type MonitorV2PromoteRule struct {
+	// If this field has been specified, it means there are values in the columns that we want to assign severity by.
+	// When multiple column comparisons are specified within one promote rule, it will act as an AND condition. When defined
+	// through separate promote rules, it will be treated as an OR condition.
+	// If the field is left as an empty array by the frontend, all the rows of the dataset will be considered as an alert.
+	// For example, if the field is left empty and the level of the MonitorV2Rule is set at critical, all the rows of the dataset
+	// will be treated as a critical alert.
+	CompareColumns []MonitorV2ColumnComparison `json:"compareColumns"`
+}
 
 // GetCompareColumns returns MonitorV2PromoteRule.CompareColumns, and is useful for accessing the field via an interface.
 func (v *MonitorV2PromoteRule) GetCompareColumns() []MonitorV2ColumnComparison {
@@ -5097,6 +6874,42 @@ func (v *MonitorV2PromoteRuleInput) GetCompareColumns() []MonitorV2ColumnCompari
 	return v.CompareColumns
 }
 
+// MonitorV2RenderedTemplate includes the GraphQL fields of RenderedTemplate requested by the fragment MonitorV2RenderedTemplate.
+type MonitorV2RenderedTemplate struct {
+	Email   *MonitorV2RenderedTemplateEmailRenderedEmail     `json:"email"`
+	Webhook *MonitorV2RenderedTemplateWebhookRenderedWebhook `json:"webhook"`
+}
+
+// GetEmail returns MonitorV2RenderedTemplate.Email, and is useful for accessing the field via an interface.
+func (v *MonitorV2RenderedTemplate) GetEmail() *MonitorV2RenderedTemplateEmailRenderedEmail {
+	return v.Email
+}
+
+// GetWebhook returns MonitorV2RenderedTemplate.Webhook, and is useful for accessing the field via an interface.
+func (v *MonitorV2RenderedTemplate) GetWebhook() *MonitorV2RenderedTemplateWebhookRenderedWebhook {
+	return v.Webhook
+}
+
+// MonitorV2RenderedTemplateEmailRenderedEmail includes the requested fields of the GraphQL type RenderedEmail.
+type MonitorV2RenderedTemplateEmailRenderedEmail struct {
+	Action MonitorV2EmailAction `json:"action"`
+}
+
+// GetAction returns MonitorV2RenderedTemplateEmailRenderedEmail.Action, and is useful for accessing the field via an interface.
+func (v *MonitorV2RenderedTemplateEmailRenderedEmail) GetAction() MonitorV2EmailAction {
+	return v.Action
+}
+
+// MonitorV2RenderedTemplateWebhookRenderedWebhook includes the requested fields of the GraphQL type RenderedWebhook.
+type MonitorV2RenderedTemplateWebhookRenderedWebhook struct {
+	Action MonitorV2WebhookAction `json:"action"`
+}
+
+// GetAction returns MonitorV2RenderedTemplateWebhookRenderedWebhook.Action, and is useful for accessing the field via an interface.
+func (v *MonitorV2RenderedTemplateWebhookRenderedWebhook) GetAction() MonitorV2WebhookAction {
+	return v.Action
+}
+
 // MonitorV2RollupStatus is a convenience indicator of how to perceive the state of the monitor.
 // This value is derived entirely using existing data in other fields, but
 // encapsultes those inspections into a single priority-based status.
@@ -5399,6 +7212,476 @@ func (v *MultiStageQueryInput) GetParameterValues() []ParameterBindingInput { re
 // GetLayout returns MultiStageQueryInput.Layout, and is useful for accessing the field via an interface.
 func (v *MultiStageQueryInput) GetLayout() *types.JsonObject { return v.Layout }
 
+type NotebookActionConfirmation string
+
+const (
+	NotebookActionConfirmationNo      NotebookActionConfirmation = "No"
+	NotebookActionConfirmationPending NotebookActionConfirmation = "Pending"
+	NotebookActionConfirmationYes     NotebookActionConfirmation = "Yes"
+)
+
+type NotebookActionPreviewInput struct {
+	Text string `json:"text"`
+}
+
+// GetText returns NotebookActionPreviewInput.Text, and is useful for accessing the field via an interface.
+func (v *NotebookActionPreviewInput) GetText() string { return v.Text }
+
+type NotebookAlertInfoInput struct {
+	MonitorID string `json:"monitorID"`
+	AlertID   string `json:"alertID"`
+}
+
+// GetMonitorID returns NotebookAlertInfoInput.MonitorID, and is useful for accessing the field via an interface.
+func (v *NotebookAlertInfoInput) GetMonitorID() string { return v.MonitorID }
+
+// GetAlertID returns NotebookAlertInfoInput.AlertID, and is useful for accessing the field via an interface.
+func (v *NotebookAlertInfoInput) GetAlertID() string { return v.AlertID }
+
+// NotebookBlock includes the GraphQL fields of NotebookBlock requested by the fragment NotebookBlock.
+type NotebookBlock struct {
+	Type NotebookBlockType `json:"type"`
+	// A unique UUID for this block
+	Id         *string                 `json:"id"`
+	Properties NotebookBlockProperties `json:"properties"`
+}
+
+// GetType returns NotebookBlock.Type, and is useful for accessing the field via an interface.
+func (v *NotebookBlock) GetType() NotebookBlockType { return v.Type }
+
+// GetId returns NotebookBlock.Id, and is useful for accessing the field via an interface.
+func (v *NotebookBlock) GetId() *string { return v.Id }
+
+// GetProperties returns NotebookBlock.Properties, and is useful for accessing the field via an interface.
+func (v *NotebookBlock) GetProperties() NotebookBlockProperties { return v.Properties }
+
+type NotebookBlockInput struct {
+	Type       NotebookBlockType            `json:"type"`
+	Properties NotebookBlockPropertiesInput `json:"properties"`
+	Id         *string                      `json:"id,omitempty"`
+}
+
+// GetType returns NotebookBlockInput.Type, and is useful for accessing the field via an interface.
+func (v *NotebookBlockInput) GetType() NotebookBlockType { return v.Type }
+
+// GetProperties returns NotebookBlockInput.Properties, and is useful for accessing the field via an interface.
+func (v *NotebookBlockInput) GetProperties() NotebookBlockPropertiesInput { return v.Properties }
+
+// GetId returns NotebookBlockInput.Id, and is useful for accessing the field via an interface.
+func (v *NotebookBlockInput) GetId() *string { return v.Id }
+
+// NotebookBlockProperties includes the requested fields of the GraphQL type NotebookBlockProperties.
+type NotebookBlockProperties struct {
+	Markdown        *NotebookBlockPropertiesMarkdownNotebookMarkdown                 `json:"markdown"`
+	Query           *NotebookBlockPropertiesQueryNotebookQuery                       `json:"query"`
+	Image           *NotebookBlockPropertiesImageNotebookImage                       `json:"image"`
+	RaiseIncident   *NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction `json:"raiseIncident"`
+	Ping            *NotebookBlockPropertiesPingNotebookPingAction                   `json:"ping"`
+	Ticket          *NotebookBlockPropertiesTicketNotebookTicketAction               `json:"ticket"`
+	O11yPlaceholder *NotebookBlockPropertiesO11yPlaceholderNotebookO11yPlaceholder   `json:"o11yPlaceholder"`
+}
+
+// GetMarkdown returns NotebookBlockProperties.Markdown, and is useful for accessing the field via an interface.
+func (v *NotebookBlockProperties) GetMarkdown() *NotebookBlockPropertiesMarkdownNotebookMarkdown {
+	return v.Markdown
+}
+
+// GetQuery returns NotebookBlockProperties.Query, and is useful for accessing the field via an interface.
+func (v *NotebookBlockProperties) GetQuery() *NotebookBlockPropertiesQueryNotebookQuery {
+	return v.Query
+}
+
+// GetImage returns NotebookBlockProperties.Image, and is useful for accessing the field via an interface.
+func (v *NotebookBlockProperties) GetImage() *NotebookBlockPropertiesImageNotebookImage {
+	return v.Image
+}
+
+// GetRaiseIncident returns NotebookBlockProperties.RaiseIncident, and is useful for accessing the field via an interface.
+func (v *NotebookBlockProperties) GetRaiseIncident() *NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction {
+	return v.RaiseIncident
+}
+
+// GetPing returns NotebookBlockProperties.Ping, and is useful for accessing the field via an interface.
+func (v *NotebookBlockProperties) GetPing() *NotebookBlockPropertiesPingNotebookPingAction {
+	return v.Ping
+}
+
+// GetTicket returns NotebookBlockProperties.Ticket, and is useful for accessing the field via an interface.
+func (v *NotebookBlockProperties) GetTicket() *NotebookBlockPropertiesTicketNotebookTicketAction {
+	return v.Ticket
+}
+
+// GetO11yPlaceholder returns NotebookBlockProperties.O11yPlaceholder, and is useful for accessing the field via an interface.
+func (v *NotebookBlockProperties) GetO11yPlaceholder() *NotebookBlockPropertiesO11yPlaceholderNotebookO11yPlaceholder {
+	return v.O11yPlaceholder
+}
+
+// NotebookBlockPropertiesImageNotebookImage includes the requested fields of the GraphQL type NotebookImage.
+type NotebookBlockPropertiesImageNotebookImage struct {
+	Base64      string `json:"base64"`
+	Description string `json:"description"`
+}
+
+// GetBase64 returns NotebookBlockPropertiesImageNotebookImage.Base64, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesImageNotebookImage) GetBase64() string { return v.Base64 }
+
+// GetDescription returns NotebookBlockPropertiesImageNotebookImage.Description, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesImageNotebookImage) GetDescription() string { return v.Description }
+
+type NotebookBlockPropertiesInput struct {
+	Markdown        *NotebookMarkdownInput            `json:"markdown,omitempty"`
+	Query           *NotebookQueryInput               `json:"query,omitempty"`
+	Image           *NotebookImageInput               `json:"image,omitempty"`
+	RaiseIncident   *NotebookRaiseIncidentActionInput `json:"raiseIncident,omitempty"`
+	Ping            *NotebookPingActionInput          `json:"ping,omitempty"`
+	Ticket          *NotebookTicketActionInput        `json:"ticket,omitempty"`
+	O11yPlaceholder *NotebookO11yPlaceholderInput     `json:"o11yPlaceholder,omitempty"`
+}
+
+// GetMarkdown returns NotebookBlockPropertiesInput.Markdown, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesInput) GetMarkdown() *NotebookMarkdownInput { return v.Markdown }
+
+// GetQuery returns NotebookBlockPropertiesInput.Query, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesInput) GetQuery() *NotebookQueryInput { return v.Query }
+
+// GetImage returns NotebookBlockPropertiesInput.Image, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesInput) GetImage() *NotebookImageInput { return v.Image }
+
+// GetRaiseIncident returns NotebookBlockPropertiesInput.RaiseIncident, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesInput) GetRaiseIncident() *NotebookRaiseIncidentActionInput {
+	return v.RaiseIncident
+}
+
+// GetPing returns NotebookBlockPropertiesInput.Ping, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesInput) GetPing() *NotebookPingActionInput { return v.Ping }
+
+// GetTicket returns NotebookBlockPropertiesInput.Ticket, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesInput) GetTicket() *NotebookTicketActionInput { return v.Ticket }
+
+// GetO11yPlaceholder returns NotebookBlockPropertiesInput.O11yPlaceholder, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesInput) GetO11yPlaceholder() *NotebookO11yPlaceholderInput {
+	return v.O11yPlaceholder
+}
+
+// NotebookBlockPropertiesMarkdownNotebookMarkdown includes the requested fields of the GraphQL type NotebookMarkdown.
+type NotebookBlockPropertiesMarkdownNotebookMarkdown struct {
+	Text string `json:"text"`
+}
+
+// GetText returns NotebookBlockPropertiesMarkdownNotebookMarkdown.Text, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesMarkdownNotebookMarkdown) GetText() string { return v.Text }
+
+// NotebookBlockPropertiesO11yPlaceholderNotebookO11yPlaceholder includes the requested fields of the GraphQL type NotebookO11yPlaceholder.
+type NotebookBlockPropertiesO11yPlaceholderNotebookO11yPlaceholder struct {
+	Text string `json:"text"`
+}
+
+// GetText returns NotebookBlockPropertiesO11yPlaceholderNotebookO11yPlaceholder.Text, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesO11yPlaceholderNotebookO11yPlaceholder) GetText() string {
+	return v.Text
+}
+
+// NotebookBlockPropertiesPingNotebookPingAction includes the requested fields of the GraphQL type NotebookPingAction.
+type NotebookBlockPropertiesPingNotebookPingAction struct {
+	Preview      NotebookBlockPropertiesPingNotebookPingActionPreviewNotebookActionPreview `json:"preview"`
+	User         string                                                                    `json:"user"`
+	Confirmation NotebookActionConfirmation                                                `json:"confirmation"`
+}
+
+// GetPreview returns NotebookBlockPropertiesPingNotebookPingAction.Preview, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesPingNotebookPingAction) GetPreview() NotebookBlockPropertiesPingNotebookPingActionPreviewNotebookActionPreview {
+	return v.Preview
+}
+
+// GetUser returns NotebookBlockPropertiesPingNotebookPingAction.User, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesPingNotebookPingAction) GetUser() string { return v.User }
+
+// GetConfirmation returns NotebookBlockPropertiesPingNotebookPingAction.Confirmation, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesPingNotebookPingAction) GetConfirmation() NotebookActionConfirmation {
+	return v.Confirmation
+}
+
+// NotebookBlockPropertiesPingNotebookPingActionPreviewNotebookActionPreview includes the requested fields of the GraphQL type NotebookActionPreview.
+type NotebookBlockPropertiesPingNotebookPingActionPreviewNotebookActionPreview struct {
+	Text string `json:"text"`
+}
+
+// GetText returns NotebookBlockPropertiesPingNotebookPingActionPreviewNotebookActionPreview.Text, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesPingNotebookPingActionPreviewNotebookActionPreview) GetText() string {
+	return v.Text
+}
+
+// NotebookBlockPropertiesQueryNotebookQuery includes the requested fields of the GraphQL type NotebookQuery.
+type NotebookBlockPropertiesQueryNotebookQuery struct {
+	Description string                                                        `json:"description"`
+	Query       NotebookBlockPropertiesQueryNotebookQueryQueryMultiStageQuery `json:"query"`
+}
+
+// GetDescription returns NotebookBlockPropertiesQueryNotebookQuery.Description, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesQueryNotebookQuery) GetDescription() string { return v.Description }
+
+// GetQuery returns NotebookBlockPropertiesQueryNotebookQuery.Query, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesQueryNotebookQuery) GetQuery() NotebookBlockPropertiesQueryNotebookQueryQueryMultiStageQuery {
+	return v.Query
+}
+
+// NotebookBlockPropertiesQueryNotebookQueryQueryMultiStageQuery includes the requested fields of the GraphQL type MultiStageQuery.
+type NotebookBlockPropertiesQueryNotebookQueryQueryMultiStageQuery struct {
+	OutputStage string       `json:"outputStage"`
+	Stages      []StageQuery `json:"stages"`
+}
+
+// GetOutputStage returns NotebookBlockPropertiesQueryNotebookQueryQueryMultiStageQuery.OutputStage, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesQueryNotebookQueryQueryMultiStageQuery) GetOutputStage() string {
+	return v.OutputStage
+}
+
+// GetStages returns NotebookBlockPropertiesQueryNotebookQueryQueryMultiStageQuery.Stages, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesQueryNotebookQueryQueryMultiStageQuery) GetStages() []StageQuery {
+	return v.Stages
+}
+
+// NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction includes the requested fields of the GraphQL type NotebookRaiseIncidentAction.
+type NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction struct {
+	Preview       NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentActionPreviewNotebookActionPreview `json:"preview"`
+	Summary       string                                                                                      `json:"summary"`
+	Severity      string                                                                                      `json:"severity"`
+	Slack         NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentActionSlackNotebookSlackInfo       `json:"slack"`
+	Teams         []string                                                                                    `json:"teams"`
+	IncidentOwner string                                                                                      `json:"incidentOwner"`
+	Confirmation  NotebookActionConfirmation                                                                  `json:"confirmation"`
+}
+
+// GetPreview returns NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction.Preview, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction) GetPreview() NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentActionPreviewNotebookActionPreview {
+	return v.Preview
+}
+
+// GetSummary returns NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction.Summary, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction) GetSummary() string {
+	return v.Summary
+}
+
+// GetSeverity returns NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction.Severity, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction) GetSeverity() string {
+	return v.Severity
+}
+
+// GetSlack returns NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction.Slack, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction) GetSlack() NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentActionSlackNotebookSlackInfo {
+	return v.Slack
+}
+
+// GetTeams returns NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction.Teams, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction) GetTeams() []string {
+	return v.Teams
+}
+
+// GetIncidentOwner returns NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction.IncidentOwner, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction) GetIncidentOwner() string {
+	return v.IncidentOwner
+}
+
+// GetConfirmation returns NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction.Confirmation, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentAction) GetConfirmation() NotebookActionConfirmation {
+	return v.Confirmation
+}
+
+// NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentActionPreviewNotebookActionPreview includes the requested fields of the GraphQL type NotebookActionPreview.
+type NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentActionPreviewNotebookActionPreview struct {
+	Text string `json:"text"`
+}
+
+// GetText returns NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentActionPreviewNotebookActionPreview.Text, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentActionPreviewNotebookActionPreview) GetText() string {
+	return v.Text
+}
+
+// NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentActionSlackNotebookSlackInfo includes the requested fields of the GraphQL type NotebookSlackInfo.
+type NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentActionSlackNotebookSlackInfo struct {
+	ChannelName string `json:"channelName"`
+}
+
+// GetChannelName returns NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentActionSlackNotebookSlackInfo.ChannelName, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesRaiseIncidentNotebookRaiseIncidentActionSlackNotebookSlackInfo) GetChannelName() string {
+	return v.ChannelName
+}
+
+// NotebookBlockPropertiesTicketNotebookTicketAction includes the requested fields of the GraphQL type NotebookTicketAction.
+type NotebookBlockPropertiesTicketNotebookTicketAction struct {
+	Preview      NotebookBlockPropertiesTicketNotebookTicketActionPreviewNotebookActionPreview `json:"preview"`
+	Name         string                                                                        `json:"name"`
+	Description  string                                                                        `json:"description"`
+	Priority     string                                                                        `json:"priority"`
+	Confirmation NotebookActionConfirmation                                                    `json:"confirmation"`
+}
+
+// GetPreview returns NotebookBlockPropertiesTicketNotebookTicketAction.Preview, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesTicketNotebookTicketAction) GetPreview() NotebookBlockPropertiesTicketNotebookTicketActionPreviewNotebookActionPreview {
+	return v.Preview
+}
+
+// GetName returns NotebookBlockPropertiesTicketNotebookTicketAction.Name, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesTicketNotebookTicketAction) GetName() string { return v.Name }
+
+// GetDescription returns NotebookBlockPropertiesTicketNotebookTicketAction.Description, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesTicketNotebookTicketAction) GetDescription() string {
+	return v.Description
+}
+
+// GetPriority returns NotebookBlockPropertiesTicketNotebookTicketAction.Priority, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesTicketNotebookTicketAction) GetPriority() string { return v.Priority }
+
+// GetConfirmation returns NotebookBlockPropertiesTicketNotebookTicketAction.Confirmation, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesTicketNotebookTicketAction) GetConfirmation() NotebookActionConfirmation {
+	return v.Confirmation
+}
+
+// NotebookBlockPropertiesTicketNotebookTicketActionPreviewNotebookActionPreview includes the requested fields of the GraphQL type NotebookActionPreview.
+type NotebookBlockPropertiesTicketNotebookTicketActionPreviewNotebookActionPreview struct {
+	Text string `json:"text"`
+}
+
+// GetText returns NotebookBlockPropertiesTicketNotebookTicketActionPreviewNotebookActionPreview.Text, and is useful for accessing the field via an interface.
+func (v *NotebookBlockPropertiesTicketNotebookTicketActionPreviewNotebookActionPreview) GetText() string {
+	return v.Text
+}
+
+type NotebookBlockType string
+
+const (
+	NotebookBlockTypeActionping          NotebookBlockType = "actionPing"
+	NotebookBlockTypeActionraiseincident NotebookBlockType = "actionRaiseIncident"
+	NotebookBlockTypeActionticket        NotebookBlockType = "actionTicket"
+	NotebookBlockTypeContentimage        NotebookBlockType = "contentImage"
+	NotebookBlockTypeContentmarkdown     NotebookBlockType = "contentMarkdown"
+	NotebookBlockTypeContentquery        NotebookBlockType = "contentQuery"
+	NotebookBlockTypeO11yplaceholder     NotebookBlockType = "o11yPlaceholder"
+)
+
+type NotebookImageInput struct {
+	Base64      string `json:"base64"`
+	Description string `json:"description"`
+}
+
+// GetBase64 returns NotebookImageInput.Base64, and is useful for accessing the field via an interface.
+func (v *NotebookImageInput) GetBase64() string { return v.Base64 }
+
+// GetDescription returns NotebookImageInput.Description, and is useful for accessing the field via an interface.
+func (v *NotebookImageInput) GetDescription() string { return v.Description }
+
+type NotebookMarkdownInput struct {
+	Text string `json:"text"`
+}
+
+// GetText returns NotebookMarkdownInput.Text, and is useful for accessing the field via an interface.
+func (v *NotebookMarkdownInput) GetText() string { return v.Text }
+
+type NotebookO11yPlaceholderInput struct {
+	Text string `json:"text"`
+}
+
+// GetText returns NotebookO11yPlaceholderInput.Text, and is useful for accessing the field via an interface.
+func (v *NotebookO11yPlaceholderInput) GetText() string { return v.Text }
+
+type NotebookPingActionInput struct {
+	Preview      NotebookActionPreviewInput `json:"preview"`
+	User         string                     `json:"user"`
+	Confirmation NotebookActionConfirmation `json:"confirmation"`
+}
+
+// GetPreview returns NotebookPingActionInput.Preview, and is useful for accessing the field via an interface.
+func (v *NotebookPingActionInput) GetPreview() NotebookActionPreviewInput { return v.Preview }
+
+// GetUser returns NotebookPingActionInput.User, and is useful for accessing the field via an interface.
+func (v *NotebookPingActionInput) GetUser() string { return v.User }
+
+// GetConfirmation returns NotebookPingActionInput.Confirmation, and is useful for accessing the field via an interface.
+func (v *NotebookPingActionInput) GetConfirmation() NotebookActionConfirmation { return v.Confirmation }
+
+type NotebookQueryInput struct {
+	Query       MultiStageQueryInput `json:"query"`
+	Description string               `json:"description"`
+}
+
+// GetQuery returns NotebookQueryInput.Query, and is useful for accessing the field via an interface.
+func (v *NotebookQueryInput) GetQuery() MultiStageQueryInput { return v.Query }
+
+// GetDescription returns NotebookQueryInput.Description, and is useful for accessing the field via an interface.
+func (v *NotebookQueryInput) GetDescription() string { return v.Description }
+
+type NotebookRaiseIncidentActionInput struct {
+	Preview       NotebookActionPreviewInput `json:"preview"`
+	Summary       string                     `json:"summary"`
+	Severity      string                     `json:"severity"`
+	Slack         NotebookSlackInfoInput     `json:"slack"`
+	Teams         []string                   `json:"teams"`
+	IncidentOwner string                     `json:"incidentOwner"`
+	Confirmation  NotebookActionConfirmation `json:"confirmation"`
+}
+
+// GetPreview returns NotebookRaiseIncidentActionInput.Preview, and is useful for accessing the field via an interface.
+func (v *NotebookRaiseIncidentActionInput) GetPreview() NotebookActionPreviewInput { return v.Preview }
+
+// GetSummary returns NotebookRaiseIncidentActionInput.Summary, and is useful for accessing the field via an interface.
+func (v *NotebookRaiseIncidentActionInput) GetSummary() string { return v.Summary }
+
+// GetSeverity returns NotebookRaiseIncidentActionInput.Severity, and is useful for accessing the field via an interface.
+func (v *NotebookRaiseIncidentActionInput) GetSeverity() string { return v.Severity }
+
+// GetSlack returns NotebookRaiseIncidentActionInput.Slack, and is useful for accessing the field via an interface.
+func (v *NotebookRaiseIncidentActionInput) GetSlack() NotebookSlackInfoInput { return v.Slack }
+
+// GetTeams returns NotebookRaiseIncidentActionInput.Teams, and is useful for accessing the field via an interface.
+func (v *NotebookRaiseIncidentActionInput) GetTeams() []string { return v.Teams }
+
+// GetIncidentOwner returns NotebookRaiseIncidentActionInput.IncidentOwner, and is useful for accessing the field via an interface.
+func (v *NotebookRaiseIncidentActionInput) GetIncidentOwner() string { return v.IncidentOwner }
+
+// GetConfirmation returns NotebookRaiseIncidentActionInput.Confirmation, and is useful for accessing the field via an interface.
+func (v *NotebookRaiseIncidentActionInput) GetConfirmation() NotebookActionConfirmation {
+	return v.Confirmation
+}
+
+type NotebookRunbookInfoInput struct {
+	Url string `json:"url"`
+}
+
+// GetUrl returns NotebookRunbookInfoInput.Url, and is useful for accessing the field via an interface.
+func (v *NotebookRunbookInfoInput) GetUrl() string { return v.Url }
+
+type NotebookSlackInfoInput struct {
+	ChannelName string `json:"channelName"`
+}
+
+// GetChannelName returns NotebookSlackInfoInput.ChannelName, and is useful for accessing the field via an interface.
+func (v *NotebookSlackInfoInput) GetChannelName() string { return v.ChannelName }
+
+type NotebookTicketActionInput struct {
+	Preview      NotebookActionPreviewInput `json:"preview"`
+	Name         string                     `json:"name"`
+	Description  string                     `json:"description"`
+	Priority     string                     `json:"priority"`
+	Confirmation NotebookActionConfirmation `json:"confirmation"`
+}
+
+// GetPreview returns NotebookTicketActionInput.Preview, and is useful for accessing the field via an interface.
+func (v *NotebookTicketActionInput) GetPreview() NotebookActionPreviewInput { return v.Preview }
+
+// GetName returns NotebookTicketActionInput.Name, and is useful for accessing the field via an interface.
+func (v *NotebookTicketActionInput) GetName() string { return v.Name }
+
+// GetDescription returns NotebookTicketActionInput.Description, and is useful for accessing the field via an interface.
+func (v *NotebookTicketActionInput) GetDescription() string { return v.Description }
+
+// GetPriority returns NotebookTicketActionInput.Priority, and is useful for accessing the field via an interface.
+func (v *NotebookTicketActionInput) GetPriority() string { return v.Priority }
+
+// GetConfirmation returns NotebookTicketActionInput.Confirmation, and is useful for accessing the field via an interface.
+func (v *NotebookTicketActionInput) GetConfirmation() NotebookActionConfirmation {
+	return v.Confirmation
+}
+
 type NotificationImportance string
 
 const (
@@ -5530,6 +7813,26 @@ func (v *ParameterBindingInput) GetId() string { return v.Id }
 // GetValue returns ParameterBindingInput.Value, and is useful for accessing the field via an interface.
 func (v *ParameterBindingInput) GetValue() types.Value { return v.Value }
 
+type ParameterSearchInput struct {
+	// name will do case insensitive substring match against the name AND id of the parameter
+	Name     []string           `json:"name"`
+	Kind     []ValueType        `json:"kind"`
+	Resource []string           `json:"resource"`
+	Input    []InputSearchInput `json:"input"`
+}
+
+// GetName returns ParameterSearchInput.Name, and is useful for accessing the field via an interface.
+func (v *ParameterSearchInput) GetName() []string { return v.Name }
+
+// GetKind returns ParameterSearchInput.Kind, and is useful for accessing the field via an interface.
+func (v *ParameterSearchInput) GetKind() []ValueType { return v.Kind }
+
+// GetResource returns ParameterSearchInput.Resource, and is useful for accessing the field via an interface.
+func (v *ParameterSearchInput) GetResource() []string { return v.Resource }
+
+// GetInput returns ParameterSearchInput.Input, and is useful for accessing the field via an interface.
+func (v *ParameterSearchInput) GetInput() []InputSearchInput { return v.Input }
+
 // Whever you can "save" a worksheet-like entity, you can also save the
 // parameters that go with it. This is so that the worksheet component in the FE
 // can have a unified API to work against. You can also save the parameterValues
@@ -5556,8 +7859,84 @@ func (v *ParameterSpecInput) GetDefaultValue() *types.Value { return v.DefaultVa
 // GetValueKind returns ParameterSpecInput.ValueKind, and is useful for accessing the field via an interface.
 func (v *ParameterSpecInput) GetValueKind() ValueTypeSpecInput { return v.ValueKind }
 
-// Poller includes the GraphQL fields of Poller requested by the fragment Poller.
-type Poller struct {
+// ParsedPipeline includes the GraphQL fields of ParsedPipeline requested by the fragment ParsedPipeline.
+type ParsedPipeline struct {
+	Errors              []PipelineSymbol                                               `json:"errors"`
+	Warnings            []ParsedPipelineWarningsPipelineWarning                        `json:"warnings"`
+	PreviousStageErrors []ParsedPipelinePreviousStageErrorsPreviousStagePipelineSymbol `json:"previousStageErrors"`
+}
+
+// GetErrors returns ParsedPipeline.Errors, and is useful for accessing the field via an interface.
+func (v *ParsedPipeline) GetErrors() []PipelineSymbol { return v.Errors }
+
+// GetWarnings returns ParsedPipeline.Warnings, and is useful for accessing the field via an interface.
+func (v *ParsedPipeline) GetWarnings() []ParsedPipelineWarningsPipelineWarning { return v.Warnings }
+
+// GetPreviousStageErrors returns ParsedPipeline.PreviousStageErrors, and is useful for accessing the field via an interface.
+func (v *ParsedPipeline) GetPreviousStageErrors() []ParsedPipelinePreviousStageErrorsPreviousStagePipelineSymbol {
+	return v.PreviousStageErrors
+}
+
+// ParsedPipelinePreviousStageErrorsPreviousStagePipelineSymbol includes the requested fields of the GraphQL type PreviousStagePipelineSymbol.
+type ParsedPipelinePreviousStageErrorsPreviousStagePipelineSymbol struct {
+	StageId string         `json:"stageId"`
+	Symbol  PipelineSymbol `json:"symbol"`
+}
+
+// GetStageId returns ParsedPipelinePreviousStageErrorsPreviousStagePipelineSymbol.StageId, and is useful for accessing the field via an interface.
+func (v *ParsedPipelinePreviousStageErrorsPreviousStagePipelineSymbol) GetStageId() string {
+	return v.StageId
+}
+
+// GetSymbol returns ParsedPipelinePreviousStageErrorsPreviousStagePipelineSymbol.Symbol, and is useful for accessing the field via an interface.
+func (v *ParsedPipelinePreviousStageErrorsPreviousStagePipelineSymbol) GetSymbol() PipelineSymbol {
+	return v.Symbol
+}
+
+// ParsedPipelineWarningsPipelineWarning includes the requested fields of the GraphQL type PipelineWarning.
+type ParsedPipelineWarningsPipelineWarning struct {
+	Symbol PipelineSymbol `json:"symbol"`
+}
+
+// GetSymbol returns ParsedPipelineWarningsPipelineWarning.Symbol, and is useful for accessing the field via an interface.
+func (v *ParsedPipelineWarningsPipelineWarning) GetSymbol() PipelineSymbol { return v.Symbol }
+
+// PipelineSymbol includes the GraphQL fields of PipelineSymbol requested by the fragment PipelineSymbol.
+type PipelineSymbol struct {
+	Comment string                       `json:"comment"`
+	Span    PipelineSymbolSpanSourceSpan `json:"span"`
+}
+
+// GetComment returns PipelineSymbol.Comment, and is useful for accessing the field via an interface.
+func (v *PipelineSymbol) GetComment() string { return v.Comment }
+
+// GetSpan returns PipelineSymbol.Span, and is useful for accessing the field via an interface.
+func (v *PipelineSymbol) GetSpan() PipelineSymbolSpanSourceSpan { return v.Span }
+
+// PipelineSymbolSpanSourceSpan includes the requested fields of the GraphQL type SourceSpan.
+type PipelineSymbolSpanSourceSpan struct {
+	Start PipelineSymbolSpanSourceSpanStartSourceLoc `json:"start"`
+}
+
+// GetStart returns PipelineSymbolSpanSourceSpan.Start, and is useful for accessing the field via an interface.
+func (v *PipelineSymbolSpanSourceSpan) GetStart() PipelineSymbolSpanSourceSpanStartSourceLoc {
+	return v.Start
+}
+
+// PipelineSymbolSpanSourceSpanStartSourceLoc includes the requested fields of the GraphQL type SourceLoc.
+type PipelineSymbolSpanSourceSpanStartSourceLoc struct {
+	Row types.Int64Scalar `json:"row"`
+	Col types.Int64Scalar `json:"col"`
+}
+
+// GetRow returns PipelineSymbolSpanSourceSpanStartSourceLoc.Row, and is useful for accessing the field via an interface.
+func (v *PipelineSymbolSpanSourceSpanStartSourceLoc) GetRow() types.Int64Scalar { return v.Row }
+
+// GetCol returns PipelineSymbolSpanSourceSpanStartSourceLoc.Col, and is useful for accessing the field via an interface.
+func (v *PipelineSymbolSpanSourceSpanStartSourceLoc) GetCol() types.Int64Scalar { return v.Col }
+
+// Poller includes the GraphQL fields of Poller requested by the fragment Poller.
+type Poller struct {
 	Id           string       `json:"id"`
 	WorkspaceId  string       `json:"workspaceId"`
 	CustomerId   string       `json:"customerId"`
@@ -6138,6 +8517,8 @@ type PollerConfigPollerConfluentCloudConfig struct {
 	Interval *types.DurationScalar               `json:"interval"`
 	Tags     *types.JsonObject                   `json:"tags"`
 	Chunk    *PollerConfigChunkPollerChunkConfig `json:"chunk"`
+	Key      string                              `json:"key"`
+	Secret   string                              `json:"secret"`
 }
 
 // GetTypename returns PollerConfigPollerConfluentCloudConfig.Typename, and is useful for accessing the field via an interface.
@@ -6162,6 +8543,12 @@ func (v *PollerConfigPollerConfluentCloudConfig) GetChunk() *PollerConfigChunkPo
 	return v.Chunk
 }
 
+// GetKey returns PollerConfigPollerConfluentCloudConfig.Key, and is useful for accessing the field via an interface.
+func (v *PollerConfigPollerConfluentCloudConfig) GetKey() string { return v.Key }
+
+// GetSecret returns PollerConfigPollerConfluentCloudConfig.Secret, and is useful for accessing the field via an interface.
+func (v *PollerConfigPollerConfluentCloudConfig) GetSecret() string { return v.Secret }
+
 // PollerConfigPollerGCPMonitoringConfig includes the requested fields of the GraphQL type PollerGCPMonitoringConfig.
 type PollerConfigPollerGCPMonitoringConfig struct {
 	Typename                  *string                             `json:"__typename"`
@@ -7377,6 +9764,88 @@ func (v *RbacSubjectInput) GetGroupId() *string { return v.GroupId }
 // GetAll returns RbacSubjectInput.All, and is useful for accessing the field via an interface.
 func (v *RbacSubjectInput) GetAll() *bool { return v.All }
 
+// ReferenceTable includes the GraphQL fields of ReferenceTable requested by the fragment ReferenceTable.
+type ReferenceTable struct {
+	Id          string  `json:"id"`
+	WorkspaceId string  `json:"workspaceId"`
+	Name        string  `json:"name"`
+	IconUrl     *string `json:"iconUrl"`
+	Description *string `json:"description"`
+	DatasetID   string  `json:"datasetID"`
+	ManagedById *string `json:"managedById"`
+}
+
+// GetId returns ReferenceTable.Id, and is useful for accessing the field via an interface.
+func (v *ReferenceTable) GetId() string { return v.Id }
+
+// GetWorkspaceId returns ReferenceTable.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *ReferenceTable) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetName returns ReferenceTable.Name, and is useful for accessing the field via an interface.
+func (v *ReferenceTable) GetName() string { return v.Name }
+
+// GetIconUrl returns ReferenceTable.IconUrl, and is useful for accessing the field via an interface.
+func (v *ReferenceTable) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns ReferenceTable.Description, and is useful for accessing the field via an interface.
+func (v *ReferenceTable) GetDescription() *string { return v.Description }
+
+// GetDatasetID returns ReferenceTable.DatasetID, and is useful for accessing the field via an interface.
+func (v *ReferenceTable) GetDatasetID() string { return v.DatasetID }
+
+// GetManagedById returns ReferenceTable.ManagedById, and is useful for accessing the field via an interface.
+func (v *ReferenceTable) GetManagedById() *string { return v.ManagedById }
+
+type ReferenceTableInput struct {
+	Upload      *types.UploadScalar    `json:"upload,omitempty"`
+	Schema      []DatasetFieldDefInput `json:"schema,omitempty"`
+	PrimaryKey  []string               `json:"primaryKey,omitempty"`
+	Name        *string                `json:"name"`
+	IconUrl     *string                `json:"iconUrl,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	ManagedById *string                `json:"managedById,omitempty"`
+	FolderId    *string                `json:"folderId,omitempty"`
+}
+
+// GetUpload returns ReferenceTableInput.Upload, and is useful for accessing the field via an interface.
+func (v *ReferenceTableInput) GetUpload() *types.UploadScalar { return v.Upload }
+
+// GetSchema returns ReferenceTableInput.Schema, and is useful for accessing the field via an interface.
+func (v *ReferenceTableInput) GetSchema() []DatasetFieldDefInput { return v.Schema }
+
+// GetPrimaryKey returns ReferenceTableInput.PrimaryKey, and is useful for accessing the field via an interface.
+func (v *ReferenceTableInput) GetPrimaryKey() []string { return v.PrimaryKey }
+
+// GetName returns ReferenceTableInput.Name, and is useful for accessing the field via an interface.
+func (v *ReferenceTableInput) GetName() *string { return v.Name }
+
+// GetIconUrl returns ReferenceTableInput.IconUrl, and is useful for accessing the field via an interface.
+func (v *ReferenceTableInput) GetIconUrl() *string { return v.IconUrl }
+
+// GetDescription returns ReferenceTableInput.Description, and is useful for accessing the field via an interface.
+func (v *ReferenceTableInput) GetDescription() *string { return v.Description }
+
+// GetManagedById returns ReferenceTableInput.ManagedById, and is useful for accessing the field via an interface.
+func (v *ReferenceTableInput) GetManagedById() *string { return v.ManagedById }
+
+// GetFolderId returns ReferenceTableInput.FolderId, and is useful for accessing the field via an interface.
+func (v *ReferenceTableInput) GetFolderId() *string { return v.FolderId }
+
+type RematerializationInput struct {
+	// A rematerialization input contains a collection of rematerialization requests on
+	// individual datasets. It is OK to have duplicate or overlapping requests.
+	// Backend will handle that.
+	Requests []RematerializationRequest `json:"requests"`
+	// Optional context provided by the caller.
+	Context *string `json:"context,omitempty"`
+}
+
+// GetRequests returns RematerializationInput.Requests, and is useful for accessing the field via an interface.
+func (v *RematerializationInput) GetRequests() []RematerializationRequest { return v.Requests }
+
+// GetContext returns RematerializationInput.Context, and is useful for accessing the field via an interface.
+func (v *RematerializationInput) GetContext() *string { return v.Context }
+
 // Specifies what type of rematerialization will occur when a dataset is updated
 type RematerializationMode string
 
@@ -7389,6 +9858,22 @@ const (
 	RematerializationModeSkiprematerialization RematerializationMode = "SkipRematerialization"
 )
 
+type RematerializationRequest struct {
+	// The ID of the dataset to be rematerialized.
+	DatasetId string `json:"datasetId"`
+	// The time ranges to be rematerialized. It is OK to have duplicate or overlapping
+	// ranges. Backend will handle that. This is an optional field and hence if the intervals is not
+	// specified then the intervals corresponds to the backend internal setting that is used in the case
+	// of a real rematerialization.
+	Intervals []TimeRangeInput `json:"intervals,omitempty"`
+}
+
+// GetDatasetId returns RematerializationRequest.DatasetId, and is useful for accessing the field via an interface.
+func (v *RematerializationRequest) GetDatasetId() string { return v.DatasetId }
+
+// GetIntervals returns RematerializationRequest.Intervals, and is useful for accessing the field via an interface.
+func (v *RematerializationRequest) GetIntervals() []TimeRangeInput { return v.Intervals }
+
 type ResourceIdInput struct {
 	DatasetId       string                `json:"datasetId"`
 	PrimaryKeyValue []ColumnAndValueInput `json:"primaryKeyValue"`
@@ -7514,6 +9999,13 @@ const (
 	SearchMatchKindSearchmatchcolumns SearchMatchKind = "SearchMatchColumns"
 )
 
+type SearchMode string
+
+const (
+	SearchModeInclusivemode SearchMode = "InclusiveMode"
+	SearchModeExclusivemode SearchMode = "ExclusiveMode"
+)
+
 // SettingAndTargetScope includes the GraphQL fields of SettingAndTargetScope requested by the fragment SettingAndTargetScope.
 type SettingAndTargetScope struct {
 	Setting string                     `json:"setting"`
@@ -8337,6 +10829,72 @@ func (v *__addCorrelationTagInput) GetPath() LinkFieldInput { return v.Path }
 // GetTag returns __addCorrelationTagInput.Tag, and is useful for accessing the field via an interface.
 func (v *__addCorrelationTagInput) GetTag() string { return v.Tag }
 
+// __addIncidentDashboardsInput is used internally by genqlient
+type __addIncidentDashboardsInput struct {
+	Id         string   `json:"id"`
+	Dashboards []string `json:"dashboards"`
+}
+
+// GetId returns __addIncidentDashboardsInput.Id, and is useful for accessing the field via an interface.
+func (v *__addIncidentDashboardsInput) GetId() string { return v.Id }
+
+// GetDashboards returns __addIncidentDashboardsInput.Dashboards, and is useful for accessing the field via an interface.
+func (v *__addIncidentDashboardsInput) GetDashboards() []string { return v.Dashboards }
+
+// __addIncidentSlackChannelsInput is used internally by genqlient
+type __addIncidentSlackChannelsInput struct {
+	Id       string                      `json:"id"`
+	Channels []IncidentSlackchannelInput `json:"channels"`
+}
+
+// GetId returns __addIncidentSlackChannelsInput.Id, and is useful for accessing the field via an interface.
+func (v *__addIncidentSlackChannelsInput) GetId() string { return v.Id }
+
+// GetChannels returns __addIncidentSlackChannelsInput.Channels, and is useful for accessing the field via an interface.
+func (v *__addIncidentSlackChannelsInput) GetChannels() []IncidentSlackchannelInput {
+	return v.Channels
+}
+
+// __addIncidentUsersInput is used internally by genqlient
+type __addIncidentUsersInput struct {
+	Id    string               `json:"id"`
+	Users []types.UserIdScalar `json:"users"`
+}
+
+// GetId returns __addIncidentUsersInput.Id, and is useful for accessing the field via an interface.
+func (v *__addIncidentUsersInput) GetId() string { return v.Id }
+
+// GetUsers returns __addIncidentUsersInput.Users, and is useful for accessing the field via an interface.
+func (v *__addIncidentUsersInput) GetUsers() []types.UserIdScalar { return v.Users }
+
+// __addIncidentWorksheetsInput is used internally by genqlient
+type __addIncidentWorksheetsInput struct {
+	Id         string   `json:"id"`
+	Worksheets []string `json:"worksheets"`
+}
+
+// GetId returns __addIncidentWorksheetsInput.Id, and is useful for accessing the field via an interface.
+func (v *__addIncidentWorksheetsInput) GetId() string { return v.Id }
+
+// GetWorksheets returns __addIncidentWorksheetsInput.Worksheets, and is useful for accessing the field via an interface.
+func (v *__addIncidentWorksheetsInput) GetWorksheets() []string { return v.Worksheets }
+
+// __cancelAccelerationJobInput is used internally by genqlient
+type __cancelAccelerationJobInput struct {
+	JobId string `json:"jobId"`
+}
+
+// GetJobId returns __cancelAccelerationJobInput.JobId, and is useful for accessing the field via an interface.
+func (v *__cancelAccelerationJobInput) GetJobId() string { return v.JobId }
+
+// __checkQueriesInput is used internally by genqlient
+type __checkQueriesInput struct {
+	Queries MultiStageQueryInput `json:"queries"`
+}
+
+// GetQueries returns __checkQueriesInput.Queries, and is useful for accessing the field via an interface.
+func (v *__checkQueriesInput) GetQueries() MultiStageQueryInput { return v.Queries }
+
 // __clearDefaultDashboardInput is used internally by genqlient
 type __clearDefaultDashboardInput struct {
 	Dsid string `json:"dsid"`
@@ -8345,6 +10903,26 @@ type __clearDefaultDashboardInput struct {
 // GetDsid returns __clearDefaultDashboardInput.Dsid, and is useful for accessing the field via an interface.
 func (v *__clearDefaultDashboardInput) GetDsid() string { return v.Dsid }
 
+// __createAccelerationJobInput is used internally by genqlient
+type __createAccelerationJobInput struct {
+	Job AccelerationJobInput `json:"job"`
+}
+
+// GetJob returns __createAccelerationJobInput.Job, and is useful for accessing the field via an interface.
+func (v *__createAccelerationJobInput) GetJob() AccelerationJobInput { return v.Job }
+
+// __createApiTokenInput is used internally by genqlient
+type __createApiTokenInput struct {
+	Input      AuthtokenInput      `json:"input"`
+	OwningUser *types.UserIdScalar `json:"owningUser"`
+}
+
+// GetInput returns __createApiTokenInput.Input, and is useful for accessing the field via an interface.
+func (v *__createApiTokenInput) GetInput() AuthtokenInput { return v.Input }
+
+// GetOwningUser returns __createApiTokenInput.OwningUser, and is useful for accessing the field via an interface.
+func (v *__createApiTokenInput) GetOwningUser() *types.UserIdScalar { return v.OwningUser }
+
 // __createAppDataSourceInput is used internally by genqlient
 type __createAppDataSourceInput struct {
 	Config AppDataSourceInput `json:"config"`
@@ -8413,6 +10991,18 @@ type __createDashboardLinkInput struct {
 // GetInput returns __createDashboardLinkInput.Input, and is useful for accessing the field via an interface.
 func (v *__createDashboardLinkInput) GetInput() DashboardLinkInput { return v.Input }
 
+// __createDataConnectionInput is used internally by genqlient
+type __createDataConnectionInput struct {
+	WorkspaceId string              `json:"workspaceId"`
+	Input       DataConnectionInput `json:"input"`
+}
+
+// GetWorkspaceId returns __createDataConnectionInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__createDataConnectionInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetInput returns __createDataConnectionInput.Input, and is useful for accessing the field via an interface.
+func (v *__createDataConnectionInput) GetInput() DataConnectionInput { return v.Input }
+
 // __createDatasetOutboundShareInput is used internally by genqlient
 type __createDatasetOutboundShareInput struct {
 	WorkspaceId     string                    `json:"workspaceId"`
@@ -8433,6 +11023,18 @@ func (v *__createDatasetOutboundShareInput) GetOutboundShareID() string { return
 // GetInput returns __createDatasetOutboundShareInput.Input, and is useful for accessing the field via an interface.
 func (v *__createDatasetOutboundShareInput) GetInput() DatasetOutboundShareInput { return v.Input }
 
+// __createDatasourceInput is used internally by genqlient
+type __createDatasourceInput struct {
+	WorkspaceId string          `json:"workspaceId"`
+	Input       DatasourceInput `json:"input"`
+}
+
+// GetWorkspaceId returns __createDatasourceInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__createDatasourceInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetInput returns __createDatasourceInput.Input, and is useful for accessing the field via an interface.
+func (v *__createDatasourceInput) GetInput() DatasourceInput { return v.Input }
+
 // __createDatastreamInput is used internally by genqlient
 type __createDatastreamInput struct {
 	WorkspaceId string          `json:"workspaceId"`
@@ -8501,6 +11103,30 @@ func (v *__createFolderInput) GetWorkspaceId() string { return v.WorkspaceId }
 // GetConfig returns __createFolderInput.Config, and is useful for accessing the field via an interface.
 func (v *__createFolderInput) GetConfig() FolderInput { return v.Config }
 
+// __createIncidentInput is used internally by genqlient
+type __createIncidentInput struct {
+	WorkspaceId string        `json:"workspaceId"`
+	Input       IncidentInput `json:"input"`
+}
+
+// GetWorkspaceId returns __createIncidentInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__createIncidentInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetInput returns __createIncidentInput.Input, and is useful for accessing the field via an interface.
+func (v *__createIncidentInput) GetInput() IncidentInput { return v.Input }
+
+// __createInvestigationNotebookInput is used internally by genqlient
+type __createInvestigationNotebookInput struct {
+	WorkspaceId string                     `json:"workspaceId"`
+	Input       InvestigationNotebookInput `json:"input"`
+}
+
+// GetWorkspaceId returns __createInvestigationNotebookInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__createInvestigationNotebookInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetInput returns __createInvestigationNotebookInput.Input, and is useful for accessing the field via an interface.
+func (v *__createInvestigationNotebookInput) GetInput() InvestigationNotebookInput { return v.Input }
+
 // __createLayeredSettingRecordInput is used internally by genqlient
 type __createLayeredSettingRecordInput struct {
 	SettingRecord LayeredSettingRecordInput `json:"settingRecord"`
@@ -8541,6 +11167,26 @@ func (v *__createMonitorInput) GetWorkspaceId() string { return v.WorkspaceId }
 // GetMonitor returns __createMonitorInput.Monitor, and is useful for accessing the field via an interface.
 func (v *__createMonitorInput) GetMonitor() MonitorInput { return v.Monitor }
 
+// __createMonitorMuteRuleForMonitorsInput is used internally by genqlient
+type __createMonitorMuteRuleForMonitorsInput struct {
+	Input      MonitorMuteRuleInput `json:"input"`
+	MonitorIds []string             `json:"monitorIds"`
+}
+
+// GetInput returns __createMonitorMuteRuleForMonitorsInput.Input, and is useful for accessing the field via an interface.
+func (v *__createMonitorMuteRuleForMonitorsInput) GetInput() MonitorMuteRuleInput { return v.Input }
+
+// GetMonitorIds returns __createMonitorMuteRuleForMonitorsInput.MonitorIds, and is useful for accessing the field via an interface.
+func (v *__createMonitorMuteRuleForMonitorsInput) GetMonitorIds() []string { return v.MonitorIds }
+
+// __createMonitorMuteRuleInput is used internally by genqlient
+type __createMonitorMuteRuleInput struct {
+	Input MonitorMuteRuleInput `json:"input"`
+}
+
+// GetInput returns __createMonitorMuteRuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__createMonitorMuteRuleInput) GetInput() MonitorMuteRuleInput { return v.Input }
+
 // __createMonitorV2ActionInput is used internally by genqlient
 type __createMonitorV2ActionInput struct {
 	WorkspaceId string               `json:"workspaceId"`
@@ -8565,6 +11211,18 @@ func (v *__createMonitorV2Input) GetWorkspaceId() string { return v.WorkspaceId
 // GetInput returns __createMonitorV2Input.Input, and is useful for accessing the field via an interface.
 func (v *__createMonitorV2Input) GetInput() MonitorV2Input { return v.Input }
 
+// __createMonitorV2MuteRuleInput is used internally by genqlient
+type __createMonitorV2MuteRuleInput struct {
+	WorkspaceId string                 `json:"workspaceId"`
+	Input       MonitorV2MuteRuleInput `json:"input"`
+}
+
+// GetWorkspaceId returns __createMonitorV2MuteRuleInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__createMonitorV2MuteRuleInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetInput returns __createMonitorV2MuteRuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__createMonitorV2MuteRuleInput) GetInput() MonitorV2MuteRuleInput { return v.Input }
+
 // __createOrUpdateBookmarkGroupInput is used internally by genqlient
 type __createOrUpdateBookmarkGroupInput struct {
 	Id    *string            `json:"id"`
@@ -8637,6 +11295,18 @@ type __createRbacStatementInput struct {
 // GetConfig returns __createRbacStatementInput.Config, and is useful for accessing the field via an interface.
 func (v *__createRbacStatementInput) GetConfig() RbacStatementInput { return v.Config }
 
+// __createReferenceTableInput is used internally by genqlient
+type __createReferenceTableInput struct {
+	WorkspaceId string              `json:"workspaceId"`
+	Input       ReferenceTableInput `json:"input"`
+}
+
+// GetWorkspaceId returns __createReferenceTableInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__createReferenceTableInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetInput returns __createReferenceTableInput.Input, and is useful for accessing the field via an interface.
+func (v *__createReferenceTableInput) GetInput() ReferenceTableInput { return v.Input }
+
 // __createSnowflakeOutboundShareInput is used internally by genqlient
 type __createSnowflakeOutboundShareInput struct {
 	WorkspaceId string                      `json:"workspaceId"`
@@ -8657,6 +11327,14 @@ type __createWorkspaceInput struct {
 // GetConfig returns __createWorkspaceInput.Config, and is useful for accessing the field via an interface.
 func (v *__createWorkspaceInput) GetConfig() WorkspaceInput { return v.Config }
 
+// __deleteApiTokenInput is used internally by genqlient
+type __deleteApiTokenInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteApiTokenInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteApiTokenInput) GetId() string { return v.Id }
+
 // __deleteAppDataSourceInput is used internally by genqlient
 type __deleteAppDataSourceInput struct {
 	Id string `json:"id"`
@@ -8729,6 +11407,14 @@ type __deleteDashboardLinkInput struct {
 // GetId returns __deleteDashboardLinkInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteDashboardLinkInput) GetId() string { return v.Id }
 
+// __deleteDataConnectionInput is used internally by genqlient
+type __deleteDataConnectionInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteDataConnectionInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteDataConnectionInput) GetId() string { return v.Id }
+
 // __deleteDatasetInput is used internally by genqlient
 type __deleteDatasetInput struct {
 	Id  string                   `json:"id"`
@@ -8749,6 +11435,14 @@ type __deleteDatasetOutboundShareInput struct {
 // GetId returns __deleteDatasetOutboundShareInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteDatasetOutboundShareInput) GetId() string { return v.Id }
 
+// __deleteDatasourceInput is used internally by genqlient
+type __deleteDatasourceInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteDatasourceInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteDatasourceInput) GetId() string { return v.Id }
+
 // __deleteDatastreamInput is used internally by genqlient
 type __deleteDatastreamInput struct {
 	Id string `json:"id"`
@@ -8789,6 +11483,22 @@ type __deleteFolderInput struct {
 // GetId returns __deleteFolderInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteFolderInput) GetId() string { return v.Id }
 
+// __deleteIncidentInput is used internally by genqlient
+type __deleteIncidentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteIncidentInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteIncidentInput) GetId() string { return v.Id }
+
+// __deleteInvestigationNotebookInput is used internally by genqlient
+type __deleteInvestigationNotebookInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteInvestigationNotebookInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteInvestigationNotebookInput) GetId() string { return v.Id }
+
 // __deleteLayeredSettingRecordInput is used internally by genqlient
 type __deleteLayeredSettingRecordInput struct {
 	Id string `json:"id"`
@@ -8821,6 +11531,14 @@ type __deleteMonitorInput struct {
 // GetId returns __deleteMonitorInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteMonitorInput) GetId() string { return v.Id }
 
+// __deleteMonitorMuteRuleInput is used internally by genqlient
+type __deleteMonitorMuteRuleInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteMonitorMuteRuleInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteMonitorMuteRuleInput) GetId() string { return v.Id }
+
 // __deleteMonitorV2ActionInput is used internally by genqlient
 type __deleteMonitorV2ActionInput struct {
 	Id string `json:"id"`
@@ -8837,6 +11555,14 @@ type __deleteMonitorV2Input struct {
 // GetId returns __deleteMonitorV2Input.Id, and is useful for accessing the field via an interface.
 func (v *__deleteMonitorV2Input) GetId() string { return v.Id }
 
+// __deleteMonitorV2MuteRuleInput is used internally by genqlient
+type __deleteMonitorV2MuteRuleInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteMonitorV2MuteRuleInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteMonitorV2MuteRuleInput) GetId() string { return v.Id }
+
 // __deletePollerInput is used internally by genqlient
 type __deletePollerInput struct {
 	Id string `json:"id"`
@@ -8877,6 +11603,14 @@ type __deleteRbacStatementInput struct {
 // GetId returns __deleteRbacStatementInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteRbacStatementInput) GetId() string { return v.Id }
 
+// __deleteReferenceTableInput is used internally by genqlient
+type __deleteReferenceTableInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteReferenceTableInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteReferenceTableInput) GetId() string { return v.Id }
+
 // __deleteSnowflakeOutboundShareInput is used internally by genqlient
 type __deleteSnowflakeOutboundShareInput struct {
 	Id string `json:"id"`
@@ -8901,6 +11635,30 @@ type __deleteWorkspaceInput struct {
 // GetId returns __deleteWorkspaceInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteWorkspaceInput) GetId() string { return v.Id }
 
+// __estimateRematerializationCostInput is used internally by genqlient
+type __estimateRematerializationCostInput struct {
+	Job RematerializationInput `json:"job"`
+}
+
+// GetJob returns __estimateRematerializationCostInput.Job, and is useful for accessing the field via an interface.
+func (v *__estimateRematerializationCostInput) GetJob() RematerializationInput { return v.Job }
+
+// __getAccelerationJobInput is used internally by genqlient
+type __getAccelerationJobInput struct {
+	JobId string `json:"jobId"`
+}
+
+// GetJobId returns __getAccelerationJobInput.JobId, and is useful for accessing the field via an interface.
+func (v *__getAccelerationJobInput) GetJobId() string { return v.JobId }
+
+// __getApiTokenInput is used internally by genqlient
+type __getApiTokenInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getApiTokenInput.Id, and is useful for accessing the field via an interface.
+func (v *__getApiTokenInput) GetId() string { return v.Id }
+
 // __getAppDataSourceInput is used internally by genqlient
 type __getAppDataSourceInput struct {
 	Id string `json:"id"`
@@ -8973,6 +11731,22 @@ type __getDashboardLinkInput struct {
 // GetId returns __getDashboardLinkInput.Id, and is useful for accessing the field via an interface.
 func (v *__getDashboardLinkInput) GetId() string { return v.Id }
 
+// __getDataConnectionInput is used internally by genqlient
+type __getDataConnectionInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getDataConnectionInput.Id, and is useful for accessing the field via an interface.
+func (v *__getDataConnectionInput) GetId() string { return v.Id }
+
+// __getDatasetAccelerationStatusInput is used internally by genqlient
+type __getDatasetAccelerationStatusInput struct {
+	DatasetId string `json:"datasetId"`
+}
+
+// GetDatasetId returns __getDatasetAccelerationStatusInput.DatasetId, and is useful for accessing the field via an interface.
+func (v *__getDatasetAccelerationStatusInput) GetDatasetId() string { return v.DatasetId }
+
 // __getDatasetCorrelationTagsInput is used internally by genqlient
 type __getDatasetCorrelationTagsInput struct {
 	DatasetId string `json:"datasetId"`
@@ -8981,6 +11755,18 @@ type __getDatasetCorrelationTagsInput struct {
 // GetDatasetId returns __getDatasetCorrelationTagsInput.DatasetId, and is useful for accessing the field via an interface.
 func (v *__getDatasetCorrelationTagsInput) GetDatasetId() string { return v.DatasetId }
 
+// __getDatasetDoctorReportInput is used internally by genqlient
+type __getDatasetDoctorReportInput struct {
+	DatasetId string `json:"datasetId"`
+	UpLevels  *int   `json:"upLevels"`
+}
+
+// GetDatasetId returns __getDatasetDoctorReportInput.DatasetId, and is useful for accessing the field via an interface.
+func (v *__getDatasetDoctorReportInput) GetDatasetId() string { return v.DatasetId }
+
+// GetUpLevels returns __getDatasetDoctorReportInput.UpLevels, and is useful for accessing the field via an interface.
+func (v *__getDatasetDoctorReportInput) GetUpLevels() *int { return v.UpLevels }
+
 // __getDatasetInput is used internally by genqlient
 type __getDatasetInput struct {
 	Id string `json:"id"`
@@ -9009,6 +11795,14 @@ func (v *__getDatasetQueryOutputInput) GetQuery() []*StageInput { return v.Query
 // GetParams returns __getDatasetQueryOutputInput.Params, and is useful for accessing the field via an interface.
 func (v *__getDatasetQueryOutputInput) GetParams() QueryParams { return v.Params }
 
+// __getDatasourceInput is used internally by genqlient
+type __getDatasourceInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getDatasourceInput.Id, and is useful for accessing the field via an interface.
+func (v *__getDatasourceInput) GetId() string { return v.Id }
+
 // __getDatastreamInput is used internally by genqlient
 type __getDatastreamInput struct {
 	Id string `json:"id"`
@@ -9057,6 +11851,38 @@ type __getFolderInput struct {
 // GetId returns __getFolderInput.Id, and is useful for accessing the field via an interface.
 func (v *__getFolderInput) GetId() string { return v.Id }
 
+// __getIncidentInput is used internally by genqlient
+type __getIncidentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getIncidentInput.Id, and is useful for accessing the field via an interface.
+func (v *__getIncidentInput) GetId() string { return v.Id }
+
+// __getIncidentsForStatusInput is used internally by genqlient
+type __getIncidentsForStatusInput struct {
+	Status     IncidentStatus    `json:"status"`
+	StartingAt *types.TimeScalar `json:"startingAt"`
+	EndingAt   *types.TimeScalar `json:"endingAt"`
+}
+
+// GetStatus returns __getIncidentsForStatusInput.Status, and is useful for accessing the field via an interface.
+func (v *__getIncidentsForStatusInput) GetStatus() IncidentStatus { return v.Status }
+
+// GetStartingAt returns __getIncidentsForStatusInput.StartingAt, and is useful for accessing the field via an interface.
+func (v *__getIncidentsForStatusInput) GetStartingAt() *types.TimeScalar { return v.StartingAt }
+
+// GetEndingAt returns __getIncidentsForStatusInput.EndingAt, and is useful for accessing the field via an interface.
+func (v *__getIncidentsForStatusInput) GetEndingAt() *types.TimeScalar { return v.EndingAt }
+
+// __getInvestigationNotebookInput is used internally by genqlient
+type __getInvestigationNotebookInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getInvestigationNotebookInput.Id, and is useful for accessing the field via an interface.
+func (v *__getInvestigationNotebookInput) GetId() string { return v.Id }
+
 // __getLayeredSettingRecordInput is used internally by genqlient
 type __getLayeredSettingRecordInput struct {
 	Id string `json:"id"`
@@ -9089,6 +11915,14 @@ type __getMonitorInput struct {
 // GetId returns __getMonitorInput.Id, and is useful for accessing the field via an interface.
 func (v *__getMonitorInput) GetId() string { return v.Id }
 
+// __getMonitorMuteRuleInput is used internally by genqlient
+type __getMonitorMuteRuleInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getMonitorMuteRuleInput.Id, and is useful for accessing the field via an interface.
+func (v *__getMonitorMuteRuleInput) GetId() string { return v.Id }
+
 // __getMonitorV2ActionInput is used internally by genqlient
 type __getMonitorV2ActionInput struct {
 	Id string `json:"id"`
@@ -9105,6 +11939,28 @@ type __getMonitorV2Input struct {
 // GetId returns __getMonitorV2Input.Id, and is useful for accessing the field via an interface.
 func (v *__getMonitorV2Input) GetId() string { return v.Id }
 
+// __getMonitorV2TemplateDictionaryInput is used internally by genqlient
+type __getMonitorV2TemplateDictionaryInput struct {
+	AlertType    *MonitorV2AlertType `json:"alertType"`
+	MonitorInput MonitorV2Input      `json:"monitorInput"`
+	AlarmInput   MonitorV2AlarmInput `json:"alarmInput"`
+}
+
+// GetAlertType returns __getMonitorV2TemplateDictionaryInput.AlertType, and is useful for accessing the field via an interface.
+func (v *__getMonitorV2TemplateDictionaryInput) GetAlertType() *MonitorV2AlertType {
+	return v.AlertType
+}
+
+// GetMonitorInput returns __getMonitorV2TemplateDictionaryInput.MonitorInput, and is useful for accessing the field via an interface.
+func (v *__getMonitorV2TemplateDictionaryInput) GetMonitorInput() MonitorV2Input {
+	return v.MonitorInput
+}
+
+// GetAlarmInput returns __getMonitorV2TemplateDictionaryInput.AlarmInput, and is useful for accessing the field via an interface.
+func (v *__getMonitorV2TemplateDictionaryInput) GetAlarmInput() MonitorV2AlarmInput {
+	return v.AlarmInput
+}
+
 // __getPollerInput is used internally by genqlient
 type __getPollerInput struct {
 	Id string `json:"id"`
@@ -9145,6 +12001,14 @@ type __getRbacStatementInput struct {
 // GetId returns __getRbacStatementInput.Id, and is useful for accessing the field via an interface.
 func (v *__getRbacStatementInput) GetId() string { return v.Id }
 
+// __getReferenceTableInput is used internally by genqlient
+type __getReferenceTableInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getReferenceTableInput.Id, and is useful for accessing the field via an interface.
+func (v *__getReferenceTableInput) GetId() string { return v.Id }
+
 // __getSnowflakeOutboundShareInput is used internally by genqlient
 type __getSnowflakeOutboundShareInput struct {
 	Id string `json:"id"`
@@ -9189,6 +12053,38 @@ type __getWorkspaceInput struct {
 // GetId returns __getWorkspaceInput.Id, and is useful for accessing the field via an interface.
 func (v *__getWorkspaceInput) GetId() string { return v.Id }
 
+// __listDatasetInputsInput is used internally by genqlient
+type __listDatasetInputsInput struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetWorkspaceId returns __listDatasetInputsInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__listDatasetInputsInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// __listDatastreamsInput is used internally by genqlient
+type __listDatastreamsInput struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetWorkspaceId returns __listDatastreamsInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__listDatastreamsInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// __listMonitorsInput is used internally by genqlient
+type __listMonitorsInput struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetWorkspaceId returns __listMonitorsInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__listMonitorsInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// __listPollersInput is used internally by genqlient
+type __listPollersInput struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetWorkspaceId returns __listPollersInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__listPollersInput) GetWorkspaceId() string { return v.WorkspaceId }
+
 // __listWorksheetsIdLabelOnlyInput is used internally by genqlient
 type __listWorksheetsIdLabelOnlyInput struct {
 	WorkspaceId string `json:"workspaceId"`
@@ -9209,6 +12105,18 @@ func (v *__lookupAppInput) GetWorkspaceId() string { return v.WorkspaceId }
 // GetName returns __lookupAppInput.Name, and is useful for accessing the field via an interface.
 func (v *__lookupAppInput) GetName() string { return v.Name }
 
+// __lookupDataConnectionModuleVersionsInput is used internally by genqlient
+type __lookupDataConnectionModuleVersionsInput struct {
+	Id          string `json:"id"`
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetId returns __lookupDataConnectionModuleVersionsInput.Id, and is useful for accessing the field via an interface.
+func (v *__lookupDataConnectionModuleVersionsInput) GetId() string { return v.Id }
+
+// GetWorkspaceId returns __lookupDataConnectionModuleVersionsInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__lookupDataConnectionModuleVersionsInput) GetWorkspaceId() string { return v.WorkspaceId }
+
 // __lookupDatasetInput is used internally by genqlient
 type __lookupDatasetInput struct {
 	WorkspaceId string `json:"workspaceId"`
@@ -9321,20 +12229,82 @@ func (v *__removeCorrelationTagInput) GetPath() LinkFieldInput { return v.Path }
 // GetTag returns __removeCorrelationTagInput.Tag, and is useful for accessing the field via an interface.
 func (v *__removeCorrelationTagInput) GetTag() string { return v.Tag }
 
-// __saveDashboardInput is used internally by genqlient
-type __saveDashboardInput struct {
-	DashboardInput DashboardInput `json:"dashboardInput"`
+// __removeIncidentDashboardsInput is used internally by genqlient
+type __removeIncidentDashboardsInput struct {
+	Id         string   `json:"id"`
+	Dashboards []string `json:"dashboards"`
 }
 
-// GetDashboardInput returns __saveDashboardInput.DashboardInput, and is useful for accessing the field via an interface.
-func (v *__saveDashboardInput) GetDashboardInput() DashboardInput { return v.DashboardInput }
+// GetId returns __removeIncidentDashboardsInput.Id, and is useful for accessing the field via an interface.
+func (v *__removeIncidentDashboardsInput) GetId() string { return v.Id }
 
-// __saveDatasetInput is used internally by genqlient
-type __saveDatasetInput struct {
-	WorkspaceId string                   `json:"workspaceId"`
-	Dataset     DatasetInput             `json:"dataset"`
-	Query       MultiStageQueryInput     `json:"query"`
-	Dep         *DependencyHandlingInput `json:"dep"`
+// GetDashboards returns __removeIncidentDashboardsInput.Dashboards, and is useful for accessing the field via an interface.
+func (v *__removeIncidentDashboardsInput) GetDashboards() []string { return v.Dashboards }
+
+// __removeIncidentSlackChannelsInput is used internally by genqlient
+type __removeIncidentSlackChannelsInput struct {
+	Id       string                      `json:"id"`
+	Channels []IncidentSlackchannelInput `json:"channels"`
+}
+
+// GetId returns __removeIncidentSlackChannelsInput.Id, and is useful for accessing the field via an interface.
+func (v *__removeIncidentSlackChannelsInput) GetId() string { return v.Id }
+
+// GetChannels returns __removeIncidentSlackChannelsInput.Channels, and is useful for accessing the field via an interface.
+func (v *__removeIncidentSlackChannelsInput) GetChannels() []IncidentSlackchannelInput {
+	return v.Channels
+}
+
+// __removeIncidentUsersInput is used internally by genqlient
+type __removeIncidentUsersInput struct {
+	Id    string               `json:"id"`
+	Users []types.UserIdScalar `json:"users"`
+}
+
+// GetId returns __removeIncidentUsersInput.Id, and is useful for accessing the field via an interface.
+func (v *__removeIncidentUsersInput) GetId() string { return v.Id }
+
+// GetUsers returns __removeIncidentUsersInput.Users, and is useful for accessing the field via an interface.
+func (v *__removeIncidentUsersInput) GetUsers() []types.UserIdScalar { return v.Users }
+
+// __removeIncidentWorksheetsInput is used internally by genqlient
+type __removeIncidentWorksheetsInput struct {
+	Id         string   `json:"id"`
+	Worksheets []string `json:"worksheets"`
+}
+
+// GetId returns __removeIncidentWorksheetsInput.Id, and is useful for accessing the field via an interface.
+func (v *__removeIncidentWorksheetsInput) GetId() string { return v.Id }
+
+// GetWorksheets returns __removeIncidentWorksheetsInput.Worksheets, and is useful for accessing the field via an interface.
+func (v *__removeIncidentWorksheetsInput) GetWorksheets() []string { return v.Worksheets }
+
+// __renderMonitorV2TemplateInput is used internally by genqlient
+type __renderMonitorV2TemplateInput struct {
+	TemplateDict types.JsonObject     `json:"templateDict"`
+	ActionInput  MonitorV2ActionInput `json:"actionInput"`
+}
+
+// GetTemplateDict returns __renderMonitorV2TemplateInput.TemplateDict, and is useful for accessing the field via an interface.
+func (v *__renderMonitorV2TemplateInput) GetTemplateDict() types.JsonObject { return v.TemplateDict }
+
+// GetActionInput returns __renderMonitorV2TemplateInput.ActionInput, and is useful for accessing the field via an interface.
+func (v *__renderMonitorV2TemplateInput) GetActionInput() MonitorV2ActionInput { return v.ActionInput }
+
+// __saveDashboardInput is used internally by genqlient
+type __saveDashboardInput struct {
+	DashboardInput DashboardInput `json:"dashboardInput"`
+}
+
+// GetDashboardInput returns __saveDashboardInput.DashboardInput, and is useful for accessing the field via an interface.
+func (v *__saveDashboardInput) GetDashboardInput() DashboardInput { return v.DashboardInput }
+
+// __saveDatasetInput is used internally by genqlient
+type __saveDatasetInput struct {
+	WorkspaceId string                   `json:"workspaceId"`
+	Dataset     DatasetInput             `json:"dataset"`
+	Query       MultiStageQueryInput     `json:"query"`
+	Dep         *DependencyHandlingInput `json:"dep"`
 }
 
 // GetWorkspaceId returns __saveDatasetInput.WorkspaceId, and is useful for accessing the field via an interface.
@@ -9349,6 +12319,22 @@ func (v *__saveDatasetInput) GetQuery() MultiStageQueryInput { return v.Query }
 // GetDep returns __saveDatasetInput.Dep, and is useful for accessing the field via an interface.
 func (v *__saveDatasetInput) GetDep() *DependencyHandlingInput { return v.Dep }
 
+// __saveFolderSettingsInput is used internally by genqlient
+type __saveFolderSettingsInput struct {
+	WorkspaceId string             `json:"workspaceId"`
+	Id          string             `json:"id"`
+	Input       LayeredFolderInput `json:"input"`
+}
+
+// GetWorkspaceId returns __saveFolderSettingsInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__saveFolderSettingsInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetId returns __saveFolderSettingsInput.Id, and is useful for accessing the field via an interface.
+func (v *__saveFolderSettingsInput) GetId() string { return v.Id }
+
+// GetInput returns __saveFolderSettingsInput.Input, and is useful for accessing the field via an interface.
+func (v *__saveFolderSettingsInput) GetInput() LayeredFolderInput { return v.Input }
+
 // __saveMonitorV2RelationsInput is used internally by genqlient
 type __saveMonitorV2RelationsInput struct {
 	MonitorId       string                `json:"monitorId"`
@@ -9393,6 +12379,158 @@ type __saveWorksheetInput struct {
 // GetWorksheetInput returns __saveWorksheetInput.WorksheetInput, and is useful for accessing the field via an interface.
 func (v *__saveWorksheetInput) GetWorksheetInput() WorksheetInput { return v.WorksheetInput }
 
+// __searchApiTokensInput is used internally by genqlient
+type __searchApiTokensInput struct {
+	User *types.UserIdScalar `json:"user"`
+}
+
+// GetUser returns __searchApiTokensInput.User, and is useful for accessing the field via an interface.
+func (v *__searchApiTokensInput) GetUser() *types.UserIdScalar { return v.User }
+
+// __searchDashboardsInput is used internally by genqlient
+type __searchDashboardsInput struct {
+	Terms DWSearchInput `json:"terms"`
+}
+
+// GetTerms returns __searchDashboardsInput.Terms, and is useful for accessing the field via an interface.
+func (v *__searchDashboardsInput) GetTerms() DWSearchInput { return v.Terms }
+
+// __searchDataConnectionInput is used internally by genqlient
+type __searchDataConnectionInput struct {
+	WorkspaceId   *string `json:"workspaceId"`
+	FolderId      *string `json:"folderId"`
+	NameExact     *string `json:"nameExact"`
+	NameSubstring *string `json:"nameSubstring"`
+}
+
+// GetWorkspaceId returns __searchDataConnectionInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__searchDataConnectionInput) GetWorkspaceId() *string { return v.WorkspaceId }
+
+// GetFolderId returns __searchDataConnectionInput.FolderId, and is useful for accessing the field via an interface.
+func (v *__searchDataConnectionInput) GetFolderId() *string { return v.FolderId }
+
+// GetNameExact returns __searchDataConnectionInput.NameExact, and is useful for accessing the field via an interface.
+func (v *__searchDataConnectionInput) GetNameExact() *string { return v.NameExact }
+
+// GetNameSubstring returns __searchDataConnectionInput.NameSubstring, and is useful for accessing the field via an interface.
+func (v *__searchDataConnectionInput) GetNameSubstring() *string { return v.NameSubstring }
+
+// __searchDatasetOutboundShareInput is used internally by genqlient
+type __searchDatasetOutboundShareInput struct {
+	WorkspaceId   *string `json:"workspaceId"`
+	FolderId      *string `json:"folderId"`
+	NameExact     *string `json:"nameExact"`
+	NameSubstring *string `json:"nameSubstring"`
+}
+
+// GetWorkspaceId returns __searchDatasetOutboundShareInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__searchDatasetOutboundShareInput) GetWorkspaceId() *string { return v.WorkspaceId }
+
+// GetFolderId returns __searchDatasetOutboundShareInput.FolderId, and is useful for accessing the field via an interface.
+func (v *__searchDatasetOutboundShareInput) GetFolderId() *string { return v.FolderId }
+
+// GetNameExact returns __searchDatasetOutboundShareInput.NameExact, and is useful for accessing the field via an interface.
+func (v *__searchDatasetOutboundShareInput) GetNameExact() *string { return v.NameExact }
+
+// GetNameSubstring returns __searchDatasetOutboundShareInput.NameSubstring, and is useful for accessing the field via an interface.
+func (v *__searchDatasetOutboundShareInput) GetNameSubstring() *string { return v.NameSubstring }
+
+// __searchDatasetsInput is used internally by genqlient
+type __searchDatasetsInput struct {
+	WorkspaceId           string      `json:"workspaceId"`
+	LabelMatches          []string    `json:"labelMatches"`
+	CorrelationTagMatches []string    `json:"correlationTagMatches"`
+	SearchMode            *SearchMode `json:"searchMode"`
+}
+
+// GetWorkspaceId returns __searchDatasetsInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__searchDatasetsInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetLabelMatches returns __searchDatasetsInput.LabelMatches, and is useful for accessing the field via an interface.
+func (v *__searchDatasetsInput) GetLabelMatches() []string { return v.LabelMatches }
+
+// GetCorrelationTagMatches returns __searchDatasetsInput.CorrelationTagMatches, and is useful for accessing the field via an interface.
+func (v *__searchDatasetsInput) GetCorrelationTagMatches() []string { return v.CorrelationTagMatches }
+
+// GetSearchMode returns __searchDatasetsInput.SearchMode, and is useful for accessing the field via an interface.
+func (v *__searchDatasetsInput) GetSearchMode() *SearchMode { return v.SearchMode }
+
+// __searchDatasourceInput is used internally by genqlient
+type __searchDatasourceInput struct {
+	WorkspaceId   *string `json:"workspaceId"`
+	FolderId      *string `json:"folderId"`
+	NameExact     *string `json:"nameExact"`
+	NameSubstring *string `json:"nameSubstring"`
+}
+
+// GetWorkspaceId returns __searchDatasourceInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__searchDatasourceInput) GetWorkspaceId() *string { return v.WorkspaceId }
+
+// GetFolderId returns __searchDatasourceInput.FolderId, and is useful for accessing the field via an interface.
+func (v *__searchDatasourceInput) GetFolderId() *string { return v.FolderId }
+
+// GetNameExact returns __searchDatasourceInput.NameExact, and is useful for accessing the field via an interface.
+func (v *__searchDatasourceInput) GetNameExact() *string { return v.NameExact }
+
+// GetNameSubstring returns __searchDatasourceInput.NameSubstring, and is useful for accessing the field via an interface.
+func (v *__searchDatasourceInput) GetNameSubstring() *string { return v.NameSubstring }
+
+// __searchIncidentInput is used internally by genqlient
+type __searchIncidentInput struct {
+	WorkspaceId   *string `json:"workspaceId"`
+	FolderId      *string `json:"folderId"`
+	NameExact     *string `json:"nameExact"`
+	NameSubstring *string `json:"nameSubstring"`
+}
+
+// GetWorkspaceId returns __searchIncidentInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__searchIncidentInput) GetWorkspaceId() *string { return v.WorkspaceId }
+
+// GetFolderId returns __searchIncidentInput.FolderId, and is useful for accessing the field via an interface.
+func (v *__searchIncidentInput) GetFolderId() *string { return v.FolderId }
+
+// GetNameExact returns __searchIncidentInput.NameExact, and is useful for accessing the field via an interface.
+func (v *__searchIncidentInput) GetNameExact() *string { return v.NameExact }
+
+// GetNameSubstring returns __searchIncidentInput.NameSubstring, and is useful for accessing the field via an interface.
+func (v *__searchIncidentInput) GetNameSubstring() *string { return v.NameSubstring }
+
+// __searchInvestigationNotebookInput is used internally by genqlient
+type __searchInvestigationNotebookInput struct {
+	WorkspaceId   *string `json:"workspaceId"`
+	FolderId      *string `json:"folderId"`
+	NameExact     *string `json:"nameExact"`
+	NameSubstring *string `json:"nameSubstring"`
+}
+
+// GetWorkspaceId returns __searchInvestigationNotebookInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__searchInvestigationNotebookInput) GetWorkspaceId() *string { return v.WorkspaceId }
+
+// GetFolderId returns __searchInvestigationNotebookInput.FolderId, and is useful for accessing the field via an interface.
+func (v *__searchInvestigationNotebookInput) GetFolderId() *string { return v.FolderId }
+
+// GetNameExact returns __searchInvestigationNotebookInput.NameExact, and is useful for accessing the field via an interface.
+func (v *__searchInvestigationNotebookInput) GetNameExact() *string { return v.NameExact }
+
+// GetNameSubstring returns __searchInvestigationNotebookInput.NameSubstring, and is useful for accessing the field via an interface.
+func (v *__searchInvestigationNotebookInput) GetNameSubstring() *string { return v.NameSubstring }
+
+// __searchMetricsInput is used internally by genqlient
+type __searchMetricsInput struct {
+	WorkspaceId           string   `json:"workspaceId"`
+	Match                 string   `json:"match"`
+	CorrelationTagMatches []string `json:"correlationTagMatches"`
+}
+
+// GetWorkspaceId returns __searchMetricsInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__searchMetricsInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetMatch returns __searchMetricsInput.Match, and is useful for accessing the field via an interface.
+func (v *__searchMetricsInput) GetMatch() string { return v.Match }
+
+// GetCorrelationTagMatches returns __searchMetricsInput.CorrelationTagMatches, and is useful for accessing the field via an interface.
+func (v *__searchMetricsInput) GetCorrelationTagMatches() []string { return v.CorrelationTagMatches }
+
 // __searchMonitorActionsInput is used internally by genqlient
 type __searchMonitorActionsInput struct {
 	WorkspaceId *string `json:"workspaceId"`
@@ -9405,6 +12543,26 @@ func (v *__searchMonitorActionsInput) GetWorkspaceId() *string { return v.Worksp
 // GetName returns __searchMonitorActionsInput.Name, and is useful for accessing the field via an interface.
 func (v *__searchMonitorActionsInput) GetName() *string { return v.Name }
 
+// __searchMonitorMuteRuleInput is used internally by genqlient
+type __searchMonitorMuteRuleInput struct {
+	WorkspaceId   *string `json:"workspaceId"`
+	FolderId      *string `json:"folderId"`
+	NameExact     *string `json:"nameExact"`
+	NameSubstring *string `json:"nameSubstring"`
+}
+
+// GetWorkspaceId returns __searchMonitorMuteRuleInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__searchMonitorMuteRuleInput) GetWorkspaceId() *string { return v.WorkspaceId }
+
+// GetFolderId returns __searchMonitorMuteRuleInput.FolderId, and is useful for accessing the field via an interface.
+func (v *__searchMonitorMuteRuleInput) GetFolderId() *string { return v.FolderId }
+
+// GetNameExact returns __searchMonitorMuteRuleInput.NameExact, and is useful for accessing the field via an interface.
+func (v *__searchMonitorMuteRuleInput) GetNameExact() *string { return v.NameExact }
+
+// GetNameSubstring returns __searchMonitorMuteRuleInput.NameSubstring, and is useful for accessing the field via an interface.
+func (v *__searchMonitorMuteRuleInput) GetNameSubstring() *string { return v.NameSubstring }
+
 // __searchMonitorV2ActionInput is used internally by genqlient
 type __searchMonitorV2ActionInput struct {
 	WorkspaceId   *string `json:"workspaceId"`
@@ -9425,6 +12583,62 @@ func (v *__searchMonitorV2ActionInput) GetNameExact() *string { return v.NameExa
 // GetNameSubstring returns __searchMonitorV2ActionInput.NameSubstring, and is useful for accessing the field via an interface.
 func (v *__searchMonitorV2ActionInput) GetNameSubstring() *string { return v.NameSubstring }
 
+// __searchMonitorV2AlarmsInput is used internally by genqlient
+type __searchMonitorV2AlarmsInput struct {
+	WorkspaceId string                `json:"workspaceId"`
+	MonitorIds  []string              `json:"monitorIds"`
+	MinTime     *types.TimeScalar     `json:"minTime"`
+	MaxTime     *types.TimeScalar     `json:"maxTime"`
+	Levels      []MonitorV2AlarmLevel `json:"levels"`
+	Active      *bool                 `json:"active"`
+}
+
+// GetWorkspaceId returns __searchMonitorV2AlarmsInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__searchMonitorV2AlarmsInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// GetMonitorIds returns __searchMonitorV2AlarmsInput.MonitorIds, and is useful for accessing the field via an interface.
+func (v *__searchMonitorV2AlarmsInput) GetMonitorIds() []string { return v.MonitorIds }
+
+// GetMinTime returns __searchMonitorV2AlarmsInput.MinTime, and is useful for accessing the field via an interface.
+func (v *__searchMonitorV2AlarmsInput) GetMinTime() *types.TimeScalar { return v.MinTime }
+
+// GetMaxTime returns __searchMonitorV2AlarmsInput.MaxTime, and is useful for accessing the field via an interface.
+func (v *__searchMonitorV2AlarmsInput) GetMaxTime() *types.TimeScalar { return v.MaxTime }
+
+// GetLevels returns __searchMonitorV2AlarmsInput.Levels, and is useful for accessing the field via an interface.
+func (v *__searchMonitorV2AlarmsInput) GetLevels() []MonitorV2AlarmLevel { return v.Levels }
+
+// GetActive returns __searchMonitorV2AlarmsInput.Active, and is useful for accessing the field via an interface.
+func (v *__searchMonitorV2AlarmsInput) GetActive() *bool { return v.Active }
+
+// __searchReferenceTableInput is used internally by genqlient
+type __searchReferenceTableInput struct {
+	WorkspaceId   *string `json:"workspaceId"`
+	FolderId      *string `json:"folderId"`
+	NameExact     *string `json:"nameExact"`
+	NameSubstring *string `json:"nameSubstring"`
+}
+
+// GetWorkspaceId returns __searchReferenceTableInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__searchReferenceTableInput) GetWorkspaceId() *string { return v.WorkspaceId }
+
+// GetFolderId returns __searchReferenceTableInput.FolderId, and is useful for accessing the field via an interface.
+func (v *__searchReferenceTableInput) GetFolderId() *string { return v.FolderId }
+
+// GetNameExact returns __searchReferenceTableInput.NameExact, and is useful for accessing the field via an interface.
+func (v *__searchReferenceTableInput) GetNameExact() *string { return v.NameExact }
+
+// GetNameSubstring returns __searchReferenceTableInput.NameSubstring, and is useful for accessing the field via an interface.
+func (v *__searchReferenceTableInput) GetNameSubstring() *string { return v.NameSubstring }
+
+// __searchWorksheetsInput is used internally by genqlient
+type __searchWorksheetsInput struct {
+	Terms DWSearchInput `json:"terms"`
+}
+
+// GetTerms returns __searchWorksheetsInput.Terms, and is useful for accessing the field via an interface.
+func (v *__searchWorksheetsInput) GetTerms() DWSearchInput { return v.Terms }
+
 // __setChannelsForChannelActionInput is used internally by genqlient
 type __setChannelsForChannelActionInput struct {
 	ActionId   string   `json:"actionId"`
@@ -9469,6 +12683,18 @@ type __setRbacDefaultGroupInput struct {
 // GetId returns __setRbacDefaultGroupInput.Id, and is useful for accessing the field via an interface.
 func (v *__setRbacDefaultGroupInput) GetId() string { return v.Id }
 
+// __updateApiTokenInput is used internally by genqlient
+type __updateApiTokenInput struct {
+	Id    string         `json:"id"`
+	Input AuthtokenInput `json:"input"`
+}
+
+// GetId returns __updateApiTokenInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateApiTokenInput) GetId() string { return v.Id }
+
+// GetInput returns __updateApiTokenInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateApiTokenInput) GetInput() AuthtokenInput { return v.Input }
+
 // __updateAppDataSourceInput is used internally by genqlient
 type __updateAppDataSourceInput struct {
 	Id     string             `json:"id"`
@@ -9541,6 +12767,18 @@ func (v *__updateDashboardLinkInput) GetId() string { return v.Id }
 // GetInput returns __updateDashboardLinkInput.Input, and is useful for accessing the field via an interface.
 func (v *__updateDashboardLinkInput) GetInput() DashboardLinkInput { return v.Input }
 
+// __updateDataConnectionInput is used internally by genqlient
+type __updateDataConnectionInput struct {
+	Id    string              `json:"id"`
+	Input DataConnectionInput `json:"input"`
+}
+
+// GetId returns __updateDataConnectionInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateDataConnectionInput) GetId() string { return v.Id }
+
+// GetInput returns __updateDataConnectionInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateDataConnectionInput) GetInput() DataConnectionInput { return v.Input }
+
 // __updateDatasetOutboundShareInput is used internally by genqlient
 type __updateDatasetOutboundShareInput struct {
 	Id    string                    `json:"id"`
@@ -9553,6 +12791,18 @@ func (v *__updateDatasetOutboundShareInput) GetId() string { return v.Id }
 // GetInput returns __updateDatasetOutboundShareInput.Input, and is useful for accessing the field via an interface.
 func (v *__updateDatasetOutboundShareInput) GetInput() DatasetOutboundShareInput { return v.Input }
 
+// __updateDatasourceInput is used internally by genqlient
+type __updateDatasourceInput struct {
+	Id    string          `json:"id"`
+	Input DatasourceInput `json:"input"`
+}
+
+// GetId returns __updateDatasourceInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateDatasourceInput) GetId() string { return v.Id }
+
+// GetInput returns __updateDatasourceInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateDatasourceInput) GetInput() DatasourceInput { return v.Input }
+
 // __updateDatastreamInput is used internally by genqlient
 type __updateDatastreamInput struct {
 	Id         string          `json:"id"`
@@ -9613,6 +12863,30 @@ func (v *__updateFolderInput) GetId() string { return v.Id }
 // GetConfig returns __updateFolderInput.Config, and is useful for accessing the field via an interface.
 func (v *__updateFolderInput) GetConfig() FolderInput { return v.Config }
 
+// __updateIncidentInput is used internally by genqlient
+type __updateIncidentInput struct {
+	Id    string        `json:"id"`
+	Input IncidentInput `json:"input"`
+}
+
+// GetId returns __updateIncidentInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateIncidentInput) GetId() string { return v.Id }
+
+// GetInput returns __updateIncidentInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateIncidentInput) GetInput() IncidentInput { return v.Input }
+
+// __updateInvestigationNotebookInput is used internally by genqlient
+type __updateInvestigationNotebookInput struct {
+	Id    string                     `json:"id"`
+	Input InvestigationNotebookInput `json:"input"`
+}
+
+// GetId returns __updateInvestigationNotebookInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateInvestigationNotebookInput) GetId() string { return v.Id }
+
+// GetInput returns __updateInvestigationNotebookInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateInvestigationNotebookInput) GetInput() InvestigationNotebookInput { return v.Input }
+
 // __updateLayeredSettingRecordInput is used internally by genqlient
 type __updateLayeredSettingRecordInput struct {
 	SettingRecord LayeredSettingRecordInput `json:"settingRecord"`
@@ -9661,6 +12935,34 @@ func (v *__updateMonitorInput) GetId() string { return v.Id }
 // GetMonitor returns __updateMonitorInput.Monitor, and is useful for accessing the field via an interface.
 func (v *__updateMonitorInput) GetMonitor() MonitorInput { return v.Monitor }
 
+// __updateMonitorMuteRuleForMonitorsInput is used internally by genqlient
+type __updateMonitorMuteRuleForMonitorsInput struct {
+	Id         string               `json:"id"`
+	Input      MonitorMuteRuleInput `json:"input"`
+	MonitorIds []string             `json:"monitorIds"`
+}
+
+// GetId returns __updateMonitorMuteRuleForMonitorsInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateMonitorMuteRuleForMonitorsInput) GetId() string { return v.Id }
+
+// GetInput returns __updateMonitorMuteRuleForMonitorsInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateMonitorMuteRuleForMonitorsInput) GetInput() MonitorMuteRuleInput { return v.Input }
+
+// GetMonitorIds returns __updateMonitorMuteRuleForMonitorsInput.MonitorIds, and is useful for accessing the field via an interface.
+func (v *__updateMonitorMuteRuleForMonitorsInput) GetMonitorIds() []string { return v.MonitorIds }
+
+// __updateMonitorMuteRuleInput is used internally by genqlient
+type __updateMonitorMuteRuleInput struct {
+	Id    string               `json:"id"`
+	Input MonitorMuteRuleInput `json:"input"`
+}
+
+// GetId returns __updateMonitorMuteRuleInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateMonitorMuteRuleInput) GetId() string { return v.Id }
+
+// GetInput returns __updateMonitorMuteRuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateMonitorMuteRuleInput) GetInput() MonitorMuteRuleInput { return v.Input }
+
 // __updateMonitorV2ActionInput is used internally by genqlient
 type __updateMonitorV2ActionInput struct {
 	Id    string               `json:"id"`
@@ -9685,6 +12987,18 @@ func (v *__updateMonitorV2Input) GetId() string { return v.Id }
 // GetInput returns __updateMonitorV2Input.Input, and is useful for accessing the field via an interface.
 func (v *__updateMonitorV2Input) GetInput() MonitorV2Input { return v.Input }
 
+// __updateMonitorV2MuteRuleInput is used internally by genqlient
+type __updateMonitorV2MuteRuleInput struct {
+	Id    string                 `json:"id"`
+	Input MonitorV2MuteRuleInput `json:"input"`
+}
+
+// GetId returns __updateMonitorV2MuteRuleInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateMonitorV2MuteRuleInput) GetId() string { return v.Id }
+
+// GetInput returns __updateMonitorV2MuteRuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateMonitorV2MuteRuleInput) GetInput() MonitorV2MuteRuleInput { return v.Input }
+
 // __updatePollerInput is used internally by genqlient
 type __updatePollerInput struct {
 	Id     string      `json:"id"`
@@ -9745,6 +13059,18 @@ func (v *__updateRbacStatementInput) GetId() string { return v.Id }
 // GetConfig returns __updateRbacStatementInput.Config, and is useful for accessing the field via an interface.
 func (v *__updateRbacStatementInput) GetConfig() RbacStatementInput { return v.Config }
 
+// __updateReferenceTableInput is used internally by genqlient
+type __updateReferenceTableInput struct {
+	Id    string              `json:"id"`
+	Input ReferenceTableInput `json:"input"`
+}
+
+// GetId returns __updateReferenceTableInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateReferenceTableInput) GetId() string { return v.Id }
+
+// GetInput returns __updateReferenceTableInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateReferenceTableInput) GetInput() ReferenceTableInput { return v.Input }
+
 // __updateSnowflakeOutboundShareInput is used internally by genqlient
 type __updateSnowflakeOutboundShareInput struct {
 	Id    string                      `json:"id"`
@@ -9777,6 +13103,70 @@ type addCorrelationTagResponse struct {
 // GetResultStatus returns addCorrelationTagResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *addCorrelationTagResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// addIncidentDashboardsResponse is returned by addIncidentDashboards on success.
+type addIncidentDashboardsResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns addIncidentDashboardsResponse.Incident, and is useful for accessing the field via an interface.
+func (v *addIncidentDashboardsResponse) GetIncident() Incident { return v.Incident }
+
+// addIncidentSlackChannelsResponse is returned by addIncidentSlackChannels on success.
+type addIncidentSlackChannelsResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns addIncidentSlackChannelsResponse.Incident, and is useful for accessing the field via an interface.
+func (v *addIncidentSlackChannelsResponse) GetIncident() Incident { return v.Incident }
+
+// addIncidentUsersResponse is returned by addIncidentUsers on success.
+type addIncidentUsersResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns addIncidentUsersResponse.Incident, and is useful for accessing the field via an interface.
+func (v *addIncidentUsersResponse) GetIncident() Incident { return v.Incident }
+
+// addIncidentWorksheetsResponse is returned by addIncidentWorksheets on success.
+type addIncidentWorksheetsResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns addIncidentWorksheetsResponse.Incident, and is useful for accessing the field via an interface.
+func (v *addIncidentWorksheetsResponse) GetIncident() Incident { return v.Incident }
+
+// cancelAccelerationJobResponse is returned by cancelAccelerationJob on success.
+type cancelAccelerationJobResponse struct {
+	// Cancels an acceleration job identified by the jobId. If the operation is
+	// successful, an acceleration job with state "Cancelled" is returned. If the
+	// operation fails, an invalid object is returned together with errors.
+	Job AccelerationJob `json:"job"`
+}
+
+// GetJob returns cancelAccelerationJobResponse.Job, and is useful for accessing the field via an interface.
+func (v *cancelAccelerationJobResponse) GetJob() AccelerationJob { return v.Job }
+
+// checkQueriesCompilationResultsCompilationResult includes the requested fields of the GraphQL type CompilationResult.
+type checkQueriesCompilationResultsCompilationResult struct {
+	ParsedPipeline ParsedPipeline `json:"parsedPipeline"`
+}
+
+// GetParsedPipeline returns checkQueriesCompilationResultsCompilationResult.ParsedPipeline, and is useful for accessing the field via an interface.
+func (v *checkQueriesCompilationResultsCompilationResult) GetParsedPipeline() ParsedPipeline {
+	return v.ParsedPipeline
+}
+
+// checkQueriesResponse is returned by checkQueries on success.
+type checkQueriesResponse struct {
+	// the QueryParams are optional -- some defaults will be used if you don't put them in
+	CompilationResults []checkQueriesCompilationResultsCompilationResult `json:"compilationResults"`
+}
+
+// GetCompilationResults returns checkQueriesResponse.CompilationResults, and is useful for accessing the field via an interface.
+func (v *checkQueriesResponse) GetCompilationResults() []checkQueriesCompilationResultsCompilationResult {
+	return v.CompilationResults
+}
+
 // clearDefaultDashboardResponse is returned by clearDefaultDashboard on success.
 type clearDefaultDashboardResponse struct {
 	ResultStatus ResultStatus `json:"resultStatus"`
@@ -9785,6 +13175,47 @@ type clearDefaultDashboardResponse struct {
 // GetResultStatus returns clearDefaultDashboardResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *clearDefaultDashboardResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// createAccelerationJobResponse is returned by createAccelerationJob on success.
+type createAccelerationJobResponse struct {
+	// Create and submit an acceleration job to the backend, which contains multiple
+	// acceleration requests. If the operaiton is successful, a job object with
+	// detailed status is returned and caller can poll backend later for its updated
+	// status. If the operation fails, an invalid job object is returned together
+	// with errors.
+	Job AccelerationJob `json:"job"`
+}
+
+// GetJob returns createAccelerationJobResponse.Job, and is useful for accessing the field via an interface.
+func (v *createAccelerationJobResponse) GetJob() AccelerationJob { return v.Job }
+
+// createApiTokenResponse is returned by createApiToken on success.
+type createApiTokenResponse struct {
+	// We can actually only create 'api' authtokens through this API. That's the default kind, too.
+	// If you are an admin, you can create an authtoken owned by a service account user.
+	// Note that the AuthtokenCreateResult is the only place where the clear-text authtoken is returned to you.
+	// It cannot be retrieved after the fact.
+	Result createApiTokenResultAuthtokenCreateResult `json:"result"`
+}
+
+// GetResult returns createApiTokenResponse.Result, and is useful for accessing the field via an interface.
+func (v *createApiTokenResponse) GetResult() createApiTokenResultAuthtokenCreateResult {
+	return v.Result
+}
+
+// createApiTokenResultAuthtokenCreateResult includes the requested fields of the GraphQL type AuthtokenCreateResult.
+type createApiTokenResultAuthtokenCreateResult struct {
+	ApiToken ApiToken `json:"apiToken"`
+	// This secret is the bearer token you will present in the Authorization: header. It cannot
+	// be recovered if you lose it, only a hash is stored in the database.
+	Secret string `json:"secret"`
+}
+
+// GetApiToken returns createApiTokenResultAuthtokenCreateResult.ApiToken, and is useful for accessing the field via an interface.
+func (v *createApiTokenResultAuthtokenCreateResult) GetApiToken() ApiToken { return v.ApiToken }
+
+// GetSecret returns createApiTokenResultAuthtokenCreateResult.Secret, and is useful for accessing the field via an interface.
+func (v *createApiTokenResultAuthtokenCreateResult) GetSecret() string { return v.Secret }
+
 // createAppDataSourceResponse is returned by createAppDataSource on success.
 type createAppDataSourceResponse struct {
 	Appdatasource AppDataSource `json:"appdatasource"`
@@ -9901,6 +13332,14 @@ type createDashboardLinkResponse struct {
 // GetDashboardLink returns createDashboardLinkResponse.DashboardLink, and is useful for accessing the field via an interface.
 func (v *createDashboardLinkResponse) GetDashboardLink() DashboardLink { return v.DashboardLink }
 
+// createDataConnectionResponse is returned by createDataConnection on success.
+type createDataConnectionResponse struct {
+	DataConnection DataConnection `json:"dataConnection"`
+}
+
+// GetDataConnection returns createDataConnectionResponse.DataConnection, and is useful for accessing the field via an interface.
+func (v *createDataConnectionResponse) GetDataConnection() DataConnection { return v.DataConnection }
+
 // createDatasetOutboundShareResponse is returned by createDatasetOutboundShare on success.
 type createDatasetOutboundShareResponse struct {
 	DatasetOutboundShare DatasetOutboundShare `json:"datasetOutboundShare"`
@@ -9911,6 +13350,14 @@ func (v *createDatasetOutboundShareResponse) GetDatasetOutboundShare() DatasetOu
 	return v.DatasetOutboundShare
 }
 
+// createDatasourceResponse is returned by createDatasource on success.
+type createDatasourceResponse struct {
+	Datasource Datasource `json:"datasource"`
+}
+
+// GetDatasource returns createDatasourceResponse.Datasource, and is useful for accessing the field via an interface.
+func (v *createDatasourceResponse) GetDatasource() Datasource { return v.Datasource }
+
 // createDatastreamResponse is returned by createDatastream on success.
 type createDatastreamResponse struct {
 	Datastream Datastream `json:"datastream"`
@@ -9955,6 +13402,24 @@ type createFolderResponse struct {
 // GetFolder returns createFolderResponse.Folder, and is useful for accessing the field via an interface.
 func (v *createFolderResponse) GetFolder() Folder { return v.Folder }
 
+// createIncidentResponse is returned by createIncident on success.
+type createIncidentResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns createIncidentResponse.Incident, and is useful for accessing the field via an interface.
+func (v *createIncidentResponse) GetIncident() Incident { return v.Incident }
+
+// createInvestigationNotebookResponse is returned by createInvestigationNotebook on success.
+type createInvestigationNotebookResponse struct {
+	InvestigationNotebook InvestigationNotebook `json:"investigationNotebook"`
+}
+
+// GetInvestigationNotebook returns createInvestigationNotebookResponse.InvestigationNotebook, and is useful for accessing the field via an interface.
+func (v *createInvestigationNotebookResponse) GetInvestigationNotebook() InvestigationNotebook {
+	return v.InvestigationNotebook
+}
+
 // createLayeredSettingRecordResponse is returned by createLayeredSettingRecord on success.
 type createLayeredSettingRecordResponse struct {
 	LayeredSettingRecord LayeredSettingRecord `json:"layeredSettingRecord"`
@@ -10057,6 +13522,26 @@ type createMonitorMonitorMonitorUpdateResult struct {
 // GetMonitor returns createMonitorMonitorMonitorUpdateResult.Monitor, and is useful for accessing the field via an interface.
 func (v *createMonitorMonitorMonitorUpdateResult) GetMonitor() Monitor { return v.Monitor }
 
+// createMonitorMuteRuleForMonitorsResponse is returned by createMonitorMuteRuleForMonitors on success.
+type createMonitorMuteRuleForMonitorsResponse struct {
+	MonitorMuteRule MonitorMuteRule `json:"monitorMuteRule"`
+}
+
+// GetMonitorMuteRule returns createMonitorMuteRuleForMonitorsResponse.MonitorMuteRule, and is useful for accessing the field via an interface.
+func (v *createMonitorMuteRuleForMonitorsResponse) GetMonitorMuteRule() MonitorMuteRule {
+	return v.MonitorMuteRule
+}
+
+// createMonitorMuteRuleResponse is returned by createMonitorMuteRule on success.
+type createMonitorMuteRuleResponse struct {
+	MonitorMuteRule MonitorMuteRule `json:"monitorMuteRule"`
+}
+
+// GetMonitorMuteRule returns createMonitorMuteRuleResponse.MonitorMuteRule, and is useful for accessing the field via an interface.
+func (v *createMonitorMuteRuleResponse) GetMonitorMuteRule() MonitorMuteRule {
+	return v.MonitorMuteRule
+}
+
 // createMonitorResponse is returned by createMonitor on success.
 type createMonitorResponse struct {
 	Monitor *createMonitorMonitorMonitorUpdateResult `json:"monitor"`
@@ -10077,6 +13562,16 @@ func (v *createMonitorV2ActionResponse) GetMonitorV2Action() MonitorV2Action {
 	return v.MonitorV2Action
 }
 
+// createMonitorV2MuteRuleResponse is returned by createMonitorV2MuteRule on success.
+type createMonitorV2MuteRuleResponse struct {
+	MonitorV2MuteRule MonitorV2MuteRule `json:"monitorV2MuteRule"`
+}
+
+// GetMonitorV2MuteRule returns createMonitorV2MuteRuleResponse.MonitorV2MuteRule, and is useful for accessing the field via an interface.
+func (v *createMonitorV2MuteRuleResponse) GetMonitorV2MuteRule() MonitorV2MuteRule {
+	return v.MonitorV2MuteRule
+}
+
 // createMonitorV2Response is returned by createMonitorV2 on success.
 type createMonitorV2Response struct {
 	MonitorV2 MonitorV2 `json:"monitorV2"`
@@ -10151,6 +13646,14 @@ type createRbacStatementResponse struct {
 // GetRbacStatement returns createRbacStatementResponse.RbacStatement, and is useful for accessing the field via an interface.
 func (v *createRbacStatementResponse) GetRbacStatement() RbacStatement { return v.RbacStatement }
 
+// createReferenceTableResponse is returned by createReferenceTable on success.
+type createReferenceTableResponse struct {
+	ReferenceTable ReferenceTable `json:"referenceTable"`
+}
+
+// GetReferenceTable returns createReferenceTableResponse.ReferenceTable, and is useful for accessing the field via an interface.
+func (v *createReferenceTableResponse) GetReferenceTable() ReferenceTable { return v.ReferenceTable }
+
 // createSnowflakeOutboundShareResponse is returned by createSnowflakeOutboundShare on success.
 type createSnowflakeOutboundShareResponse struct {
 	Share SnowflakeOutboundShare `json:"share"`
@@ -10170,6 +13673,14 @@ type createWorkspaceResponse struct {
 // GetWorkspace returns createWorkspaceResponse.Workspace, and is useful for accessing the field via an interface.
 func (v *createWorkspaceResponse) GetWorkspace() *Workspace { return v.Workspace }
 
+// deleteApiTokenResponse is returned by deleteApiToken on success.
+type deleteApiTokenResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns deleteApiTokenResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *deleteApiTokenResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
 // deleteAppDataSourceResponse is returned by deleteAppDataSource on success.
 type deleteAppDataSourceResponse struct {
 	ResultStatus ResultStatus `json:"resultStatus"`
@@ -10245,6 +13756,14 @@ type deleteDashboardResponse struct {
 // GetResultStatus returns deleteDashboardResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *deleteDashboardResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// deleteDataConnectionResponse is returned by deleteDataConnection on success.
+type deleteDataConnectionResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns deleteDataConnectionResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *deleteDataConnectionResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
 // deleteDatasetOutboundShareResponse is returned by deleteDatasetOutboundShare on success.
 type deleteDatasetOutboundShareResponse struct {
 	ResultStatus ResultStatus `json:"resultStatus"`
@@ -10261,6 +13780,14 @@ type deleteDatasetResponse struct {
 // GetResultStatus returns deleteDatasetResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *deleteDatasetResponse) GetResultStatus() *ResultStatus { return v.ResultStatus }
 
+// deleteDatasourceResponse is returned by deleteDatasource on success.
+type deleteDatasourceResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns deleteDatasourceResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *deleteDatasourceResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
 // deleteDatastreamResponse is returned by deleteDatastream on success.
 type deleteDatastreamResponse struct {
 	ResultStatus ResultStatus `json:"resultStatus"`
@@ -10301,12 +13828,28 @@ type deleteFolderResponse struct {
 // GetResultStatus returns deleteFolderResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *deleteFolderResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
-// deleteLayeredSettingRecordDeleteLayeredSettingRecordDeletedLayeredSettingRecordsResult includes the requested fields of the GraphQL type DeletedLayeredSettingRecordsResult.
-type deleteLayeredSettingRecordDeleteLayeredSettingRecordDeletedLayeredSettingRecordsResult struct {
+// deleteIncidentResponse is returned by deleteIncident on success.
+type deleteIncidentResponse struct {
 	ResultStatus ResultStatus `json:"resultStatus"`
 }
 
-// GetResultStatus returns deleteLayeredSettingRecordDeleteLayeredSettingRecordDeletedLayeredSettingRecordsResult.ResultStatus, and is useful for accessing the field via an interface.
+// GetResultStatus returns deleteIncidentResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *deleteIncidentResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
+// deleteInvestigationNotebookResponse is returned by deleteInvestigationNotebook on success.
+type deleteInvestigationNotebookResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns deleteInvestigationNotebookResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *deleteInvestigationNotebookResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
+// deleteLayeredSettingRecordDeleteLayeredSettingRecordDeletedLayeredSettingRecordsResult includes the requested fields of the GraphQL type DeletedLayeredSettingRecordsResult.
+type deleteLayeredSettingRecordDeleteLayeredSettingRecordDeletedLayeredSettingRecordsResult struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns deleteLayeredSettingRecordDeleteLayeredSettingRecordDeletedLayeredSettingRecordsResult.ResultStatus, and is useful for accessing the field via an interface.
 func (v *deleteLayeredSettingRecordDeleteLayeredSettingRecordDeletedLayeredSettingRecordsResult) GetResultStatus() ResultStatus {
 	return v.ResultStatus
 }
@@ -10337,6 +13880,14 @@ type deleteMonitorActionResponse struct {
 // GetResultStatus returns deleteMonitorActionResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *deleteMonitorActionResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// deleteMonitorMuteRuleResponse is returned by deleteMonitorMuteRule on success.
+type deleteMonitorMuteRuleResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns deleteMonitorMuteRuleResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *deleteMonitorMuteRuleResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
 // deleteMonitorResponse is returned by deleteMonitor on success.
 type deleteMonitorResponse struct {
 	ResultStatus ResultStatus `json:"resultStatus"`
@@ -10353,6 +13904,14 @@ type deleteMonitorV2ActionResponse struct {
 // GetResultStatus returns deleteMonitorV2ActionResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *deleteMonitorV2ActionResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// deleteMonitorV2MuteRuleResponse is returned by deleteMonitorV2MuteRule on success.
+type deleteMonitorV2MuteRuleResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns deleteMonitorV2MuteRuleResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *deleteMonitorV2MuteRuleResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
 // deleteMonitorV2Response is returned by deleteMonitorV2 on success.
 type deleteMonitorV2Response struct {
 	ResultStatus ResultStatus `json:"resultStatus"`
@@ -10401,6 +13960,14 @@ type deleteRbacStatementResponse struct {
 // GetResultStatus returns deleteRbacStatementResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *deleteRbacStatementResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// deleteReferenceTableResponse is returned by deleteReferenceTable on success.
+type deleteReferenceTableResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
+
+// GetResultStatus returns deleteReferenceTableResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *deleteReferenceTableResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
 // deleteSnowflakeOutboundShareResponse is returned by deleteSnowflakeOutboundShare on success.
 type deleteSnowflakeOutboundShareResponse struct {
 	ResultStatus ResultStatus `json:"resultStatus"`
@@ -10425,6 +13992,36 @@ type deleteWorkspaceResponse struct {
 // GetResultStatus returns deleteWorkspaceResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *deleteWorkspaceResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// estimateRematerializationCostResponse is returned by estimateRematerializationCost on success.
+type estimateRematerializationCostResponse struct {
+	// Estimate the costs of a rematerialization request.
+	Estimates []DatasetCostEstimate `json:"estimates"`
+}
+
+// GetEstimates returns estimateRematerializationCostResponse.Estimates, and is useful for accessing the field via an interface.
+func (v *estimateRematerializationCostResponse) GetEstimates() []DatasetCostEstimate {
+	return v.Estimates
+}
+
+// getAccelerationJobResponse is returned by getAccelerationJob on success.
+type getAccelerationJobResponse struct {
+	// Get the full state of an acceleration job identified by the jobId. If the job
+	// can be found, a job object with defailed status is returned. If the job is not
+	// found, an invalid job object is returned together with errors.
+	Job AccelerationJob `json:"job"`
+}
+
+// GetJob returns getAccelerationJobResponse.Job, and is useful for accessing the field via an interface.
+func (v *getAccelerationJobResponse) GetJob() AccelerationJob { return v.Job }
+
+// getApiTokenResponse is returned by getApiToken on success.
+type getApiTokenResponse struct {
+	ApiToken ApiToken `json:"apiToken"`
+}
+
+// GetApiToken returns getApiTokenResponse.ApiToken, and is useful for accessing the field via an interface.
+func (v *getApiTokenResponse) GetApiToken() ApiToken { return v.ApiToken }
+
 // getAppDataSourceResponse is returned by getAppDataSource on success.
 type getAppDataSourceResponse struct {
 	Appdatasource AppDataSource `json:"appdatasource"`
@@ -10595,6 +14192,74 @@ type getDashboardResponse struct {
 // GetDashboard returns getDashboardResponse.Dashboard, and is useful for accessing the field via an interface.
 func (v *getDashboardResponse) GetDashboard() Dashboard { return v.Dashboard }
 
+// getDataConnectionResponse is returned by getDataConnection on success.
+type getDataConnectionResponse struct {
+	DataConnection DataConnection `json:"dataConnection"`
+}
+
+// GetDataConnection returns getDataConnectionResponse.DataConnection, and is useful for accessing the field via an interface.
+func (v *getDataConnectionResponse) GetDataConnection() DataConnection { return v.DataConnection }
+
+// getDatasetAccelerationStatusDataset includes the requested fields of the GraphQL type Dataset.
+type getDatasetAccelerationStatusDataset struct {
+	AccelerationInfo DatasetAccelerationInfo `json:"accelerationInfo"`
+}
+
+// GetAccelerationInfo returns getDatasetAccelerationStatusDataset.AccelerationInfo, and is useful for accessing the field via an interface.
+func (v *getDatasetAccelerationStatusDataset) GetAccelerationInfo() DatasetAccelerationInfo {
+	return v.AccelerationInfo
+}
+
+// getDatasetAccelerationStatusResponse is returned by getDatasetAccelerationStatus on success.
+type getDatasetAccelerationStatusResponse struct {
+	Dataset *getDatasetAccelerationStatusDataset `json:"dataset"`
+	// Get the full acceleration status of a dataset identified by the datasetId.
+	Status getDatasetAccelerationStatusStatusDatasetAccelerationStatus `json:"status"`
+}
+
+// GetDataset returns getDatasetAccelerationStatusResponse.Dataset, and is useful for accessing the field via an interface.
+func (v *getDatasetAccelerationStatusResponse) GetDataset() *getDatasetAccelerationStatusDataset {
+	return v.Dataset
+}
+
+// GetStatus returns getDatasetAccelerationStatusResponse.Status, and is useful for accessing the field via an interface.
+func (v *getDatasetAccelerationStatusResponse) GetStatus() getDatasetAccelerationStatusStatusDatasetAccelerationStatus {
+	return v.Status
+}
+
+// getDatasetAccelerationStatusStatusDatasetAccelerationStatus includes the requested fields of the GraphQL type DatasetAccelerationStatus.
+type getDatasetAccelerationStatusStatusDatasetAccelerationStatus struct {
+	// Percetage of the already accelerated windows over requested acceleration windows.
+	Progress float64 `json:"progress"`
+	// All running acceleration jobs for this dataset.
+	RunningJobs []getDatasetAccelerationStatusStatusDatasetAccelerationStatusRunningJobsAccelerationJob `json:"runningJobs"`
+}
+
+// GetProgress returns getDatasetAccelerationStatusStatusDatasetAccelerationStatus.Progress, and is useful for accessing the field via an interface.
+func (v *getDatasetAccelerationStatusStatusDatasetAccelerationStatus) GetProgress() float64 {
+	return v.Progress
+}
+
+// GetRunningJobs returns getDatasetAccelerationStatusStatusDatasetAccelerationStatus.RunningJobs, and is useful for accessing the field via an interface.
+func (v *getDatasetAccelerationStatusStatusDatasetAccelerationStatus) GetRunningJobs() []getDatasetAccelerationStatusStatusDatasetAccelerationStatusRunningJobsAccelerationJob {
+	return v.RunningJobs
+}
+
+// getDatasetAccelerationStatusStatusDatasetAccelerationStatusRunningJobsAccelerationJob includes the requested fields of the GraphQL type AccelerationJob.
+// The GraphQL type's documentation follows.
+//
+// This is the acceleration job returned from the backend.
+type getDatasetAccelerationStatusStatusDatasetAccelerationStatusRunningJobsAccelerationJob struct {
+	// A unique identifier for the acceleration job. An invalid jobId might be
+	// returned for a failed create operation or a dry run create operation.
+	JobId string `json:"jobId"`
+}
+
+// GetJobId returns getDatasetAccelerationStatusStatusDatasetAccelerationStatusRunningJobsAccelerationJob.JobId, and is useful for accessing the field via an interface.
+func (v *getDatasetAccelerationStatusStatusDatasetAccelerationStatusRunningJobsAccelerationJob) GetJobId() string {
+	return v.JobId
+}
+
 // getDatasetCorrelationTagsCorrelationTagsDataset includes the requested fields of the GraphQL type Dataset.
 type getDatasetCorrelationTagsCorrelationTagsDataset struct {
 	CorrelationTagMappings []getDatasetCorrelationTagsCorrelationTagsDatasetCorrelationTagMappingsCorrelationTagMapping `json:"correlationTagMappings"`
@@ -10647,6 +14312,44 @@ func (v *getDatasetCorrelationTagsResponse) GetCorrelationTags() *getDatasetCorr
 	return v.CorrelationTags
 }
 
+// getDatasetDoctorReportReportDatasetDoctorReport includes the requested fields of the GraphQL type DatasetDoctorReport.
+type getDatasetDoctorReportReportDatasetDoctorReport struct {
+	// Some particular dataset was the "seed" of this report -- this is the tip
+	// of the iceberg, and the most-interesting dataset in the reported datasets
+	// output.
+	DoctorForDataset string `json:"doctorForDataset"`
+	// All interesting upstream datasets end up in this flat list -- the actual
+	// graph can be constructed by following the inputDatasets links.
+	Datasets []DatasetReport `json:"datasets"`
+}
+
+// GetDoctorForDataset returns getDatasetDoctorReportReportDatasetDoctorReport.DoctorForDataset, and is useful for accessing the field via an interface.
+func (v *getDatasetDoctorReportReportDatasetDoctorReport) GetDoctorForDataset() string {
+	return v.DoctorForDataset
+}
+
+// GetDatasets returns getDatasetDoctorReportReportDatasetDoctorReport.Datasets, and is useful for accessing the field via an interface.
+func (v *getDatasetDoctorReportReportDatasetDoctorReport) GetDatasets() []DatasetReport {
+	return v.Datasets
+}
+
+// getDatasetDoctorReportResponse is returned by getDatasetDoctorReport on success.
+type getDatasetDoctorReportResponse struct {
+	// The control UI is focused on some particular stage. checkQuery() and friends are helpful
+	// for that, but sometimes you're looking for a more holostic "what the hell is wrong with
+	// this dataset" view, which you may be able to get in one swell foop from this call. Note
+	// that the call may take a few seconds if the dataset has many upstream datasets.
+	// If upLevels is 0, only the dataset is checked. If upLevels is 1, the dataset and its
+	// immediate ancestors are checked, and so on. If upLevels is not set at all, then all
+	// ancestors up to the observation dataset will be checked!
+	Report *getDatasetDoctorReportReportDatasetDoctorReport `json:"report"`
+}
+
+// GetReport returns getDatasetDoctorReportResponse.Report, and is useful for accessing the field via an interface.
+func (v *getDatasetDoctorReportResponse) GetReport() *getDatasetDoctorReportReportDatasetDoctorReport {
+	return v.Report
+}
+
 // getDatasetOutboundShareResponse is returned by getDatasetOutboundShare on success.
 type getDatasetOutboundShareResponse struct {
 	DatasetOutboundShare DatasetOutboundShare `json:"datasetOutboundShare"`
@@ -10679,6 +14382,14 @@ type getDatasetResponse struct {
 // GetDataset returns getDatasetResponse.Dataset, and is useful for accessing the field via an interface.
 func (v *getDatasetResponse) GetDataset() *Dataset { return v.Dataset }
 
+// getDatasourceResponse is returned by getDatasource on success.
+type getDatasourceResponse struct {
+	Datasource Datasource `json:"datasource"`
+}
+
+// GetDatasource returns getDatasourceResponse.Datasource, and is useful for accessing the field via an interface.
+func (v *getDatasourceResponse) GetDatasource() Datasource { return v.Datasource }
+
 // getDatastreamResponse is returned by getDatastream on success.
 type getDatastreamResponse struct {
 	Datastream Datastream `json:"datastream"`
@@ -10730,6 +14441,22 @@ type getFolderResponse struct {
 // GetFolder returns getFolderResponse.Folder, and is useful for accessing the field via an interface.
 func (v *getFolderResponse) GetFolder() Folder { return v.Folder }
 
+// getIncidentResponse is returned by getIncident on success.
+type getIncidentResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns getIncidentResponse.Incident, and is useful for accessing the field via an interface.
+func (v *getIncidentResponse) GetIncident() Incident { return v.Incident }
+
+// getIncidentsForStatusResponse is returned by getIncidentsForStatus on success.
+type getIncidentsForStatusResponse struct {
+	Incidents []Incident `json:"incidents"`
+}
+
+// GetIncidents returns getIncidentsForStatusResponse.Incidents, and is useful for accessing the field via an interface.
+func (v *getIncidentsForStatusResponse) GetIncidents() []Incident { return v.Incidents }
+
 // getIngestInfoIngestCustomer includes the requested fields of the GraphQL type Customer.
 type getIngestInfoIngestCustomer struct {
 	IngestInfo IngestInfo `json:"ingestInfo"`
@@ -10746,6 +14473,16 @@ type getIngestInfoResponse struct {
 // GetIngest returns getIngestInfoResponse.Ingest, and is useful for accessing the field via an interface.
 func (v *getIngestInfoResponse) GetIngest() *getIngestInfoIngestCustomer { return v.Ingest }
 
+// getInvestigationNotebookResponse is returned by getInvestigationNotebook on success.
+type getInvestigationNotebookResponse struct {
+	InvestigationNotebook InvestigationNotebook `json:"investigationNotebook"`
+}
+
+// GetInvestigationNotebook returns getInvestigationNotebookResponse.InvestigationNotebook, and is useful for accessing the field via an interface.
+func (v *getInvestigationNotebookResponse) GetInvestigationNotebook() InvestigationNotebook {
+	return v.InvestigationNotebook
+}
+
 // getLayeredSettingRecordResponse is returned by getLayeredSettingRecord on success.
 type getLayeredSettingRecordResponse struct {
 	LayeredSettingRecord LayeredSettingRecord `json:"layeredSettingRecord"`
@@ -10840,6 +14577,14 @@ func (v *getMonitorActionResponse) __premarshalJSON() (*__premarshalgetMonitorAc
 	return &retval, nil
 }
 
+// getMonitorMuteRuleResponse is returned by getMonitorMuteRule on success.
+type getMonitorMuteRuleResponse struct {
+	MonitorMuteRule MonitorMuteRule `json:"monitorMuteRule"`
+}
+
+// GetMonitorMuteRule returns getMonitorMuteRuleResponse.MonitorMuteRule, and is useful for accessing the field via an interface.
+func (v *getMonitorMuteRuleResponse) GetMonitorMuteRule() MonitorMuteRule { return v.MonitorMuteRule }
+
 // getMonitorResponse is returned by getMonitor on success.
 type getMonitorResponse struct {
 	Monitor Monitor `json:"monitor"`
@@ -10864,6 +14609,28 @@ type getMonitorV2Response struct {
 // GetMonitorV2 returns getMonitorV2Response.MonitorV2, and is useful for accessing the field via an interface.
 func (v *getMonitorV2Response) GetMonitorV2() MonitorV2 { return v.MonitorV2 }
 
+// getMonitorV2TemplateDictionaryResponse is returned by getMonitorV2TemplateDictionary on success.
+type getMonitorV2TemplateDictionaryResponse struct {
+	// monitorV2TemplateDictionary takes in the monitor v2 input and the alarm input to produce a template dictionary
+	// for the frontend which can be used to render the template.
+	TemplateDictionary getMonitorV2TemplateDictionaryTemplateDictionary `json:"templateDictionary"`
+}
+
+// GetTemplateDictionary returns getMonitorV2TemplateDictionaryResponse.TemplateDictionary, and is useful for accessing the field via an interface.
+func (v *getMonitorV2TemplateDictionaryResponse) GetTemplateDictionary() getMonitorV2TemplateDictionaryTemplateDictionary {
+	return v.TemplateDictionary
+}
+
+// getMonitorV2TemplateDictionaryTemplateDictionary includes the requested fields of the GraphQL type TemplateDictionary.
+type getMonitorV2TemplateDictionaryTemplateDictionary struct {
+	Dictionary types.JsonObject `json:"dictionary"`
+}
+
+// GetDictionary returns getMonitorV2TemplateDictionaryTemplateDictionary.Dictionary, and is useful for accessing the field via an interface.
+func (v *getMonitorV2TemplateDictionaryTemplateDictionary) GetDictionary() types.JsonObject {
+	return v.Dictionary
+}
+
 // getPollerResponse is returned by getPoller on success.
 type getPollerResponse struct {
 	Poller Poller `json:"poller"`
@@ -10927,6 +14694,14 @@ type getRbacStatementResponse struct {
 // GetRbacStatement returns getRbacStatementResponse.RbacStatement, and is useful for accessing the field via an interface.
 func (v *getRbacStatementResponse) GetRbacStatement() RbacStatement { return v.RbacStatement }
 
+// getReferenceTableResponse is returned by getReferenceTable on success.
+type getReferenceTableResponse struct {
+	ReferenceTable ReferenceTable `json:"referenceTable"`
+}
+
+// GetReferenceTable returns getReferenceTableResponse.ReferenceTable, and is useful for accessing the field via an interface.
+func (v *getReferenceTableResponse) GetReferenceTable() ReferenceTable { return v.ReferenceTable }
+
 // getSnowflakeOutboundShareResponse is returned by getSnowflakeOutboundShare on success.
 type getSnowflakeOutboundShareResponse struct {
 	Share SnowflakeOutboundShare `json:"share"`
@@ -10967,6 +14742,29 @@ type getWorkspaceResponse struct {
 // GetWorkspace returns getWorkspaceResponse.Workspace, and is useful for accessing the field via an interface.
 func (v *getWorkspaceResponse) GetWorkspace() *Workspace { return v.Workspace }
 
+// listDatasetInputsDatasetsProject includes the requested fields of the GraphQL type Project.
+// The GraphQL type's documentation follows.
+//
+// Project and Workspace are the same thing We call it Workspace in the UI
+// design now, so at some point, maybe update the API to match the updated
+// design?
+type listDatasetInputsDatasetsProject struct {
+	Datasets []DatasetInputs `json:"datasets"`
+}
+
+// GetDatasets returns listDatasetInputsDatasetsProject.Datasets, and is useful for accessing the field via an interface.
+func (v *listDatasetInputsDatasetsProject) GetDatasets() []DatasetInputs { return v.Datasets }
+
+// listDatasetInputsResponse is returned by listDatasetInputs on success.
+type listDatasetInputsResponse struct {
+	Datasets *listDatasetInputsDatasetsProject `json:"datasets"`
+}
+
+// GetDatasets returns listDatasetInputsResponse.Datasets, and is useful for accessing the field via an interface.
+func (v *listDatasetInputsResponse) GetDatasets() *listDatasetInputsDatasetsProject {
+	return v.Datasets
+}
+
 // listDatasetsDatasetsProject includes the requested fields of the GraphQL type Project.
 // The GraphQL type's documentation follows.
 //
@@ -11009,6 +14807,39 @@ type listDatasetsResponse struct {
 // GetDatasets returns listDatasetsResponse.Datasets, and is useful for accessing the field via an interface.
 func (v *listDatasetsResponse) GetDatasets() []listDatasetsDatasetsProject { return v.Datasets }
 
+// listDatastreamsResponse is returned by listDatastreams on success.
+type listDatastreamsResponse struct {
+	Datastreams []Datastream `json:"datastreams"`
+}
+
+// GetDatastreams returns listDatastreamsResponse.Datastreams, and is useful for accessing the field via an interface.
+func (v *listDatastreamsResponse) GetDatastreams() []Datastream { return v.Datastreams }
+
+// listMonitorsResponse is returned by listMonitors on success.
+type listMonitorsResponse struct {
+	Monitors []Monitor `json:"monitors"`
+}
+
+// GetMonitors returns listMonitorsResponse.Monitors, and is useful for accessing the field via an interface.
+func (v *listMonitorsResponse) GetMonitors() []Monitor { return v.Monitors }
+
+// listPollersResponse is returned by listPollers on success.
+type listPollersResponse struct {
+	Pollers []Poller `json:"pollers"`
+}
+
+// GetPollers returns listPollersResponse.Pollers, and is useful for accessing the field via an interface.
+func (v *listPollersResponse) GetPollers() []Poller { return v.Pollers }
+
+// listRbacStatementsResponse is returned by listRbacStatements on success.
+type listRbacStatementsResponse struct {
+	// All RBAC statements defined.
+	RbacStatements []RbacStatement `json:"rbacStatements"`
+}
+
+// GetRbacStatements returns listRbacStatementsResponse.RbacStatements, and is useful for accessing the field via an interface.
+func (v *listRbacStatementsResponse) GetRbacStatements() []RbacStatement { return v.RbacStatements }
+
 // listUsersResponse is returned by listUsers on success.
 type listUsersResponse struct {
 	Users *listUsersUsersCustomer `json:"users"`
@@ -11071,6 +14902,27 @@ type lookupAppResponse struct {
 // GetApps returns lookupAppResponse.Apps, and is useful for accessing the field via an interface.
 func (v *lookupAppResponse) GetApps() []App { return v.Apps }
 
+// lookupDataConnectionModuleVersionsModuleVersionsDataConnectionModuleVersion includes the requested fields of the GraphQL type DataConnectionModuleVersion.
+type lookupDataConnectionModuleVersionsModuleVersionsDataConnectionModuleVersion struct {
+	Version string `json:"version"`
+}
+
+// GetVersion returns lookupDataConnectionModuleVersionsModuleVersionsDataConnectionModuleVersion.Version, and is useful for accessing the field via an interface.
+func (v *lookupDataConnectionModuleVersionsModuleVersionsDataConnectionModuleVersion) GetVersion() string {
+	return v.Version
+}
+
+// lookupDataConnectionModuleVersionsResponse is returned by lookupDataConnectionModuleVersions on success.
+type lookupDataConnectionModuleVersionsResponse struct {
+	// DataConnectionModuleVersion returns the complete list of all versions a DataConnectionModule's definition.
+	ModuleVersions []lookupDataConnectionModuleVersionsModuleVersionsDataConnectionModuleVersion `json:"moduleVersions"`
+}
+
+// GetModuleVersions returns lookupDataConnectionModuleVersionsResponse.ModuleVersions, and is useful for accessing the field via an interface.
+func (v *lookupDataConnectionModuleVersionsResponse) GetModuleVersions() []lookupDataConnectionModuleVersionsModuleVersionsDataConnectionModuleVersion {
+	return v.ModuleVersions
+}
+
 // lookupDatasetDatasetProject includes the requested fields of the GraphQL type Project.
 // The GraphQL type's documentation follows.
 //
@@ -11209,6 +15061,50 @@ type removeCorrelationTagResponse struct {
 // GetResultStatus returns removeCorrelationTagResponse.ResultStatus, and is useful for accessing the field via an interface.
 func (v *removeCorrelationTagResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
+// removeIncidentDashboardsResponse is returned by removeIncidentDashboards on success.
+type removeIncidentDashboardsResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns removeIncidentDashboardsResponse.Incident, and is useful for accessing the field via an interface.
+func (v *removeIncidentDashboardsResponse) GetIncident() Incident { return v.Incident }
+
+// removeIncidentSlackChannelsResponse is returned by removeIncidentSlackChannels on success.
+type removeIncidentSlackChannelsResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns removeIncidentSlackChannelsResponse.Incident, and is useful for accessing the field via an interface.
+func (v *removeIncidentSlackChannelsResponse) GetIncident() Incident { return v.Incident }
+
+// removeIncidentUsersResponse is returned by removeIncidentUsers on success.
+type removeIncidentUsersResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns removeIncidentUsersResponse.Incident, and is useful for accessing the field via an interface.
+func (v *removeIncidentUsersResponse) GetIncident() Incident { return v.Incident }
+
+// removeIncidentWorksheetsResponse is returned by removeIncidentWorksheets on success.
+type removeIncidentWorksheetsResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns removeIncidentWorksheetsResponse.Incident, and is useful for accessing the field via an interface.
+func (v *removeIncidentWorksheetsResponse) GetIncident() Incident { return v.Incident }
+
+// renderMonitorV2TemplateResponse is returned by renderMonitorV2Template on success.
+type renderMonitorV2TemplateResponse struct {
+	// Receive an actionInput and sample data payload to render all the fields in the mustache template.
+	// SampleData is the json payload that contains all the fields to render the mustache template.
+	RenderedTemplate MonitorV2RenderedTemplate `json:"renderedTemplate"`
+}
+
+// GetRenderedTemplate returns renderMonitorV2TemplateResponse.RenderedTemplate, and is useful for accessing the field via an interface.
+func (v *renderMonitorV2TemplateResponse) GetRenderedTemplate() MonitorV2RenderedTemplate {
+	return v.RenderedTemplate
+}
+
 // saveDashboardResponse is returned by saveDashboard on success.
 type saveDashboardResponse struct {
 	Dashboard Dashboard `json:"dashboard"`
@@ -11239,6 +15135,35 @@ type saveDatasetResponse struct {
 // GetDataset returns saveDatasetResponse.Dataset, and is useful for accessing the field via an interface.
 func (v *saveDatasetResponse) GetDataset() *saveDatasetDatasetDatasetSaveResult { return v.Dataset }
 
+// saveFolderSettingsRecordsLayeredSettingRecord includes the requested fields of the GraphQL type LayeredSettingRecord.
+// The GraphQL type's documentation follows.
+//
+// A Layered Setting is like a feature flag that can be controlled by customers.
+// It allows control on a per-scope basis, where scope could be entire customer,
+// workspace, folder, app, dataset, monitor, or similar, with a broad-to-detailed
+// inheritance hierarchy.
+//
+// A Layered Setting Record is a record setting the value of a Layered Setting at
+// a particular scope.  When the value of a Layered Setting is read from a
+// particular scope, we merge the Layered Setting Records at each scope that
+// contains the requested scope to determine the return value.
+type saveFolderSettingsRecordsLayeredSettingRecord struct {
+	Id string `json:"id"`
+}
+
+// GetId returns saveFolderSettingsRecordsLayeredSettingRecord.Id, and is useful for accessing the field via an interface.
+func (v *saveFolderSettingsRecordsLayeredSettingRecord) GetId() string { return v.Id }
+
+// saveFolderSettingsResponse is returned by saveFolderSettings on success.
+type saveFolderSettingsResponse struct {
+	Records []saveFolderSettingsRecordsLayeredSettingRecord `json:"records"`
+}
+
+// GetRecords returns saveFolderSettingsResponse.Records, and is useful for accessing the field via an interface.
+func (v *saveFolderSettingsResponse) GetRecords() []saveFolderSettingsRecordsLayeredSettingRecord {
+	return v.Records
+}
+
 // saveMonitorV2RelationsResponse is returned by saveMonitorV2Relations on success.
 type saveMonitorV2RelationsResponse struct {
 	// saveMonitorV2Relations replaces all monitor relations (MonitorV2ActionRule, ActionDestinationLink)
@@ -11281,349 +15206,350 @@ type saveWorksheetResponse struct {
 // GetWorksheet returns saveWorksheetResponse.Worksheet, and is useful for accessing the field via an interface.
 func (v *saveWorksheetResponse) GetWorksheet() Worksheet { return v.Worksheet }
 
-// searchMonitorActionsResponse is returned by searchMonitorActions on success.
-type searchMonitorActionsResponse struct {
-	MonitorActions []MonitorAction `json:"-"`
+// searchApiTokensResponse is returned by searchApiTokens on success.
+type searchApiTokensResponse struct {
+	ApiTokens []ApiToken `json:"apiTokens"`
 }
 
-// GetMonitorActions returns searchMonitorActionsResponse.MonitorActions, and is useful for accessing the field via an interface.
-func (v *searchMonitorActionsResponse) GetMonitorActions() []MonitorAction { return v.MonitorActions }
+// GetApiTokens returns searchApiTokensResponse.ApiTokens, and is useful for accessing the field via an interface.
+func (v *searchApiTokensResponse) GetApiTokens() []ApiToken { return v.ApiTokens }
 
-func (v *searchMonitorActionsResponse) UnmarshalJSON(b []byte) error {
+// searchDashboardsDashboardSearchDashboardSearchResultWrapper includes the requested fields of the GraphQL type DashboardSearchResultWrapper.
+type searchDashboardsDashboardSearchDashboardSearchResultWrapper struct {
+	Dashboards []searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult `json:"dashboards"`
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetDashboards returns searchDashboardsDashboardSearchDashboardSearchResultWrapper.Dashboards, and is useful for accessing the field via an interface.
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapper) GetDashboards() []searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult {
+	return v.Dashboards
+}
 
-	var firstPass struct {
-		*searchMonitorActionsResponse
-		MonitorActions []json.RawMessage `json:"monitorActions"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.searchMonitorActionsResponse = v
+// searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult includes the requested fields of the GraphQL type DashboardSearchResult.
+type searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult struct {
+	Dashboard searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard `json:"dashboard"`
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetDashboard returns searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult.Dashboard, and is useful for accessing the field via an interface.
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResult) GetDashboard() searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard {
+	return v.Dashboard
+}
 
-	{
-		dst := &v.MonitorActions
-		src := firstPass.MonitorActions
-		*dst = make(
-			[]MonitorAction,
-			len(src))
-		for i, src := range src {
-			dst := &(*dst)[i]
-			if len(src) != 0 && string(src) != "null" {
-				err = __unmarshalMonitorAction(
-					src, dst)
-				if err != nil {
-					return fmt.Errorf(
-						"unable to unmarshal searchMonitorActionsResponse.MonitorActions: %w", err)
-				}
-			}
-		}
-	}
-	return nil
+// searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard includes the requested fields of the GraphQL type Dashboard.
+type searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	WorkspaceId string  `json:"workspaceId"`
+	FolderId    string  `json:"folderId"`
+	ManagedById *string `json:"managedById"`
 }
 
-type __premarshalsearchMonitorActionsResponse struct {
-	MonitorActions []json.RawMessage `json:"monitorActions"`
+// GetId returns searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard.Id, and is useful for accessing the field via an interface.
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard) GetId() string {
+	return v.Id
 }
 
-func (v *searchMonitorActionsResponse) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetName returns searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard.Name, and is useful for accessing the field via an interface.
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard) GetName() string {
+	return v.Name
 }
 
-func (v *searchMonitorActionsResponse) __premarshalJSON() (*__premarshalsearchMonitorActionsResponse, error) {
-	var retval __premarshalsearchMonitorActionsResponse
+// GetDescription returns searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard.Description, and is useful for accessing the field via an interface.
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard) GetDescription() *string {
+	return v.Description
+}
 
-	{
+// GetWorkspaceId returns searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard) GetWorkspaceId() string {
+	return v.WorkspaceId
+}
 
-		dst := &retval.MonitorActions
-		src := v.MonitorActions
-		*dst = make(
-			[]json.RawMessage,
-			len(src))
-		for i, src := range src {
-			dst := &(*dst)[i]
-			var err error
-			*dst, err = __marshalMonitorAction(
-				&src)
-			if err != nil {
-				return nil, fmt.Errorf(
-					"unable to marshal searchMonitorActionsResponse.MonitorActions: %w", err)
-			}
-		}
-	}
-	return &retval, nil
+// GetFolderId returns searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard.FolderId, and is useful for accessing the field via an interface.
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard) GetFolderId() string {
+	return v.FolderId
 }
 
-// searchMonitorV2ActionResponse is returned by searchMonitorV2Action on success.
-type searchMonitorV2ActionResponse struct {
-	MonitorV2Actions MonitorV2ActionSearchResult `json:"monitorV2Actions"`
+// GetManagedById returns searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard.ManagedById, and is useful for accessing the field via an interface.
+func (v *searchDashboardsDashboardSearchDashboardSearchResultWrapperDashboardsDashboardSearchResultDashboard) GetManagedById() *string {
+	return v.ManagedById
 }
 
-// GetMonitorV2Actions returns searchMonitorV2ActionResponse.MonitorV2Actions, and is useful for accessing the field via an interface.
-func (v *searchMonitorV2ActionResponse) GetMonitorV2Actions() MonitorV2ActionSearchResult {
-	return v.MonitorV2Actions
+// searchDashboardsResponse is returned by searchDashboards on success.
+type searchDashboardsResponse struct {
+	DashboardSearch searchDashboardsDashboardSearchDashboardSearchResultWrapper `json:"dashboardSearch"`
 }
 
-// setChannelsForChannelActionResponse is returned by setChannelsForChannelAction on success.
-type setChannelsForChannelActionResponse struct {
-	ResultStatus ResultStatus `json:"resultStatus"`
+// GetDashboardSearch returns searchDashboardsResponse.DashboardSearch, and is useful for accessing the field via an interface.
+func (v *searchDashboardsResponse) GetDashboardSearch() searchDashboardsDashboardSearchDashboardSearchResultWrapper {
+	return v.DashboardSearch
 }
 
-// GetResultStatus returns setChannelsForChannelActionResponse.ResultStatus, and is useful for accessing the field via an interface.
-func (v *setChannelsForChannelActionResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
-
-// setDefaultDashboardResponse is returned by setDefaultDashboard on success.
-type setDefaultDashboardResponse struct {
-	ResultStatus ResultStatus `json:"resultStatus"`
+// searchDataConnectionDataConnectionsDataConnectionSearchResult includes the requested fields of the GraphQL type DataConnectionSearchResult.
+type searchDataConnectionDataConnectionsDataConnectionSearchResult struct {
+	Results []DataConnection `json:"results"`
 }
 
-// GetResultStatus returns setDefaultDashboardResponse.ResultStatus, and is useful for accessing the field via an interface.
-func (v *setDefaultDashboardResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
-
-// setMonitorsForChannelResponse is returned by setMonitorsForChannel on success.
-type setMonitorsForChannelResponse struct {
-	ResultStatus ResultStatus `json:"resultStatus"`
+// GetResults returns searchDataConnectionDataConnectionsDataConnectionSearchResult.Results, and is useful for accessing the field via an interface.
+func (v *searchDataConnectionDataConnectionsDataConnectionSearchResult) GetResults() []DataConnection {
+	return v.Results
 }
 
-// GetResultStatus returns setMonitorsForChannelResponse.ResultStatus, and is useful for accessing the field via an interface.
-func (v *setMonitorsForChannelResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
-
-// setRbacDefaultGroupResponse is returned by setRbacDefaultGroup on success.
-type setRbacDefaultGroupResponse struct {
-	// Set the default group that users will be assigned to. Must currently be one
-	// of the pre-defined "reader", "writer", or "admin" groups!
-	ResultStatus ResultStatus `json:"resultStatus"`
+// searchDataConnectionResponse is returned by searchDataConnection on success.
+type searchDataConnectionResponse struct {
+	DataConnections searchDataConnectionDataConnectionsDataConnectionSearchResult `json:"dataConnections"`
 }
 
-// GetResultStatus returns setRbacDefaultGroupResponse.ResultStatus, and is useful for accessing the field via an interface.
-func (v *setRbacDefaultGroupResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+// GetDataConnections returns searchDataConnectionResponse.DataConnections, and is useful for accessing the field via an interface.
+func (v *searchDataConnectionResponse) GetDataConnections() searchDataConnectionDataConnectionsDataConnectionSearchResult {
+	return v.DataConnections
+}
 
-// unsetRbacDefaultGroupResponse is returned by unsetRbacDefaultGroup on success.
-type unsetRbacDefaultGroupResponse struct {
-	ResultStatus ResultStatus `json:"resultStatus"`
+// searchDatasetOutboundShareDatasetOutboundSharesDatasetOutboundShareSearchResult includes the requested fields of the GraphQL type DatasetOutboundShareSearchResult.
+type searchDatasetOutboundShareDatasetOutboundSharesDatasetOutboundShareSearchResult struct {
+	Results []DatasetOutboundShare `json:"results"`
 }
 
-// GetResultStatus returns unsetRbacDefaultGroupResponse.ResultStatus, and is useful for accessing the field via an interface.
-func (v *unsetRbacDefaultGroupResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+// GetResults returns searchDatasetOutboundShareDatasetOutboundSharesDatasetOutboundShareSearchResult.Results, and is useful for accessing the field via an interface.
+func (v *searchDatasetOutboundShareDatasetOutboundSharesDatasetOutboundShareSearchResult) GetResults() []DatasetOutboundShare {
+	return v.Results
+}
 
-// updateAppDataSourceResponse is returned by updateAppDataSource on success.
-type updateAppDataSourceResponse struct {
-	Appdatasource AppDataSource `json:"appdatasource"`
+// searchDatasetOutboundShareResponse is returned by searchDatasetOutboundShare on success.
+type searchDatasetOutboundShareResponse struct {
+	DatasetOutboundShares searchDatasetOutboundShareDatasetOutboundSharesDatasetOutboundShareSearchResult `json:"datasetOutboundShares"`
 }
 
-// GetAppdatasource returns updateAppDataSourceResponse.Appdatasource, and is useful for accessing the field via an interface.
-func (v *updateAppDataSourceResponse) GetAppdatasource() AppDataSource { return v.Appdatasource }
+// GetDatasetOutboundShares returns searchDatasetOutboundShareResponse.DatasetOutboundShares, and is useful for accessing the field via an interface.
+func (v *searchDatasetOutboundShareResponse) GetDatasetOutboundShares() searchDatasetOutboundShareDatasetOutboundSharesDatasetOutboundShareSearchResult {
+	return v.DatasetOutboundShares
+}
 
-// updateAppResponse is returned by updateApp on success.
-type updateAppResponse struct {
-	App App `json:"app"`
+// searchDatasetsDatasetMatchesDatasetMatch includes the requested fields of the GraphQL type DatasetMatch.
+type searchDatasetsDatasetMatchesDatasetMatch struct {
+	Dataset searchDatasetsDatasetMatchesDatasetMatchDataset `json:"dataset"`
 }
 
-// GetApp returns updateAppResponse.App, and is useful for accessing the field via an interface.
-func (v *updateAppResponse) GetApp() App { return v.App }
+// GetDataset returns searchDatasetsDatasetMatchesDatasetMatch.Dataset, and is useful for accessing the field via an interface.
+func (v *searchDatasetsDatasetMatchesDatasetMatch) GetDataset() searchDatasetsDatasetMatchesDatasetMatchDataset {
+	return v.Dataset
+}
 
-// updateBoardResponse is returned by updateBoard on success.
-type updateBoardResponse struct {
-	Board Board `json:"board"`
+// searchDatasetsDatasetMatchesDatasetMatchDataset includes the requested fields of the GraphQL type Dataset.
+type searchDatasetsDatasetMatchesDatasetMatchDataset struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	WorkspaceId string  `json:"workspaceId"`
+	FolderId    string  `json:"folderId"`
+	ManagedById *string `json:"managedById"`
+	Source      *string `json:"source"`
 }
 
-// GetBoard returns updateBoardResponse.Board, and is useful for accessing the field via an interface.
-func (v *updateBoardResponse) GetBoard() Board { return v.Board }
+// GetId returns searchDatasetsDatasetMatchesDatasetMatchDataset.Id, and is useful for accessing the field via an interface.
+func (v *searchDatasetsDatasetMatchesDatasetMatchDataset) GetId() string { return v.Id }
 
-// updateChannelActionResponse is returned by updateChannelAction on success.
-type updateChannelActionResponse struct {
-	ChannelAction *ChannelAction `json:"-"`
+// GetName returns searchDatasetsDatasetMatchesDatasetMatchDataset.Name, and is useful for accessing the field via an interface.
+func (v *searchDatasetsDatasetMatchesDatasetMatchDataset) GetName() string { return v.Name }
+
+// GetDescription returns searchDatasetsDatasetMatchesDatasetMatchDataset.Description, and is useful for accessing the field via an interface.
+func (v *searchDatasetsDatasetMatchesDatasetMatchDataset) GetDescription() *string {
+	return v.Description
 }
 
-// GetChannelAction returns updateChannelActionResponse.ChannelAction, and is useful for accessing the field via an interface.
-func (v *updateChannelActionResponse) GetChannelAction() *ChannelAction { return v.ChannelAction }
+// GetWorkspaceId returns searchDatasetsDatasetMatchesDatasetMatchDataset.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *searchDatasetsDatasetMatchesDatasetMatchDataset) GetWorkspaceId() string {
+	return v.WorkspaceId
+}
 
-func (v *updateChannelActionResponse) UnmarshalJSON(b []byte) error {
+// GetFolderId returns searchDatasetsDatasetMatchesDatasetMatchDataset.FolderId, and is useful for accessing the field via an interface.
+func (v *searchDatasetsDatasetMatchesDatasetMatchDataset) GetFolderId() string { return v.FolderId }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetManagedById returns searchDatasetsDatasetMatchesDatasetMatchDataset.ManagedById, and is useful for accessing the field via an interface.
+func (v *searchDatasetsDatasetMatchesDatasetMatchDataset) GetManagedById() *string {
+	return v.ManagedById
+}
 
-	var firstPass struct {
-		*updateChannelActionResponse
-		ChannelAction json.RawMessage `json:"channelAction"`
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.updateChannelActionResponse = v
+// GetSource returns searchDatasetsDatasetMatchesDatasetMatchDataset.Source, and is useful for accessing the field via an interface.
+func (v *searchDatasetsDatasetMatchesDatasetMatchDataset) GetSource() *string { return v.Source }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// searchDatasetsResponse is returned by searchDatasets on success.
+type searchDatasetsResponse struct {
+	// searchMode defaults to InclusiveMode, which means "any matches, counts" sorted by better-scoring.
+	// If you pass in ExclusiveMode, then you get "must match each thing" behavior, which may end up
+	// returning no datasets at all quite easily.
+	DatasetMatches []searchDatasetsDatasetMatchesDatasetMatch `json:"datasetMatches"`
+}
 
-	{
-		dst := &v.ChannelAction
-		src := firstPass.ChannelAction
-		if len(src) != 0 && string(src) != "null" {
-			*dst = new(ChannelAction)
-			err = __unmarshalChannelAction(
-				src, *dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal updateChannelActionResponse.ChannelAction: %w", err)
-			}
-		}
-	}
-	return nil
+// GetDatasetMatches returns searchDatasetsResponse.DatasetMatches, and is useful for accessing the field via an interface.
+func (v *searchDatasetsResponse) GetDatasetMatches() []searchDatasetsDatasetMatchesDatasetMatch {
+	return v.DatasetMatches
 }
 
-type __premarshalupdateChannelActionResponse struct {
-	ChannelAction json.RawMessage `json:"channelAction"`
+// searchDatasourceDatasourcesDatasourceSearchResult includes the requested fields of the GraphQL type DatasourceSearchResult.
+type searchDatasourceDatasourcesDatasourceSearchResult struct {
+	Results []Datasource `json:"results"`
 }
 
-func (v *updateChannelActionResponse) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetResults returns searchDatasourceDatasourcesDatasourceSearchResult.Results, and is useful for accessing the field via an interface.
+func (v *searchDatasourceDatasourcesDatasourceSearchResult) GetResults() []Datasource {
+	return v.Results
 }
 
-func (v *updateChannelActionResponse) __premarshalJSON() (*__premarshalupdateChannelActionResponse, error) {
-	var retval __premarshalupdateChannelActionResponse
+// searchDatasourceResponse is returned by searchDatasource on success.
+type searchDatasourceResponse struct {
+	Datasources searchDatasourceDatasourcesDatasourceSearchResult `json:"datasources"`
+}
 
-	{
+// GetDatasources returns searchDatasourceResponse.Datasources, and is useful for accessing the field via an interface.
+func (v *searchDatasourceResponse) GetDatasources() searchDatasourceDatasourcesDatasourceSearchResult {
+	return v.Datasources
+}
 
-		dst := &retval.ChannelAction
-		src := v.ChannelAction
-		if src != nil {
-			var err error
-			*dst, err = __marshalChannelAction(
-				src)
-			if err != nil {
-				return nil, fmt.Errorf(
-					"unable to marshal updateChannelActionResponse.ChannelAction: %w", err)
-			}
-		}
-	}
-	return &retval, nil
+// searchIncidentIncidentsIncidentSearchResult includes the requested fields of the GraphQL type IncidentSearchResult.
+type searchIncidentIncidentsIncidentSearchResult struct {
+	Results []Incident `json:"results"`
 }
 
-// updateChannelResponse is returned by updateChannel on success.
-type updateChannelResponse struct {
-	Channel *Channel `json:"channel"`
+// GetResults returns searchIncidentIncidentsIncidentSearchResult.Results, and is useful for accessing the field via an interface.
+func (v *searchIncidentIncidentsIncidentSearchResult) GetResults() []Incident { return v.Results }
+
+// searchIncidentResponse is returned by searchIncident on success.
+type searchIncidentResponse struct {
+	Incidents searchIncidentIncidentsIncidentSearchResult `json:"incidents"`
 }
 
-// GetChannel returns updateChannelResponse.Channel, and is useful for accessing the field via an interface.
-func (v *updateChannelResponse) GetChannel() *Channel { return v.Channel }
+// GetIncidents returns searchIncidentResponse.Incidents, and is useful for accessing the field via an interface.
+func (v *searchIncidentResponse) GetIncidents() searchIncidentIncidentsIncidentSearchResult {
+	return v.Incidents
+}
 
-// updateDashboardLinkResponse is returned by updateDashboardLink on success.
-type updateDashboardLinkResponse struct {
-	DashboardLink DashboardLink `json:"dashboardLink"`
+// searchInvestigationNotebookInvestigationNotebooksInvestigationNotebookSearchResult includes the requested fields of the GraphQL type InvestigationNotebookSearchResult.
+type searchInvestigationNotebookInvestigationNotebooksInvestigationNotebookSearchResult struct {
+	Results []InvestigationNotebook `json:"results"`
 }
 
-// GetDashboardLink returns updateDashboardLinkResponse.DashboardLink, and is useful for accessing the field via an interface.
-func (v *updateDashboardLinkResponse) GetDashboardLink() DashboardLink { return v.DashboardLink }
+// GetResults returns searchInvestigationNotebookInvestigationNotebooksInvestigationNotebookSearchResult.Results, and is useful for accessing the field via an interface.
+func (v *searchInvestigationNotebookInvestigationNotebooksInvestigationNotebookSearchResult) GetResults() []InvestigationNotebook {
+	return v.Results
+}
 
-// updateDatasetOutboundShareResponse is returned by updateDatasetOutboundShare on success.
-type updateDatasetOutboundShareResponse struct {
-	DatasetOutboundShare DatasetOutboundShare `json:"datasetOutboundShare"`
+// searchInvestigationNotebookResponse is returned by searchInvestigationNotebook on success.
+type searchInvestigationNotebookResponse struct {
+	InvestigationNotebooks searchInvestigationNotebookInvestigationNotebooksInvestigationNotebookSearchResult `json:"investigationNotebooks"`
 }
 
-// GetDatasetOutboundShare returns updateDatasetOutboundShareResponse.DatasetOutboundShare, and is useful for accessing the field via an interface.
-func (v *updateDatasetOutboundShareResponse) GetDatasetOutboundShare() DatasetOutboundShare {
-	return v.DatasetOutboundShare
+// GetInvestigationNotebooks returns searchInvestigationNotebookResponse.InvestigationNotebooks, and is useful for accessing the field via an interface.
+func (v *searchInvestigationNotebookResponse) GetInvestigationNotebooks() searchInvestigationNotebookInvestigationNotebooksInvestigationNotebookSearchResult {
+	return v.InvestigationNotebooks
 }
 
-// updateDatastreamResponse is returned by updateDatastream on success.
-type updateDatastreamResponse struct {
-	Datastream Datastream `json:"datastream"`
+// searchMetricsMetricSearchMetricSearchResult includes the requested fields of the GraphQL type MetricSearchResult.
+type searchMetricsMetricSearchMetricSearchResult struct {
+	Matches  []searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch `json:"matches"`
+	Datasets []searchMetricsMetricSearchMetricSearchResultDatasetsDataset    `json:"datasets"`
 }
 
-// GetDatastream returns updateDatastreamResponse.Datastream, and is useful for accessing the field via an interface.
-func (v *updateDatastreamResponse) GetDatastream() Datastream { return v.Datastream }
+// GetMatches returns searchMetricsMetricSearchMetricSearchResult.Matches, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResult) GetMatches() []searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch {
+	return v.Matches
+}
 
-// updateDatastreamTokenResponse is returned by updateDatastreamToken on success.
-type updateDatastreamTokenResponse struct {
-	DatastreamToken DatastreamToken `json:"datastreamToken"`
+// GetDatasets returns searchMetricsMetricSearchMetricSearchResult.Datasets, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResult) GetDatasets() []searchMetricsMetricSearchMetricSearchResultDatasetsDataset {
+	return v.Datasets
 }
 
-// GetDatastreamToken returns updateDatastreamTokenResponse.DatastreamToken, and is useful for accessing the field via an interface.
-func (v *updateDatastreamTokenResponse) GetDatastreamToken() DatastreamToken {
-	return v.DatastreamToken
+// searchMetricsMetricSearchMetricSearchResultDatasetsDataset includes the requested fields of the GraphQL type Dataset.
+type searchMetricsMetricSearchMetricSearchResultDatasetsDataset struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	WorkspaceId string `json:"workspaceId"`
+	FolderId    string `json:"folderId"`
 }
 
-// updateDeferredForeignKeyResponse is returned by updateDeferredForeignKey on success.
-type updateDeferredForeignKeyResponse struct {
-	DeferredForeignKey *DeferredForeignKey `json:"deferredForeignKey"`
+// GetId returns searchMetricsMetricSearchMetricSearchResultDatasetsDataset.Id, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResultDatasetsDataset) GetId() string { return v.Id }
+
+// GetName returns searchMetricsMetricSearchMetricSearchResultDatasetsDataset.Name, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResultDatasetsDataset) GetName() string { return v.Name }
+
+// GetWorkspaceId returns searchMetricsMetricSearchMetricSearchResultDatasetsDataset.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResultDatasetsDataset) GetWorkspaceId() string {
+	return v.WorkspaceId
 }
 
-// GetDeferredForeignKey returns updateDeferredForeignKeyResponse.DeferredForeignKey, and is useful for accessing the field via an interface.
-func (v *updateDeferredForeignKeyResponse) GetDeferredForeignKey() *DeferredForeignKey {
-	return v.DeferredForeignKey
+// GetFolderId returns searchMetricsMetricSearchMetricSearchResultDatasetsDataset.FolderId, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResultDatasetsDataset) GetFolderId() string {
+	return v.FolderId
 }
 
-// updateFiledropResponse is returned by updateFiledrop on success.
-type updateFiledropResponse struct {
-	Filedrop *Filedrop `json:"filedrop"`
+// searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch includes the requested fields of the GraphQL type MetricMatch.
+type searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch struct {
+	Metric    searchMetricsMetricSearchMetricSearchResultMatchesMetricMatchMetric `json:"metric"`
+	DatasetId *string                                                             `json:"datasetId"`
 }
 
-// GetFiledrop returns updateFiledropResponse.Filedrop, and is useful for accessing the field via an interface.
-func (v *updateFiledropResponse) GetFiledrop() *Filedrop { return v.Filedrop }
+// GetMetric returns searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch.Metric, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch) GetMetric() searchMetricsMetricSearchMetricSearchResultMatchesMetricMatchMetric {
+	return v.Metric
+}
 
-// updateFolderResponse is returned by updateFolder on success.
-type updateFolderResponse struct {
-	Folder Folder `json:"folder"`
+// GetDatasetId returns searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch.DatasetId, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResultMatchesMetricMatch) GetDatasetId() *string {
+	return v.DatasetId
 }
 
-// GetFolder returns updateFolderResponse.Folder, and is useful for accessing the field via an interface.
-func (v *updateFolderResponse) GetFolder() Folder { return v.Folder }
+// searchMetricsMetricSearchMetricSearchResultMatchesMetricMatchMetric includes the requested fields of the GraphQL type Metric.
+type searchMetricsMetricSearchMetricSearchResultMatchesMetricMatchMetric struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
 
-// updateLayeredSettingRecordResponse is returned by updateLayeredSettingRecord on success.
-type updateLayeredSettingRecordResponse struct {
-	LayeredSettingRecord LayeredSettingRecord `json:"layeredSettingRecord"`
+// GetName returns searchMetricsMetricSearchMetricSearchResultMatchesMetricMatchMetric.Name, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResultMatchesMetricMatchMetric) GetName() string {
+	return v.Name
 }
 
-// GetLayeredSettingRecord returns updateLayeredSettingRecordResponse.LayeredSettingRecord, and is useful for accessing the field via an interface.
-func (v *updateLayeredSettingRecordResponse) GetLayeredSettingRecord() LayeredSettingRecord {
-	return v.LayeredSettingRecord
+// GetDescription returns searchMetricsMetricSearchMetricSearchResultMatchesMetricMatchMetric.Description, and is useful for accessing the field via an interface.
+func (v *searchMetricsMetricSearchMetricSearchResultMatchesMetricMatchMetric) GetDescription() string {
+	return v.Description
 }
 
-// updateMonitorActionAttachmentResponse is returned by updateMonitorActionAttachment on success.
-type updateMonitorActionAttachmentResponse struct {
-	MonitorActionAttachment *MonitorActionAttachment `json:"monitorActionAttachment"`
+// searchMetricsResponse is returned by searchMetrics on success.
+type searchMetricsResponse struct {
+	// metricSearch finds all matched metrics:
+	// - inDatasets limits the candidates to only the metrics belonging to any of the provided metric datasets
+	// - linkToDatasets limits the candidates to only the metrics in the metric dataset that has link(s) to any of the provided resource datasets
+	// - match will be used to to match against (case ignored) metric name, label and description
+	// - heuristicsOptions, when provided, expands the search to also include computed metric heuristics
+	MetricSearch searchMetricsMetricSearchMetricSearchResult `json:"metricSearch"`
 }
 
-// GetMonitorActionAttachment returns updateMonitorActionAttachmentResponse.MonitorActionAttachment, and is useful for accessing the field via an interface.
-func (v *updateMonitorActionAttachmentResponse) GetMonitorActionAttachment() *MonitorActionAttachment {
-	return v.MonitorActionAttachment
+// GetMetricSearch returns searchMetricsResponse.MetricSearch, and is useful for accessing the field via an interface.
+func (v *searchMetricsResponse) GetMetricSearch() searchMetricsMetricSearchMetricSearchResult {
+	return v.MetricSearch
 }
 
-// updateMonitorActionResponse is returned by updateMonitorAction on success.
-type updateMonitorActionResponse struct {
-	MonitorAction *MonitorAction `json:"-"`
+// searchMonitorActionsResponse is returned by searchMonitorActions on success.
+type searchMonitorActionsResponse struct {
+	MonitorActions []MonitorAction `json:"-"`
 }
 
-// GetMonitorAction returns updateMonitorActionResponse.MonitorAction, and is useful for accessing the field via an interface.
-func (v *updateMonitorActionResponse) GetMonitorAction() *MonitorAction { return v.MonitorAction }
+// GetMonitorActions returns searchMonitorActionsResponse.MonitorActions, and is useful for accessing the field via an interface.
+func (v *searchMonitorActionsResponse) GetMonitorActions() []MonitorAction { return v.MonitorActions }
 
-func (v *updateMonitorActionResponse) UnmarshalJSON(b []byte) error {
+func (v *searchMonitorActionsResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*updateMonitorActionResponse
-		MonitorAction json.RawMessage `json:"monitorAction"`
+		*searchMonitorActionsResponse
+		MonitorActions []json.RawMessage `json:"monitorActions"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.updateMonitorActionResponse = v
+	firstPass.searchMonitorActionsResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -11631,26 +15557,31 @@ func (v *updateMonitorActionResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.MonitorAction
-		src := firstPass.MonitorAction
-		if len(src) != 0 && string(src) != "null" {
-			*dst = new(MonitorAction)
-			err = __unmarshalMonitorAction(
-				src, *dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal updateMonitorActionResponse.MonitorAction: %w", err)
+		dst := &v.MonitorActions
+		src := firstPass.MonitorActions
+		*dst = make(
+			[]MonitorAction,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalMonitorAction(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal searchMonitorActionsResponse.MonitorActions: %w", err)
+				}
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalupdateMonitorActionResponse struct {
-	MonitorAction json.RawMessage `json:"monitorAction"`
+type __premarshalsearchMonitorActionsResponse struct {
+	MonitorActions []json.RawMessage `json:"monitorActions"`
 }
 
-func (v *updateMonitorActionResponse) MarshalJSON() ([]byte, error) {
+func (v *searchMonitorActionsResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -11658,297 +15589,3760 @@ func (v *updateMonitorActionResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *updateMonitorActionResponse) __premarshalJSON() (*__premarshalupdateMonitorActionResponse, error) {
-	var retval __premarshalupdateMonitorActionResponse
+func (v *searchMonitorActionsResponse) __premarshalJSON() (*__premarshalsearchMonitorActionsResponse, error) {
+	var retval __premarshalsearchMonitorActionsResponse
 
 	{
 
-		dst := &retval.MonitorAction
-		src := v.MonitorAction
-		if src != nil {
+		dst := &retval.MonitorActions
+		src := v.MonitorActions
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
 			var err error
 			*dst, err = __marshalMonitorAction(
-				src)
+				&src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"unable to marshal updateMonitorActionResponse.MonitorAction: %w", err)
+					"unable to marshal searchMonitorActionsResponse.MonitorActions: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// updateMonitorMonitorMonitorUpdateResult includes the requested fields of the GraphQL type MonitorUpdateResult.
-type updateMonitorMonitorMonitorUpdateResult struct {
-	Monitor Monitor `json:"monitor"`
+// searchMonitorMuteRuleMonitorMuteRulesMonitorMuteRuleSearchResult includes the requested fields of the GraphQL type MonitorMuteRuleSearchResult.
+type searchMonitorMuteRuleMonitorMuteRulesMonitorMuteRuleSearchResult struct {
+	Results []MonitorMuteRule `json:"results"`
 }
 
-// GetMonitor returns updateMonitorMonitorMonitorUpdateResult.Monitor, and is useful for accessing the field via an interface.
-func (v *updateMonitorMonitorMonitorUpdateResult) GetMonitor() Monitor { return v.Monitor }
+// GetResults returns searchMonitorMuteRuleMonitorMuteRulesMonitorMuteRuleSearchResult.Results, and is useful for accessing the field via an interface.
+func (v *searchMonitorMuteRuleMonitorMuteRulesMonitorMuteRuleSearchResult) GetResults() []MonitorMuteRule {
+	return v.Results
+}
 
-// updateMonitorResponse is returned by updateMonitor on success.
-type updateMonitorResponse struct {
-	Monitor *updateMonitorMonitorMonitorUpdateResult `json:"monitor"`
+// searchMonitorMuteRuleResponse is returned by searchMonitorMuteRule on success.
+type searchMonitorMuteRuleResponse struct {
+	MonitorMuteRules searchMonitorMuteRuleMonitorMuteRulesMonitorMuteRuleSearchResult `json:"monitorMuteRules"`
 }
 
-// GetMonitor returns updateMonitorResponse.Monitor, and is useful for accessing the field via an interface.
-func (v *updateMonitorResponse) GetMonitor() *updateMonitorMonitorMonitorUpdateResult {
-	return v.Monitor
+// GetMonitorMuteRules returns searchMonitorMuteRuleResponse.MonitorMuteRules, and is useful for accessing the field via an interface.
+func (v *searchMonitorMuteRuleResponse) GetMonitorMuteRules() searchMonitorMuteRuleMonitorMuteRulesMonitorMuteRuleSearchResult {
+	return v.MonitorMuteRules
 }
 
-// updateMonitorV2ActionResponse is returned by updateMonitorV2Action on success.
-type updateMonitorV2ActionResponse struct {
-	MonitorV2Action MonitorV2Action `json:"monitorV2Action"`
+// searchMonitorV2ActionResponse is returned by searchMonitorV2Action on success.
+type searchMonitorV2ActionResponse struct {
+	MonitorV2Actions MonitorV2ActionSearchResult `json:"monitorV2Actions"`
 }
 
-// GetMonitorV2Action returns updateMonitorV2ActionResponse.MonitorV2Action, and is useful for accessing the field via an interface.
-func (v *updateMonitorV2ActionResponse) GetMonitorV2Action() MonitorV2Action {
-	return v.MonitorV2Action
+// GetMonitorV2Actions returns searchMonitorV2ActionResponse.MonitorV2Actions, and is useful for accessing the field via an interface.
+func (v *searchMonitorV2ActionResponse) GetMonitorV2Actions() MonitorV2ActionSearchResult {
+	return v.MonitorV2Actions
 }
 
-// updateMonitorV2Response is returned by updateMonitorV2 on success.
-type updateMonitorV2Response struct {
-	MonitorV2 MonitorV2 `json:"monitorV2"`
+// searchMonitorV2AlarmsAlarmsMonitorV2AlarmSearchResult includes the requested fields of the GraphQL type MonitorV2AlarmSearchResult.
+type searchMonitorV2AlarmsAlarmsMonitorV2AlarmSearchResult struct {
+	Results []MonitorV2Alarm `json:"results"`
 }
 
-// GetMonitorV2 returns updateMonitorV2Response.MonitorV2, and is useful for accessing the field via an interface.
-func (v *updateMonitorV2Response) GetMonitorV2() MonitorV2 { return v.MonitorV2 }
+// GetResults returns searchMonitorV2AlarmsAlarmsMonitorV2AlarmSearchResult.Results, and is useful for accessing the field via an interface.
+func (v *searchMonitorV2AlarmsAlarmsMonitorV2AlarmSearchResult) GetResults() []MonitorV2Alarm {
+	return v.Results
+}
 
-// updatePollerResponse is returned by updatePoller on success.
-type updatePollerResponse struct {
-	Poller Poller `json:"poller"`
+// searchMonitorV2AlarmsResponse is returned by searchMonitorV2Alarms on success.
+type searchMonitorV2AlarmsResponse struct {
+	// searchMonitorV2Alarms can be used to query alerts in the explorer using various optional filters.
+	//
+	// monitorIds optionally restricts to a specific monitors
+	// nameSubstring restricts to monitors with partial match on the name
+	// alarmId optionally restricts to a single alarm
+	// minTime and maxTime optionally restrict to alarms that partially overlap with the time range
+	// levels optionally restricts by severity levels
+	// groupingHash optionally restricts to alarms with the same groupiingHash, which can be used to
+	// see logically grouped alarms.
+	// active optionally filters on the active flag
+	//
+	// note: At this time, rbac enforcement is done on the associated monitor for list privs
+	Alarms searchMonitorV2AlarmsAlarmsMonitorV2AlarmSearchResult `json:"alarms"`
 }
 
-// GetPoller returns updatePollerResponse.Poller, and is useful for accessing the field via an interface.
-func (v *updatePollerResponse) GetPoller() Poller { return v.Poller }
+// GetAlarms returns searchMonitorV2AlarmsResponse.Alarms, and is useful for accessing the field via an interface.
+func (v *searchMonitorV2AlarmsResponse) GetAlarms() searchMonitorV2AlarmsAlarmsMonitorV2AlarmSearchResult {
+	return v.Alarms
+}
 
-// updatePreferredPathResponse is returned by updatePreferredPath on success.
-type updatePreferredPathResponse struct {
-	// Update a preferred path. When 'acceptInErrorState" is true, the API will accept
-	// a save/update for a path, even if it means that the path will be left in error
-	// state, else an error will be returned.
-	PreferredPathWithStatus PreferredPathWithStatus `json:"preferredPathWithStatus"`
+// searchReferenceTableReferenceTablesReferenceTableSearchResult includes the requested fields of the GraphQL type ReferenceTableSearchResult.
+type searchReferenceTableReferenceTablesReferenceTableSearchResult struct {
+	Results []ReferenceTable `json:"results"`
 }
 
-// GetPreferredPathWithStatus returns updatePreferredPathResponse.PreferredPathWithStatus, and is useful for accessing the field via an interface.
-func (v *updatePreferredPathResponse) GetPreferredPathWithStatus() PreferredPathWithStatus {
-	return v.PreferredPathWithStatus
+// GetResults returns searchReferenceTableReferenceTablesReferenceTableSearchResult.Results, and is useful for accessing the field via an interface.
+func (v *searchReferenceTableReferenceTablesReferenceTableSearchResult) GetResults() []ReferenceTable {
+	return v.Results
 }
 
-// updateRbacGroupResponse is returned by updateRbacGroup on success.
-type updateRbacGroupResponse struct {
-	RbacGroup RbacGroup `json:"rbacGroup"`
+// searchReferenceTableResponse is returned by searchReferenceTable on success.
+type searchReferenceTableResponse struct {
+	ReferenceTables searchReferenceTableReferenceTablesReferenceTableSearchResult `json:"referenceTables"`
 }
 
-// GetRbacGroup returns updateRbacGroupResponse.RbacGroup, and is useful for accessing the field via an interface.
-func (v *updateRbacGroupResponse) GetRbacGroup() RbacGroup { return v.RbacGroup }
+// GetReferenceTables returns searchReferenceTableResponse.ReferenceTables, and is useful for accessing the field via an interface.
+func (v *searchReferenceTableResponse) GetReferenceTables() searchReferenceTableReferenceTablesReferenceTableSearchResult {
+	return v.ReferenceTables
+}
 
-// updateRbacGroupmemberResponse is returned by updateRbacGroupmember on success.
-type updateRbacGroupmemberResponse struct {
-	RbacGroupmember RbacGroupmember `json:"rbacGroupmember"`
+// searchWorksheetsResponse is returned by searchWorksheets on success.
+type searchWorksheetsResponse struct {
+	WorksheetSearch searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper `json:"worksheetSearch"`
 }
 
-// GetRbacGroupmember returns updateRbacGroupmemberResponse.RbacGroupmember, and is useful for accessing the field via an interface.
-func (v *updateRbacGroupmemberResponse) GetRbacGroupmember() RbacGroupmember {
-	return v.RbacGroupmember
+// GetWorksheetSearch returns searchWorksheetsResponse.WorksheetSearch, and is useful for accessing the field via an interface.
+func (v *searchWorksheetsResponse) GetWorksheetSearch() searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper {
+	return v.WorksheetSearch
 }
 
-// updateRbacStatementResponse is returned by updateRbacStatement on success.
-type updateRbacStatementResponse struct {
-	RbacStatement RbacStatement `json:"rbacStatement"`
+// searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper includes the requested fields of the GraphQL type WorksheetSearchResultWrapper.
+type searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper struct {
+	Worksheets []searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult `json:"worksheets"`
 }
 
-// GetRbacStatement returns updateRbacStatementResponse.RbacStatement, and is useful for accessing the field via an interface.
-func (v *updateRbacStatementResponse) GetRbacStatement() RbacStatement { return v.RbacStatement }
+// GetWorksheets returns searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper.Worksheets, and is useful for accessing the field via an interface.
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapper) GetWorksheets() []searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult {
+	return v.Worksheets
+}
 
-// updateSnowflakeOutboundShareResponse is returned by updateSnowflakeOutboundShare on success.
-type updateSnowflakeOutboundShareResponse struct {
-	Share SnowflakeOutboundShare `json:"share"`
+// searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult includes the requested fields of the GraphQL type WorksheetSearchResult.
+type searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult struct {
+	Worksheet searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet `json:"worksheet"`
 }
 
-// GetShare returns updateSnowflakeOutboundShareResponse.Share, and is useful for accessing the field via an interface.
-func (v *updateSnowflakeOutboundShareResponse) GetShare() SnowflakeOutboundShare { return v.Share }
+// GetWorksheet returns searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult.Worksheet, and is useful for accessing the field via an interface.
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResult) GetWorksheet() searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet {
+	return v.Worksheet
+}
 
-// updateWorkspaceResponse is returned by updateWorkspace on success.
-type updateWorkspaceResponse struct {
-	Workspace *Workspace `json:"workspace"`
+// searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet includes the requested fields of the GraphQL type Worksheet.
+type searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	WorkspaceId string `json:"workspaceId"`
+	FolderId    string `json:"folderId"`
 }
 
-// GetWorkspace returns updateWorkspaceResponse.Workspace, and is useful for accessing the field via an interface.
-func (v *updateWorkspaceResponse) GetWorkspace() *Workspace { return v.Workspace }
+// GetId returns searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet.Id, and is useful for accessing the field via an interface.
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet) GetId() string {
+	return v.Id
+}
 
-// The query or mutation executed by addCorrelationTag.
-const addCorrelationTag_Operation = `
-mutation addCorrelationTag ($datasetId: ObjectId!, $path: LinkFieldInput!, $tag: String!) {
-	resultStatus: addCorrelationTag(datasetId: $datasetId, path: $path, tag: $tag) {
-		... ResultStatus
-	}
+// GetName returns searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet.Name, and is useful for accessing the field via an interface.
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet) GetName() string {
+	return v.Name
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+
+// GetWorkspaceId returns searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet) GetWorkspaceId() string {
+	return v.WorkspaceId
 }
-`
 
-func addCorrelationTag(
-	ctx context.Context,
-	client graphql.Client,
-	datasetId string,
-	path LinkFieldInput,
-	tag string,
-) (*addCorrelationTagResponse, error) {
-	req := &graphql.Request{
-		OpName: "addCorrelationTag",
-		Query:  addCorrelationTag_Operation,
-		Variables: &__addCorrelationTagInput{
-			DatasetId: datasetId,
-			Path:      path,
-			Tag:       tag,
-		},
-	}
-	var err error
+// GetFolderId returns searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet.FolderId, and is useful for accessing the field via an interface.
+func (v *searchWorksheetsWorksheetSearchWorksheetSearchResultWrapperWorksheetsWorksheetSearchResultWorksheet) GetFolderId() string {
+	return v.FolderId
+}
 
-	var data addCorrelationTagResponse
-	resp := &graphql.Response{Data: &data}
+// setChannelsForChannelActionResponse is returned by setChannelsForChannelAction on success.
+type setChannelsForChannelActionResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
 
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
+// GetResultStatus returns setChannelsForChannelActionResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *setChannelsForChannelActionResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
-	return &data, err
+// setDefaultDashboardResponse is returned by setDefaultDashboard on success.
+type setDefaultDashboardResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
 }
 
-// The query or mutation executed by clearDefaultDashboard.
-const clearDefaultDashboard_Operation = `
-mutation clearDefaultDashboard ($dsid: ObjectId!) {
-	resultStatus: clearDefaultDashboard(dsid: $dsid) {
-		... ResultStatus
-	}
-}
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+// GetResultStatus returns setDefaultDashboardResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *setDefaultDashboardResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
+// setMonitorsForChannelResponse is returned by setMonitorsForChannel on success.
+type setMonitorsForChannelResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
 }
-`
 
-func clearDefaultDashboard(
-	ctx context.Context,
-	client graphql.Client,
-	dsid string,
-) (*clearDefaultDashboardResponse, error) {
-	req := &graphql.Request{
-		OpName: "clearDefaultDashboard",
-		Query:  clearDefaultDashboard_Operation,
-		Variables: &__clearDefaultDashboardInput{
-			Dsid: dsid,
-		},
-	}
-	var err error
+// GetResultStatus returns setMonitorsForChannelResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *setMonitorsForChannelResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
-	var data clearDefaultDashboardResponse
-	resp := &graphql.Response{Data: &data}
+// setRbacDefaultGroupResponse is returned by setRbacDefaultGroup on success.
+type setRbacDefaultGroupResponse struct {
+	// Set the default group that users will be assigned to. Must currently be one
+	// of the pre-defined "reader", "writer", or "admin" groups!
+	ResultStatus ResultStatus `json:"resultStatus"`
+}
 
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
+// GetResultStatus returns setRbacDefaultGroupResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *setRbacDefaultGroupResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
 
-	return &data, err
+// unsetRbacDefaultGroupResponse is returned by unsetRbacDefaultGroup on success.
+type unsetRbacDefaultGroupResponse struct {
+	ResultStatus ResultStatus `json:"resultStatus"`
 }
 
-// The query or mutation executed by createApp.
-const createApp_Operation = `
-mutation createApp ($workspaceId: ObjectId!, $config: AppInput!) {
-	app: createApp(workspaceId: $workspaceId, app: $config) {
-		... App
-	}
+// GetResultStatus returns unsetRbacDefaultGroupResponse.ResultStatus, and is useful for accessing the field via an interface.
+func (v *unsetRbacDefaultGroupResponse) GetResultStatus() ResultStatus { return v.ResultStatus }
+
+// updateApiTokenResponse is returned by updateApiToken on success.
+type updateApiTokenResponse struct {
+	ApiToken ApiToken `json:"apiToken"`
 }
-fragment App on App {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	folderId
-	config {
-		moduleId
-		version
-	}
-	status {
-		state
-		internalError
-	}
-	outputs
+
+// GetApiToken returns updateApiTokenResponse.ApiToken, and is useful for accessing the field via an interface.
+func (v *updateApiTokenResponse) GetApiToken() ApiToken { return v.ApiToken }
+
+// updateAppDataSourceResponse is returned by updateAppDataSource on success.
+type updateAppDataSourceResponse struct {
+	Appdatasource AppDataSource `json:"appdatasource"`
 }
-`
 
-func createApp(
-	ctx context.Context,
-	client graphql.Client,
-	workspaceId string,
-	config AppInput,
-) (*createAppResponse, error) {
-	req := &graphql.Request{
-		OpName: "createApp",
-		Query:  createApp_Operation,
-		Variables: &__createAppInput{
-			WorkspaceId: workspaceId,
-			Config:      config,
-		},
-	}
-	var err error
+// GetAppdatasource returns updateAppDataSourceResponse.Appdatasource, and is useful for accessing the field via an interface.
+func (v *updateAppDataSourceResponse) GetAppdatasource() AppDataSource { return v.Appdatasource }
 
-	var data createAppResponse
-	resp := &graphql.Response{Data: &data}
+// updateAppResponse is returned by updateApp on success.
+type updateAppResponse struct {
+	App App `json:"app"`
+}
 
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
+// GetApp returns updateAppResponse.App, and is useful for accessing the field via an interface.
+func (v *updateAppResponse) GetApp() App { return v.App }
 
-	return &data, err
+// updateBoardResponse is returned by updateBoard on success.
+type updateBoardResponse struct {
+	Board Board `json:"board"`
 }
 
-// The query or mutation executed by createAppDataSource.
-const createAppDataSource_Operation = `
-mutation createAppDataSource ($config: AppDataSourceInput!) {
-	appdatasource: createAppDataSource(source: $config) {
+// GetBoard returns updateBoardResponse.Board, and is useful for accessing the field via an interface.
+func (v *updateBoardResponse) GetBoard() Board { return v.Board }
+
+// updateChannelActionResponse is returned by updateChannelAction on success.
+type updateChannelActionResponse struct {
+	ChannelAction *ChannelAction `json:"-"`
+}
+
+// GetChannelAction returns updateChannelActionResponse.ChannelAction, and is useful for accessing the field via an interface.
+func (v *updateChannelActionResponse) GetChannelAction() *ChannelAction { return v.ChannelAction }
+
+func (v *updateChannelActionResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateChannelActionResponse
+		ChannelAction json.RawMessage `json:"channelAction"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateChannelActionResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.ChannelAction
+		src := firstPass.ChannelAction
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(ChannelAction)
+			err = __unmarshalChannelAction(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal updateChannelActionResponse.ChannelAction: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalupdateChannelActionResponse struct {
+	ChannelAction json.RawMessage `json:"channelAction"`
+}
+
+func (v *updateChannelActionResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateChannelActionResponse) __premarshalJSON() (*__premarshalupdateChannelActionResponse, error) {
+	var retval __premarshalupdateChannelActionResponse
+
+	{
+
+		dst := &retval.ChannelAction
+		src := v.ChannelAction
+		if src != nil {
+			var err error
+			*dst, err = __marshalChannelAction(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal updateChannelActionResponse.ChannelAction: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// updateChannelResponse is returned by updateChannel on success.
+type updateChannelResponse struct {
+	Channel *Channel `json:"channel"`
+}
+
+// GetChannel returns updateChannelResponse.Channel, and is useful for accessing the field via an interface.
+func (v *updateChannelResponse) GetChannel() *Channel { return v.Channel }
+
+// updateDashboardLinkResponse is returned by updateDashboardLink on success.
+type updateDashboardLinkResponse struct {
+	DashboardLink DashboardLink `json:"dashboardLink"`
+}
+
+// GetDashboardLink returns updateDashboardLinkResponse.DashboardLink, and is useful for accessing the field via an interface.
+func (v *updateDashboardLinkResponse) GetDashboardLink() DashboardLink { return v.DashboardLink }
+
+// updateDataConnectionResponse is returned by updateDataConnection on success.
+type updateDataConnectionResponse struct {
+	DataConnection DataConnection `json:"dataConnection"`
+}
+
+// GetDataConnection returns updateDataConnectionResponse.DataConnection, and is useful for accessing the field via an interface.
+func (v *updateDataConnectionResponse) GetDataConnection() DataConnection { return v.DataConnection }
+
+// updateDatasetOutboundShareResponse is returned by updateDatasetOutboundShare on success.
+type updateDatasetOutboundShareResponse struct {
+	DatasetOutboundShare DatasetOutboundShare `json:"datasetOutboundShare"`
+}
+
+// GetDatasetOutboundShare returns updateDatasetOutboundShareResponse.DatasetOutboundShare, and is useful for accessing the field via an interface.
+func (v *updateDatasetOutboundShareResponse) GetDatasetOutboundShare() DatasetOutboundShare {
+	return v.DatasetOutboundShare
+}
+
+// updateDatasourceResponse is returned by updateDatasource on success.
+type updateDatasourceResponse struct {
+	Datasource Datasource `json:"datasource"`
+}
+
+// GetDatasource returns updateDatasourceResponse.Datasource, and is useful for accessing the field via an interface.
+func (v *updateDatasourceResponse) GetDatasource() Datasource { return v.Datasource }
+
+// updateDatastreamResponse is returned by updateDatastream on success.
+type updateDatastreamResponse struct {
+	Datastream Datastream `json:"datastream"`
+}
+
+// GetDatastream returns updateDatastreamResponse.Datastream, and is useful for accessing the field via an interface.
+func (v *updateDatastreamResponse) GetDatastream() Datastream { return v.Datastream }
+
+// updateDatastreamTokenResponse is returned by updateDatastreamToken on success.
+type updateDatastreamTokenResponse struct {
+	DatastreamToken DatastreamToken `json:"datastreamToken"`
+}
+
+// GetDatastreamToken returns updateDatastreamTokenResponse.DatastreamToken, and is useful for accessing the field via an interface.
+func (v *updateDatastreamTokenResponse) GetDatastreamToken() DatastreamToken {
+	return v.DatastreamToken
+}
+
+// updateDeferredForeignKeyResponse is returned by updateDeferredForeignKey on success.
+type updateDeferredForeignKeyResponse struct {
+	DeferredForeignKey *DeferredForeignKey `json:"deferredForeignKey"`
+}
+
+// GetDeferredForeignKey returns updateDeferredForeignKeyResponse.DeferredForeignKey, and is useful for accessing the field via an interface.
+func (v *updateDeferredForeignKeyResponse) GetDeferredForeignKey() *DeferredForeignKey {
+	return v.DeferredForeignKey
+}
+
+// updateFiledropResponse is returned by updateFiledrop on success.
+type updateFiledropResponse struct {
+	Filedrop *Filedrop `json:"filedrop"`
+}
+
+// GetFiledrop returns updateFiledropResponse.Filedrop, and is useful for accessing the field via an interface.
+func (v *updateFiledropResponse) GetFiledrop() *Filedrop { return v.Filedrop }
+
+// updateFolderResponse is returned by updateFolder on success.
+type updateFolderResponse struct {
+	Folder Folder `json:"folder"`
+}
+
+// GetFolder returns updateFolderResponse.Folder, and is useful for accessing the field via an interface.
+func (v *updateFolderResponse) GetFolder() Folder { return v.Folder }
+
+// updateIncidentResponse is returned by updateIncident on success.
+type updateIncidentResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// GetIncident returns updateIncidentResponse.Incident, and is useful for accessing the field via an interface.
+func (v *updateIncidentResponse) GetIncident() Incident { return v.Incident }
+
+// updateInvestigationNotebookResponse is returned by updateInvestigationNotebook on success.
+type updateInvestigationNotebookResponse struct {
+	InvestigationNotebook InvestigationNotebook `json:"investigationNotebook"`
+}
+
+// GetInvestigationNotebook returns updateInvestigationNotebookResponse.InvestigationNotebook, and is useful for accessing the field via an interface.
+func (v *updateInvestigationNotebookResponse) GetInvestigationNotebook() InvestigationNotebook {
+	return v.InvestigationNotebook
+}
+
+// updateLayeredSettingRecordResponse is returned by updateLayeredSettingRecord on success.
+type updateLayeredSettingRecordResponse struct {
+	LayeredSettingRecord LayeredSettingRecord `json:"layeredSettingRecord"`
+}
+
+// GetLayeredSettingRecord returns updateLayeredSettingRecordResponse.LayeredSettingRecord, and is useful for accessing the field via an interface.
+func (v *updateLayeredSettingRecordResponse) GetLayeredSettingRecord() LayeredSettingRecord {
+	return v.LayeredSettingRecord
+}
+
+// updateMonitorActionAttachmentResponse is returned by updateMonitorActionAttachment on success.
+type updateMonitorActionAttachmentResponse struct {
+	MonitorActionAttachment *MonitorActionAttachment `json:"monitorActionAttachment"`
+}
+
+// GetMonitorActionAttachment returns updateMonitorActionAttachmentResponse.MonitorActionAttachment, and is useful for accessing the field via an interface.
+func (v *updateMonitorActionAttachmentResponse) GetMonitorActionAttachment() *MonitorActionAttachment {
+	return v.MonitorActionAttachment
+}
+
+// updateMonitorActionResponse is returned by updateMonitorAction on success.
+type updateMonitorActionResponse struct {
+	MonitorAction *MonitorAction `json:"-"`
+}
+
+// GetMonitorAction returns updateMonitorActionResponse.MonitorAction, and is useful for accessing the field via an interface.
+func (v *updateMonitorActionResponse) GetMonitorAction() *MonitorAction { return v.MonitorAction }
+
+func (v *updateMonitorActionResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateMonitorActionResponse
+		MonitorAction json.RawMessage `json:"monitorAction"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateMonitorActionResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.MonitorAction
+		src := firstPass.MonitorAction
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(MonitorAction)
+			err = __unmarshalMonitorAction(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal updateMonitorActionResponse.MonitorAction: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalupdateMonitorActionResponse struct {
+	MonitorAction json.RawMessage `json:"monitorAction"`
+}
+
+func (v *updateMonitorActionResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateMonitorActionResponse) __premarshalJSON() (*__premarshalupdateMonitorActionResponse, error) {
+	var retval __premarshalupdateMonitorActionResponse
+
+	{
+
+		dst := &retval.MonitorAction
+		src := v.MonitorAction
+		if src != nil {
+			var err error
+			*dst, err = __marshalMonitorAction(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal updateMonitorActionResponse.MonitorAction: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// updateMonitorMonitorMonitorUpdateResult includes the requested fields of the GraphQL type MonitorUpdateResult.
+type updateMonitorMonitorMonitorUpdateResult struct {
+	Monitor Monitor `json:"monitor"`
+}
+
+// GetMonitor returns updateMonitorMonitorMonitorUpdateResult.Monitor, and is useful for accessing the field via an interface.
+func (v *updateMonitorMonitorMonitorUpdateResult) GetMonitor() Monitor { return v.Monitor }
+
+// updateMonitorMuteRuleForMonitorsResponse is returned by updateMonitorMuteRuleForMonitors on success.
+type updateMonitorMuteRuleForMonitorsResponse struct {
+	MonitorMuteRule MonitorMuteRule `json:"monitorMuteRule"`
+}
+
+// GetMonitorMuteRule returns updateMonitorMuteRuleForMonitorsResponse.MonitorMuteRule, and is useful for accessing the field via an interface.
+func (v *updateMonitorMuteRuleForMonitorsResponse) GetMonitorMuteRule() MonitorMuteRule {
+	return v.MonitorMuteRule
+}
+
+// updateMonitorMuteRuleResponse is returned by updateMonitorMuteRule on success.
+type updateMonitorMuteRuleResponse struct {
+	MonitorMuteRule MonitorMuteRule `json:"monitorMuteRule"`
+}
+
+// GetMonitorMuteRule returns updateMonitorMuteRuleResponse.MonitorMuteRule, and is useful for accessing the field via an interface.
+func (v *updateMonitorMuteRuleResponse) GetMonitorMuteRule() MonitorMuteRule {
+	return v.MonitorMuteRule
+}
+
+// updateMonitorResponse is returned by updateMonitor on success.
+type updateMonitorResponse struct {
+	Monitor *updateMonitorMonitorMonitorUpdateResult `json:"monitor"`
+}
+
+// GetMonitor returns updateMonitorResponse.Monitor, and is useful for accessing the field via an interface.
+func (v *updateMonitorResponse) GetMonitor() *updateMonitorMonitorMonitorUpdateResult {
+	return v.Monitor
+}
+
+// updateMonitorV2ActionResponse is returned by updateMonitorV2Action on success.
+type updateMonitorV2ActionResponse struct {
+	MonitorV2Action MonitorV2Action `json:"monitorV2Action"`
+}
+
+// GetMonitorV2Action returns updateMonitorV2ActionResponse.MonitorV2Action, and is useful for accessing the field via an interface.
+func (v *updateMonitorV2ActionResponse) GetMonitorV2Action() MonitorV2Action {
+	return v.MonitorV2Action
+}
+
+// updateMonitorV2MuteRuleResponse is returned by updateMonitorV2MuteRule on success.
+type updateMonitorV2MuteRuleResponse struct {
+	MonitorV2MuteRule MonitorV2MuteRule `json:"monitorV2MuteRule"`
+}
+
+// GetMonitorV2MuteRule returns updateMonitorV2MuteRuleResponse.MonitorV2MuteRule, and is useful for accessing the field via an interface.
+func (v *updateMonitorV2MuteRuleResponse) GetMonitorV2MuteRule() MonitorV2MuteRule {
+	return v.MonitorV2MuteRule
+}
+
+// updateMonitorV2Response is returned by updateMonitorV2 on success.
+type updateMonitorV2Response struct {
+	MonitorV2 MonitorV2 `json:"monitorV2"`
+}
+
+// GetMonitorV2 returns updateMonitorV2Response.MonitorV2, and is useful for accessing the field via an interface.
+func (v *updateMonitorV2Response) GetMonitorV2() MonitorV2 { return v.MonitorV2 }
+
+// updatePollerResponse is returned by updatePoller on success.
+type updatePollerResponse struct {
+	Poller Poller `json:"poller"`
+}
+
+// GetPoller returns updatePollerResponse.Poller, and is useful for accessing the field via an interface.
+func (v *updatePollerResponse) GetPoller() Poller { return v.Poller }
+
+// updatePreferredPathResponse is returned by updatePreferredPath on success.
+type updatePreferredPathResponse struct {
+	// Update a preferred path. When 'acceptInErrorState" is true, the API will accept
+	// a save/update for a path, even if it means that the path will be left in error
+	// state, else an error will be returned.
+	PreferredPathWithStatus PreferredPathWithStatus `json:"preferredPathWithStatus"`
+}
+
+// GetPreferredPathWithStatus returns updatePreferredPathResponse.PreferredPathWithStatus, and is useful for accessing the field via an interface.
+func (v *updatePreferredPathResponse) GetPreferredPathWithStatus() PreferredPathWithStatus {
+	return v.PreferredPathWithStatus
+}
+
+// updateRbacGroupResponse is returned by updateRbacGroup on success.
+type updateRbacGroupResponse struct {
+	RbacGroup RbacGroup `json:"rbacGroup"`
+}
+
+// GetRbacGroup returns updateRbacGroupResponse.RbacGroup, and is useful for accessing the field via an interface.
+func (v *updateRbacGroupResponse) GetRbacGroup() RbacGroup { return v.RbacGroup }
+
+// updateRbacGroupmemberResponse is returned by updateRbacGroupmember on success.
+type updateRbacGroupmemberResponse struct {
+	RbacGroupmember RbacGroupmember `json:"rbacGroupmember"`
+}
+
+// GetRbacGroupmember returns updateRbacGroupmemberResponse.RbacGroupmember, and is useful for accessing the field via an interface.
+func (v *updateRbacGroupmemberResponse) GetRbacGroupmember() RbacGroupmember {
+	return v.RbacGroupmember
+}
+
+// updateRbacStatementResponse is returned by updateRbacStatement on success.
+type updateRbacStatementResponse struct {
+	RbacStatement RbacStatement `json:"rbacStatement"`
+}
+
+// GetRbacStatement returns updateRbacStatementResponse.RbacStatement, and is useful for accessing the field via an interface.
+func (v *updateRbacStatementResponse) GetRbacStatement() RbacStatement { return v.RbacStatement }
+
+// updateReferenceTableResponse is returned by updateReferenceTable on success.
+type updateReferenceTableResponse struct {
+	ReferenceTable ReferenceTable `json:"referenceTable"`
+}
+
+// GetReferenceTable returns updateReferenceTableResponse.ReferenceTable, and is useful for accessing the field via an interface.
+func (v *updateReferenceTableResponse) GetReferenceTable() ReferenceTable { return v.ReferenceTable }
+
+// updateSnowflakeOutboundShareResponse is returned by updateSnowflakeOutboundShare on success.
+type updateSnowflakeOutboundShareResponse struct {
+	Share SnowflakeOutboundShare `json:"share"`
+}
+
+// GetShare returns updateSnowflakeOutboundShareResponse.Share, and is useful for accessing the field via an interface.
+func (v *updateSnowflakeOutboundShareResponse) GetShare() SnowflakeOutboundShare { return v.Share }
+
+// updateWorkspaceResponse is returned by updateWorkspace on success.
+type updateWorkspaceResponse struct {
+	Workspace *Workspace `json:"workspace"`
+}
+
+// GetWorkspace returns updateWorkspaceResponse.Workspace, and is useful for accessing the field via an interface.
+func (v *updateWorkspaceResponse) GetWorkspace() *Workspace { return v.Workspace }
+
+// The query or mutation executed by addCorrelationTag.
+const addCorrelationTag_Operation = `
+mutation addCorrelationTag ($datasetId: ObjectId!, $path: LinkFieldInput!, $tag: String!) {
+	resultStatus: addCorrelationTag(datasetId: $datasetId, path: $path, tag: $tag) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func addCorrelationTag(
+	ctx context.Context,
+	client graphql.Client,
+	datasetId string,
+	path LinkFieldInput,
+	tag string,
+) (*addCorrelationTagResponse, error) {
+	req := &graphql.Request{
+		OpName: "addCorrelationTag",
+		Query:  addCorrelationTag_Operation,
+		Variables: &__addCorrelationTagInput{
+			DatasetId: datasetId,
+			Path:      path,
+			Tag:       tag,
+		},
+	}
+	var err error
+
+	var data addCorrelationTagResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by addIncidentDashboards.
+const addIncidentDashboards_Operation = `
+mutation addIncidentDashboards ($id: ObjectId!, $dashboards: [ObjectId!]!) {
+	incident: addIncidentDashboards(i: $id, ds: $dashboards) {
+		... Incident
+	}
+}
+fragment Incident on Incident {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
+}
+`
+
+func addIncidentDashboards(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	dashboards []string,
+) (*addIncidentDashboardsResponse, error) {
+	req := &graphql.Request{
+		OpName: "addIncidentDashboards",
+		Query:  addIncidentDashboards_Operation,
+		Variables: &__addIncidentDashboardsInput{
+			Id:         id,
+			Dashboards: dashboards,
+		},
+	}
+	var err error
+
+	var data addIncidentDashboardsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by addIncidentSlackChannels.
+const addIncidentSlackChannels_Operation = `
+mutation addIncidentSlackChannels ($id: ObjectId!, $channels: [IncidentSlackchannelInput!]!) {
+	incident: addIncidentSlackchannels(i: $id, cs: $channels) {
+		... Incident
+	}
+}
+fragment Incident on Incident {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
+}
+`
+
+func addIncidentSlackChannels(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	channels []IncidentSlackchannelInput,
+) (*addIncidentSlackChannelsResponse, error) {
+	req := &graphql.Request{
+		OpName: "addIncidentSlackChannels",
+		Query:  addIncidentSlackChannels_Operation,
+		Variables: &__addIncidentSlackChannelsInput{
+			Id:       id,
+			Channels: channels,
+		},
+	}
+	var err error
+
+	var data addIncidentSlackChannelsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by addIncidentUsers.
+const addIncidentUsers_Operation = `
+mutation addIncidentUsers ($id: ObjectId!, $users: [UserId!]!) {
+	incident: addIncidentUsers(i: $id, us: $users) {
+		... Incident
+	}
+}
+fragment Incident on Incident {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
+}
+`
+
+func addIncidentUsers(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	users []types.UserIdScalar,
+) (*addIncidentUsersResponse, error) {
+	req := &graphql.Request{
+		OpName: "addIncidentUsers",
+		Query:  addIncidentUsers_Operation,
+		Variables: &__addIncidentUsersInput{
+			Id:    id,
+			Users: users,
+		},
+	}
+	var err error
+
+	var data addIncidentUsersResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by addIncidentWorksheets.
+const addIncidentWorksheets_Operation = `
+mutation addIncidentWorksheets ($id: ObjectId!, $worksheets: [ObjectId!]!) {
+	incident: addIncidentWorksheets(i: $id, ws: $worksheets) {
+		... Incident
+	}
+}
+fragment Incident on Incident {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
+}
+`
+
+func addIncidentWorksheets(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	worksheets []string,
+) (*addIncidentWorksheetsResponse, error) {
+	req := &graphql.Request{
+		OpName: "addIncidentWorksheets",
+		Query:  addIncidentWorksheets_Operation,
+		Variables: &__addIncidentWorksheetsInput{
+			Id:         id,
+			Worksheets: worksheets,
+		},
+	}
+	var err error
+
+	var data addIncidentWorksheetsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by cancelAccelerationJob.
+const cancelAccelerationJob_Operation = `
+mutation cancelAccelerationJob ($jobId: String!) {
+	job: cancelAccelerationJob(jobId: $jobId) {
+		... AccelerationJob
+	}
+}
+fragment AccelerationJob on AccelerationJob {
+	jobId
+	context
+	createdDate
+	state
+	stateLastUpdatedDate
+	progress
+	credits
+	datasetStatuses {
+		datasetId
+		isDirect
+		progress
+		credits
+	}
+}
+`
+
+func cancelAccelerationJob(
+	ctx context.Context,
+	client graphql.Client,
+	jobId string,
+) (*cancelAccelerationJobResponse, error) {
+	req := &graphql.Request{
+		OpName: "cancelAccelerationJob",
+		Query:  cancelAccelerationJob_Operation,
+		Variables: &__cancelAccelerationJobInput{
+			JobId: jobId,
+		},
+	}
+	var err error
+
+	var data cancelAccelerationJobResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by checkQueries.
+const checkQueries_Operation = `
+query checkQueries ($queries: MultiStageQueryInput!) {
+	compilationResults: checkQueries(queries: $queries) {
+		parsedPipeline {
+			... ParsedPipeline
+		}
+	}
+}
+fragment ParsedPipeline on ParsedPipeline {
+	errors {
+		... PipelineSymbol
+	}
+	warnings {
+		symbol {
+			... PipelineSymbol
+		}
+	}
+	previousStageErrors {
+		stageId
+		symbol {
+			... PipelineSymbol
+		}
+	}
+}
+fragment PipelineSymbol on PipelineSymbol {
+	comment
+	span {
+		start {
+			row
+			col
+		}
+	}
+}
+`
+
+func checkQueries(
+	ctx context.Context,
+	client graphql.Client,
+	queries MultiStageQueryInput,
+) (*checkQueriesResponse, error) {
+	req := &graphql.Request{
+		OpName: "checkQueries",
+		Query:  checkQueries_Operation,
+		Variables: &__checkQueriesInput{
+			Queries: queries,
+		},
+	}
+	var err error
+
+	var data checkQueriesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by clearDefaultDashboard.
+const clearDefaultDashboard_Operation = `
+mutation clearDefaultDashboard ($dsid: ObjectId!) {
+	resultStatus: clearDefaultDashboard(dsid: $dsid) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func clearDefaultDashboard(
+	ctx context.Context,
+	client graphql.Client,
+	dsid string,
+) (*clearDefaultDashboardResponse, error) {
+	req := &graphql.Request{
+		OpName: "clearDefaultDashboard",
+		Query:  clearDefaultDashboard_Operation,
+		Variables: &__clearDefaultDashboardInput{
+			Dsid: dsid,
+		},
+	}
+	var err error
+
+	var data clearDefaultDashboardResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createAccelerationJob.
+const createAccelerationJob_Operation = `
+mutation createAccelerationJob ($job: AccelerationJobInput!) {
+	job: createAccelerationJob(job: $job) {
+		... AccelerationJob
+	}
+}
+fragment AccelerationJob on AccelerationJob {
+	jobId
+	context
+	createdDate
+	state
+	stateLastUpdatedDate
+	progress
+	credits
+	datasetStatuses {
+		datasetId
+		isDirect
+		progress
+		credits
+	}
+}
+`
+
+func createAccelerationJob(
+	ctx context.Context,
+	client graphql.Client,
+	job AccelerationJobInput,
+) (*createAccelerationJobResponse, error) {
+	req := &graphql.Request{
+		OpName: "createAccelerationJob",
+		Query:  createAccelerationJob_Operation,
+		Variables: &__createAccelerationJobInput{
+			Job: job,
+		},
+	}
+	var err error
+
+	var data createAccelerationJobResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createApiToken.
+const createApiToken_Operation = `
+mutation createApiToken ($input: AuthtokenInput!, $owningUser: UserId) {
+	result: createAuthtoken(input: $input, kind: Api, owningUser: $owningUser) {
+		apiToken: authtoken {
+			... ApiToken
+		}
+		secret
+	}
+}
+fragment ApiToken on Authtoken {
+	id
+	name
+	description
+	disabled
+	expiration
+	extensionSeconds
+	kind
+	user
+	createdDate
+	updatedDate
+}
+`
+
+func createApiToken(
+	ctx context.Context,
+	client graphql.Client,
+	input AuthtokenInput,
+	owningUser *types.UserIdScalar,
+) (*createApiTokenResponse, error) {
+	req := &graphql.Request{
+		OpName: "createApiToken",
+		Query:  createApiToken_Operation,
+		Variables: &__createApiTokenInput{
+			Input:      input,
+			OwningUser: owningUser,
+		},
+	}
+	var err error
+
+	var data createApiTokenResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createApp.
+const createApp_Operation = `
+mutation createApp ($workspaceId: ObjectId!, $config: AppInput!) {
+	app: createApp(workspaceId: $workspaceId, app: $config) {
+		... App
+	}
+}
+fragment App on App {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	folderId
+	config {
+		moduleId
+		version
+	}
+	status {
+		state
+		internalError
+	}
+	outputs
+}
+`
+
+func createApp(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	config AppInput,
+) (*createAppResponse, error) {
+	req := &graphql.Request{
+		OpName: "createApp",
+		Query:  createApp_Operation,
+		Variables: &__createAppInput{
+			WorkspaceId: workspaceId,
+			Config:      config,
+		},
+	}
+	var err error
+
+	var data createAppResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createAppDataSource.
+const createAppDataSource_Operation = `
+mutation createAppDataSource ($config: AppDataSourceInput!) {
+	appdatasource: createAppDataSource(source: $config) {
 		... AppDataSource
 	}
 }
-fragment AppDataSource on AppDataSource {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	managedById
-	sourceUrl
-	instructions
+fragment AppDataSource on AppDataSource {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	managedById
+	sourceUrl
+	instructions
+}
+`
+
+func createAppDataSource(
+	ctx context.Context,
+	client graphql.Client,
+	config AppDataSourceInput,
+) (*createAppDataSourceResponse, error) {
+	req := &graphql.Request{
+		OpName: "createAppDataSource",
+		Query:  createAppDataSource_Operation,
+		Variables: &__createAppDataSourceInput{
+			Config: config,
+		},
+	}
+	var err error
+
+	var data createAppDataSourceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createBoard.
+const createBoard_Operation = `
+mutation createBoard ($datasetId: ObjectId!, $boardType: BoardType!, $board: BoardInput!) {
+	board: createBoard(datasetId: $datasetId, type: $boardType, board: $board) {
+		... Board
+	}
+}
+fragment Board on Board {
+	id
+	datasetId
+	name
+	type
+	boardJson: board
+	source
+}
+`
+
+func createBoard(
+	ctx context.Context,
+	client graphql.Client,
+	datasetId string,
+	boardType BoardType,
+	board BoardInput,
+) (*createBoardResponse, error) {
+	req := &graphql.Request{
+		OpName: "createBoard",
+		Query:  createBoard_Operation,
+		Variables: &__createBoardInput{
+			DatasetId: datasetId,
+			BoardType: boardType,
+			Board:     board,
+		},
+	}
+	var err error
+
+	var data createBoardResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createChannel.
+const createChannel_Operation = `
+mutation createChannel ($workspaceId: ObjectId!, $channel: ChannelInput!) {
+	channel: createChannel(workspaceId: $workspaceId, channel: $channel) {
+		... Channel
+	}
+}
+fragment Channel on Channel {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	monitors {
+		id
+	}
+}
+`
+
+func createChannel(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	channel ChannelInput,
+) (*createChannelResponse, error) {
+	req := &graphql.Request{
+		OpName: "createChannel",
+		Query:  createChannel_Operation,
+		Variables: &__createChannelInput{
+			WorkspaceId: workspaceId,
+			Channel:     channel,
+		},
+	}
+	var err error
+
+	var data createChannelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createChannelAction.
+const createChannelAction_Operation = `
+mutation createChannelAction ($workspaceId: ObjectId!, $action: ActionInput!) {
+	channelAction: createChannelAction(workspaceId: $workspaceId, action: $action) {
+		__typename
+		... ChannelAction
+	}
+}
+fragment ChannelAction on ChannelAction {
+	id
+	name
+	iconUrl
+	description
+	notifyOnClose
+	workspaceId
+	channels {
+		id
+	}
+	rateLimit
+	__typename
+	... on WebhookAction {
+		urlTemplate
+		bodyTemplate
+		method
+		headers {
+			header
+			valueTemplate
+		}
+	}
+	... on EmailAction {
+		targetAddresses
+		subjectTemplate
+		bodyTemplate
+		isHtml
+	}
+}
+`
+
+func createChannelAction(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	action ActionInput,
+) (*createChannelActionResponse, error) {
+	req := &graphql.Request{
+		OpName: "createChannelAction",
+		Query:  createChannelAction_Operation,
+		Variables: &__createChannelActionInput{
+			WorkspaceId: workspaceId,
+			Action:      action,
+		},
+	}
+	var err error
+
+	var data createChannelActionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createDashboardLink.
+const createDashboardLink_Operation = `
+mutation createDashboardLink ($input: DashboardLinkInput!) {
+	dashboardLink: createDashboardLink(link: $input) {
+		... DashboardLink
+	}
+}
+fragment DashboardLink on DashboardLink {
+	id
+	name
+	workspaceId
+	folderId
+	managedById
+	description
+	fromDashboard: fromDashboardId
+	toDashboard: toDashboardId
+	fromCard
+	linkLabel
+}
+`
+
+func createDashboardLink(
+	ctx context.Context,
+	client graphql.Client,
+	input DashboardLinkInput,
+) (*createDashboardLinkResponse, error) {
+	req := &graphql.Request{
+		OpName: "createDashboardLink",
+		Query:  createDashboardLink_Operation,
+		Variables: &__createDashboardLinkInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createDashboardLinkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createDataConnection.
+const createDataConnection_Operation = `
+mutation createDataConnection ($workspaceId: ObjectId!, $input: DataConnectionInput!) {
+	dataConnection: createDataConnection(workspaceId: $workspaceId, input: $input) {
+		... DataConnection
+	}
+}
+fragment DataConnection on DataConnection {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	moduleID
+	version
+	variables {
+		... DataVariable
+	}
+	outputs {
+		name
+		target
+	}
+}
+fragment DataVariable on DataVariable {
+	name
+	value
+	sensitive
+}
+`
+
+func createDataConnection(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	input DataConnectionInput,
+) (*createDataConnectionResponse, error) {
+	req := &graphql.Request{
+		OpName: "createDataConnection",
+		Query:  createDataConnection_Operation,
+		Variables: &__createDataConnectionInput{
+			WorkspaceId: workspaceId,
+			Input:       input,
+		},
+	}
+	var err error
+
+	var data createDataConnectionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createDatasetOutboundShare.
+const createDatasetOutboundShare_Operation = `
+mutation createDatasetOutboundShare ($workspaceId: ObjectId!, $datasetID: ObjectId!, $outboundShareID: ObjectId!, $input: DatasetOutboundShareInput!) {
+	datasetOutboundShare: createDatasetOutboundShare(workspaceId: $workspaceId, datasetID: $datasetID, outboundShareID: $outboundShareID, input: $input) {
+		... DatasetOutboundShare
+	}
+}
+fragment DatasetOutboundShare on DatasetOutboundShare {
+	id
+	name
+	description
+	workspaceId
+	folderId
+	datasetID
+	outboundShareID
+	schemaName
+	viewName
+	freshnessGoal
+	status {
+		state
+		error
+	}
+}
+`
+
+func createDatasetOutboundShare(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	datasetID string,
+	outboundShareID string,
+	input DatasetOutboundShareInput,
+) (*createDatasetOutboundShareResponse, error) {
+	req := &graphql.Request{
+		OpName: "createDatasetOutboundShare",
+		Query:  createDatasetOutboundShare_Operation,
+		Variables: &__createDatasetOutboundShareInput{
+			WorkspaceId:     workspaceId,
+			DatasetID:       datasetID,
+			OutboundShareID: outboundShareID,
+			Input:           input,
+		},
+	}
+	var err error
+
+	var data createDatasetOutboundShareResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createDatasource.
+const createDatasource_Operation = `
+mutation createDatasource ($workspaceId: ObjectId!, $input: DatasourceInput!) {
+	datasource: createDatasource(workspaceId: $workspaceId, input: $input) {
+		... Datasource
+	}
+}
+fragment Datasource on Datasource {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	dataConnectionID
+	datastreamID
+	datastreamTokenID
+	type
+	status {
+		state
+	}
+	variables {
+		... DataVariable
+	}
+	clientStackAttributes {
+		... DataVariable
+	}
+}
+fragment DataVariable on DataVariable {
+	name
+	value
+	sensitive
+}
+`
+
+func createDatasource(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	input DatasourceInput,
+) (*createDatasourceResponse, error) {
+	req := &graphql.Request{
+		OpName: "createDatasource",
+		Query:  createDatasource_Operation,
+		Variables: &__createDatasourceInput{
+			WorkspaceId: workspaceId,
+			Input:       input,
+		},
+	}
+	var err error
+
+	var data createDatasourceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createDatastream.
+const createDatastream_Operation = `
+mutation createDatastream ($workspaceId: ObjectId!, $datastream: DatastreamInput!) {
+	datastream: createDatastream(workspaceId: $workspaceId, datastream: $datastream) {
+		... Datastream
+	}
+}
+fragment Datastream on Datastream {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	datasetId
+}
+`
+
+func createDatastream(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	datastream DatastreamInput,
+) (*createDatastreamResponse, error) {
+	req := &graphql.Request{
+		OpName: "createDatastream",
+		Query:  createDatastream_Operation,
+		Variables: &__createDatastreamInput{
+			WorkspaceId: workspaceId,
+			Datastream:  datastream,
+		},
+	}
+	var err error
+
+	var data createDatastreamResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createDatastreamToken.
+const createDatastreamToken_Operation = `
+mutation createDatastreamToken ($datastreamId: ObjectId!, $token: DatastreamTokenInput!, $password: String) {
+	datastreamToken: createDatastreamToken(datastreamId: $datastreamId, token: $token, password: $password) {
+		... DatastreamToken
+	}
+}
+fragment DatastreamToken on DatastreamToken {
+	id
+	name
+	description
+	disabled
+	datastreamId
+	secret
+}
+`
+
+func createDatastreamToken(
+	ctx context.Context,
+	client graphql.Client,
+	datastreamId string,
+	token DatastreamTokenInput,
+	password *string,
+) (*createDatastreamTokenResponse, error) {
+	req := &graphql.Request{
+		OpName: "createDatastreamToken",
+		Query:  createDatastreamToken_Operation,
+		Variables: &__createDatastreamTokenInput{
+			DatastreamId: datastreamId,
+			Token:        token,
+			Password:     password,
+		},
+	}
+	var err error
+
+	var data createDatastreamTokenResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createDeferredForeignKey.
+const createDeferredForeignKey_Operation = `
+mutation createDeferredForeignKey ($workspaceId: ObjectId!, $keyInput: DeferredForeignKeyInput!) {
+	deferredForeignKey: createDeferredForeignKey(workspaceId: $workspaceId, data: $keyInput) {
+		... DeferredForeignKey
+	}
+}
+fragment DeferredForeignKey on DeferredForeignKey {
+	id
+	workspaceId
+	sourceDataset {
+		datasetId
+	}
+	targetDataset {
+		datasetId
+	}
+	srcFields
+	dstFields
+	label
+	resolution {
+		sourceId
+		targetId
+	}
+	status {
+		errorText
+	}
+}
+`
+
+func createDeferredForeignKey(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	keyInput DeferredForeignKeyInput,
+) (*createDeferredForeignKeyResponse, error) {
+	req := &graphql.Request{
+		OpName: "createDeferredForeignKey",
+		Query:  createDeferredForeignKey_Operation,
+		Variables: &__createDeferredForeignKeyInput{
+			WorkspaceId: workspaceId,
+			KeyInput:    keyInput,
+		},
+	}
+	var err error
+
+	var data createDeferredForeignKeyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createFiledrop.
+const createFiledrop_Operation = `
+mutation createFiledrop ($workspaceID: ObjectId!, $datastreamID: ObjectId!, $input: FiledropInput!) {
+	filedrop: createFiledrop(workspaceId: $workspaceID, datastreamID: $datastreamID, input: $input) {
+		... Filedrop
+	}
+}
+fragment Filedrop on Filedrop {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	status
+	datastreamID
+	config {
+		provider {
+			__typename
+			... on FiledropProviderAwsConfig {
+				region
+				roleArn
+			}
+		}
+	}
+	endpoint {
+		__typename
+		... on FiledropS3Endpoint {
+			arn
+			bucket
+			prefix
+		}
+	}
+}
+`
+
+func createFiledrop(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceID string,
+	datastreamID string,
+	input FiledropInput,
+) (*createFiledropResponse, error) {
+	req := &graphql.Request{
+		OpName: "createFiledrop",
+		Query:  createFiledrop_Operation,
+		Variables: &__createFiledropInput{
+			WorkspaceID:  workspaceID,
+			DatastreamID: datastreamID,
+			Input:        input,
+		},
+	}
+	var err error
+
+	var data createFiledropResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createFolder.
+const createFolder_Operation = `
+mutation createFolder ($workspaceId: ObjectId!, $config: FolderInput!) {
+	folder: createFolder(workspaceId: $workspaceId, folder: $config) {
+		... Folder
+	}
+}
+fragment Folder on Folder {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	effectiveSettings {
+		scanner {
+			powerLevel
+		}
+	}
+}
+`
+
+func createFolder(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	config FolderInput,
+) (*createFolderResponse, error) {
+	req := &graphql.Request{
+		OpName: "createFolder",
+		Query:  createFolder_Operation,
+		Variables: &__createFolderInput{
+			WorkspaceId: workspaceId,
+			Config:      config,
+		},
+	}
+	var err error
+
+	var data createFolderResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createIncident.
+const createIncident_Operation = `
+mutation createIncident ($workspaceId: ObjectId!, $input: IncidentInput!) {
+	incident: createIncident(workspaceId: $workspaceId, input: $input) {
+		... Incident
+	}
+}
+fragment Incident on Incident {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
+}
+`
+
+func createIncident(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	input IncidentInput,
+) (*createIncidentResponse, error) {
+	req := &graphql.Request{
+		OpName: "createIncident",
+		Query:  createIncident_Operation,
+		Variables: &__createIncidentInput{
+			WorkspaceId: workspaceId,
+			Input:       input,
+		},
+	}
+	var err error
+
+	var data createIncidentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createInvestigationNotebook.
+const createInvestigationNotebook_Operation = `
+mutation createInvestigationNotebook ($workspaceId: ObjectId!, $input: InvestigationNotebookInput!) {
+	investigationNotebook: createInvestigationNotebook(workspaceId: $workspaceId, input: $input) {
+		... InvestigationNotebook
+	}
+}
+fragment InvestigationNotebook on InvestigationNotebook {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	incidentID
+	runbook {
+		url
+	}
+	blocks {
+		... NotebookBlock
+	}
+}
+fragment NotebookBlock on NotebookBlock {
+	type
+	id
+	properties {
+		markdown {
+			text
+		}
+		query {
+			description
+			query {
+				outputStage
+				stages {
+					... StageQuery
+				}
+			}
+		}
+		image {
+			base64
+			description
+		}
+		raiseIncident {
+			preview {
+				text
+			}
+			summary
+			severity
+			slack {
+				channelName
+			}
+			teams
+			incidentOwner
+			confirmation
+		}
+		ping {
+			preview {
+				text
+			}
+			user
+			confirmation
+		}
+		ticket {
+			preview {
+				text
+			}
+			name
+			description
+			priority
+			confirmation
+		}
+		o11yPlaceholder {
+			text
+		}
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+`
+
+func createInvestigationNotebook(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	input InvestigationNotebookInput,
+) (*createInvestigationNotebookResponse, error) {
+	req := &graphql.Request{
+		OpName: "createInvestigationNotebook",
+		Query:  createInvestigationNotebook_Operation,
+		Variables: &__createInvestigationNotebookInput{
+			WorkspaceId: workspaceId,
+			Input:       input,
+		},
+	}
+	var err error
+
+	var data createInvestigationNotebookResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createLayeredSettingRecord.
+const createLayeredSettingRecord_Operation = `
+mutation createLayeredSettingRecord ($settingRecord: LayeredSettingRecordInput!) {
+	layeredSettingRecord: createLayeredSettingRecord(settingRecord: $settingRecord) {
+		... LayeredSettingRecord
+	}
+}
+fragment LayeredSettingRecord on LayeredSettingRecord {
+	settingAndTargetScope {
+		... SettingAndTargetScope
+	}
+	value {
+		... PrimitiveValue
+	}
+	id
+	name
+	description
+	iconUrl
+	workspaceId
+	managedById
+	folderId
+}
+fragment SettingAndTargetScope on SettingAndTargetScope {
+	setting
+	target {
+		... LayeredSettingRecordTarget
+	}
+}
+fragment PrimitiveValue on PrimitiveValue {
+	bool
+	float64
+	int64
+	string
+	timestamp
+	duration
+}
+fragment LayeredSettingRecordTarget on LayeredSettingRecordTarget {
+	customerId
+	workspaceId
+	folderId
+	appId
+	monitorId
+	worksheetId
+	dashboardId
+	datasetId
+	datastreamId
+	userId
+}
+`
+
+func createLayeredSettingRecord(
+	ctx context.Context,
+	client graphql.Client,
+	settingRecord LayeredSettingRecordInput,
+) (*createLayeredSettingRecordResponse, error) {
+	req := &graphql.Request{
+		OpName: "createLayeredSettingRecord",
+		Query:  createLayeredSettingRecord_Operation,
+		Variables: &__createLayeredSettingRecordInput{
+			SettingRecord: settingRecord,
+		},
+	}
+	var err error
+
+	var data createLayeredSettingRecordResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createMonitor.
+const createMonitor_Operation = `
+mutation createMonitor ($workspaceId: ObjectId!, $monitor: MonitorInput!) {
+	monitor: createMonitor(workspaceId: $workspaceId, monitor: $monitor) {
+		monitor {
+			... Monitor
+		}
+	}
+}
+fragment Monitor on Monitor {
+	workspaceId
+	id
+	name
+	description
+	comment
+	iconUrl
+	isTemplate
+	disabled
+	freshnessGoal
+	useDefaultFreshness
+	source
+	definition
+	managedById
+	query {
+		outputStage
+		stages {
+			... StageQuery
+		}
+	}
+	rule {
+		__typename
+		sourceColumn
+		groupByGroups {
+			groupName
+			columns
+		}
+		... on MonitorRuleCount {
+			compareFunction
+			compareValues
+			lookbackTime
+		}
+		... on MonitorRuleChange {
+			changeType
+			compareFunction
+			compareValues
+			aggregateFunction
+			lookbackTime
+			baselineTime
+		}
+		... on MonitorRuleFacet {
+			facetFunction
+			facetValues
+			timeFunction
+			timeValue
+			lookbackTime
+		}
+		... on MonitorRuleThreshold {
+			compareFunction
+			compareValues
+			lookbackTime
+			thresholdAggFunction
+		}
+		... on MonitorRulePromote {
+			kindField
+			descriptionField
+			primaryKey
+		}
+		... on MonitorRuleLog {
+			compareFunction
+			compareValues
+			lookbackTime
+			expressionSummary
+			logStageId
+			sourceLogDatasetId
+		}
+	}
+	notificationSpec {
+		merge
+		importance
+		notifyOnReminder
+		reminderFrequency
+		notifyOnClose
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+`
+
+// More workarounds for server-side struggles
+func createMonitor(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	monitor MonitorInput,
+) (*createMonitorResponse, error) {
+	req := &graphql.Request{
+		OpName: "createMonitor",
+		Query:  createMonitor_Operation,
+		Variables: &__createMonitorInput{
+			WorkspaceId: workspaceId,
+			Monitor:     monitor,
+		},
+	}
+	var err error
+
+	var data createMonitorResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createMonitorAction.
+const createMonitorAction_Operation = `
+mutation createMonitorAction ($monitorAction: MonitorActionInput!) {
+	monitorAction: createMonitorAction(input: $monitorAction) {
+		__typename
+		... MonitorAction
+	}
+}
+fragment MonitorAction on MonitorAction {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	rateLimit
+	notifyOnClose
+	isPrivate
+	__typename
+	... on EmailAction {
+		targetAddresses
+		subjectTemplate
+		bodyTemplate
+		isHtml
+	}
+	... on WebhookAction {
+		urlTemplate
+		method
+		headers {
+			header
+			valueTemplate
+		}
+		bodyTemplate
+	}
+}
+`
+
+func createMonitorAction(
+	ctx context.Context,
+	client graphql.Client,
+	monitorAction MonitorActionInput,
+) (*createMonitorActionResponse, error) {
+	req := &graphql.Request{
+		OpName: "createMonitorAction",
+		Query:  createMonitorAction_Operation,
+		Variables: &__createMonitorActionInput{
+			MonitorAction: monitorAction,
+		},
+	}
+	var err error
+
+	var data createMonitorActionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createMonitorActionAttachment.
+const createMonitorActionAttachment_Operation = `
+mutation createMonitorActionAttachment ($monitorActionAttachment: MonitorActionAttachmentInput!) {
+	monitorActionAttachment: createMonitorActionAttachment(input: $monitorActionAttachment) {
+		... MonitorActionAttachment
+	}
+}
+fragment MonitorActionAttachment on MonitorActionAttachment {
+	monitorID
+	actionID
+	id
+	workspaceId
+	name
+	iconUrl
+	description
+}
+`
+
+func createMonitorActionAttachment(
+	ctx context.Context,
+	client graphql.Client,
+	monitorActionAttachment MonitorActionAttachmentInput,
+) (*createMonitorActionAttachmentResponse, error) {
+	req := &graphql.Request{
+		OpName: "createMonitorActionAttachment",
+		Query:  createMonitorActionAttachment_Operation,
+		Variables: &__createMonitorActionAttachmentInput{
+			MonitorActionAttachment: monitorActionAttachment,
+		},
+	}
+	var err error
+
+	var data createMonitorActionAttachmentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createMonitorMuteRule.
+const createMonitorMuteRule_Operation = `
+mutation createMonitorMuteRule ($input: MonitorMuteRuleInput!) {
+	monitorMuteRule: createMonitorMuteRule(input: $input) {
+		... MonitorMuteRule
+	}
+}
+fragment MonitorMuteRule on MonitorMuteRule {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	startDate
+	duration
+	filter {
+		__typename
+		filterType
+		... on MonitorMuteRuleFilterPerColumn {
+			columnID
+			values
+		}
+		... on MonitorMuteRuleFilterPerResource {
+			resourceIds {
+				... MonitorMuteRuleResourceId
+			}
+		}
+	}
+}
+fragment MonitorMuteRuleResourceId on ResourceId {
+	datasetId
+	primaryKeyValue {
+		name
+		value
+	}
+}
+`
+
+func createMonitorMuteRule(
+	ctx context.Context,
+	client graphql.Client,
+	input MonitorMuteRuleInput,
+) (*createMonitorMuteRuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "createMonitorMuteRule",
+		Query:  createMonitorMuteRule_Operation,
+		Variables: &__createMonitorMuteRuleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createMonitorMuteRuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createMonitorMuteRuleForMonitors.
+const createMonitorMuteRuleForMonitors_Operation = `
+mutation createMonitorMuteRuleForMonitors ($input: MonitorMuteRuleInput!, $monitorIds: [ObjectId!]!) {
+	monitorMuteRule: createMonitorMuteRuleForMonitors(input: $input, monitorIds: $monitorIds) {
+		... MonitorMuteRule
+	}
+}
+fragment MonitorMuteRule on MonitorMuteRule {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	startDate
+	duration
+	filter {
+		__typename
+		filterType
+		... on MonitorMuteRuleFilterPerColumn {
+			columnID
+			values
+		}
+		... on MonitorMuteRuleFilterPerResource {
+			resourceIds {
+				... MonitorMuteRuleResourceId
+			}
+		}
+	}
+}
+fragment MonitorMuteRuleResourceId on ResourceId {
+	datasetId
+	primaryKeyValue {
+		name
+		value
+	}
+}
+`
+
+func createMonitorMuteRuleForMonitors(
+	ctx context.Context,
+	client graphql.Client,
+	input MonitorMuteRuleInput,
+	monitorIds []string,
+) (*createMonitorMuteRuleForMonitorsResponse, error) {
+	req := &graphql.Request{
+		OpName: "createMonitorMuteRuleForMonitors",
+		Query:  createMonitorMuteRuleForMonitors_Operation,
+		Variables: &__createMonitorMuteRuleForMonitorsInput{
+			Input:      input,
+			MonitorIds: monitorIds,
+		},
+	}
+	var err error
+
+	var data createMonitorMuteRuleForMonitorsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createMonitorV2.
+const createMonitorV2_Operation = `
+mutation createMonitorV2 ($workspaceId: ObjectId!, $input: MonitorV2Input!) {
+	monitorV2: createMonitorV2(workspaceId: $workspaceId, input: $input) {
+		... MonitorV2
+	}
+}
+fragment MonitorV2 on MonitorV2 {
+	id
+	workspaceId
+	createdBy
+	createdDate
+	name
+	iconUrl
+	description
+	managedById
+	folderId
+	rollupStatus
+	ruleKind
+	definition {
+		... MonitorV2Definition
+	}
+	actionRules {
+		... MonitorV2ActionRule
+	}
+	mutes {
+		... MonitorV2MuteRule
+	}
+}
+fragment MonitorV2Definition on MonitorV2Definition {
+	inputQuery {
+		outputStage
+		stages {
+			... StageQuery
+		}
+	}
+	rules {
+		... MonitorV2Rule
+	}
+	lookbackTime
+	dataStabilizationDelay
+	groupings {
+		... MonitorV2Column
+	}
+	scheduling {
+		... MonitorV2Scheduling
+	}
+}
+fragment MonitorV2ActionRule on MonitorV2ActionRule {
+	actionID
+	levels
+	sendEndNotifications
+	sendRemindersInterval
+}
+fragment MonitorV2MuteRule on MonitorV2MuteRule {
+	id
+	workspaceId
+	name
+	description
+	monitorID
+	schedule {
+		type
+		oneTime {
+			startTime
+			endTime
+		}
+	}
+	validFrom
+	validTo
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+fragment MonitorV2Rule on MonitorV2Rule {
+	level
+	count {
+		... MonitorV2CountRule
+	}
+	threshold {
+		... MonitorV2ThresholdRule
+	}
+	promote {
+		... MonitorV2PromoteRule
+	}
+}
+fragment MonitorV2Column on MonitorV2Column {
+	linkColumn {
+		... MonitorV2LinkColumn
+	}
+	columnPath {
+		... MonitorV2ColumnPath
+	}
+}
+fragment MonitorV2Scheduling on MonitorV2Scheduling {
+	interval {
+		... MonitorV2IntervalSchedule
+	}
+	transform {
+		... MonitorV2TransformSchedule
+	}
+}
+fragment MonitorV2CountRule on MonitorV2CountRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	valueColumnName
+	aggregation
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
+	compareColumns {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
+	name
+	meta {
+		... MonitorV2LinkColumnMeta
+	}
+}
+fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
+	name
+	path
+}
+fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
+	interval
+	randomize
+}
+fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
+	freshnessGoal
+}
+fragment MonitorV2Comparison on MonitorV2Comparison {
+	compareFn
+	compareValue {
+		... PrimitiveValue
+	}
+}
+fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
+	column {
+		... MonitorV2Column
+	}
+	compareValues {
+		... MonitorV2Comparison
+	}
+}
+fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
+	srcFields {
+		... MonitorV2ColumnPath
+	}
+	dstFields
+	targetDataset
+}
+fragment PrimitiveValue on PrimitiveValue {
+	bool
+	float64
+	int64
+	string
+	timestamp
+	duration
+}
+`
+
+func createMonitorV2(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	input MonitorV2Input,
+) (*createMonitorV2Response, error) {
+	req := &graphql.Request{
+		OpName: "createMonitorV2",
+		Query:  createMonitorV2_Operation,
+		Variables: &__createMonitorV2Input{
+			WorkspaceId: workspaceId,
+			Input:       input,
+		},
+	}
+	var err error
+
+	var data createMonitorV2Response
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createMonitorV2Action.
+const createMonitorV2Action_Operation = `
+mutation createMonitorV2Action ($workspaceId: ObjectId!, $input: MonitorV2ActionInput!) {
+	monitorV2Action: createMonitorV2Action(workspaceId: $workspaceId, input: $input) {
+		... MonitorV2Action
+	}
+}
+fragment MonitorV2Action on MonitorV2Action {
+	inline
+	type
+	email {
+		... MonitorV2EmailAction
+	}
+	webhook {
+		... MonitorV2WebhookAction
+	}
+	id
+	workspaceId
+	name
+	iconUrl
+	description
+	createdBy
+	createdDate
+}
+fragment MonitorV2EmailAction on MonitorV2EmailAction {
+	users
+	addresses
+	subject
+	body
+	fragments
+}
+fragment MonitorV2WebhookAction on MonitorV2WebhookAction {
+	headers {
+		... MonitorV2WebhookHeader
+	}
+	body
+	fragments
+	url
+	method
+}
+fragment MonitorV2WebhookHeader on MonitorV2WebhookHeader {
+	header
+	value
+}
+`
+
+func createMonitorV2Action(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	input MonitorV2ActionInput,
+) (*createMonitorV2ActionResponse, error) {
+	req := &graphql.Request{
+		OpName: "createMonitorV2Action",
+		Query:  createMonitorV2Action_Operation,
+		Variables: &__createMonitorV2ActionInput{
+			WorkspaceId: workspaceId,
+			Input:       input,
+		},
+	}
+	var err error
+
+	var data createMonitorV2ActionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createMonitorV2MuteRule.
+const createMonitorV2MuteRule_Operation = `
+mutation createMonitorV2MuteRule ($workspaceId: ObjectId!, $input: MonitorV2MuteRuleInput!) {
+	monitorV2MuteRule: createMonitorV2MuteRule(workspaceId: $workspaceId, input: $input) {
+		... MonitorV2MuteRule
+	}
+}
+fragment MonitorV2MuteRule on MonitorV2MuteRule {
+	id
+	workspaceId
+	name
+	description
+	monitorID
+	schedule {
+		type
+		oneTime {
+			startTime
+			endTime
+		}
+	}
+	validFrom
+	validTo
+}
+`
+
+func createMonitorV2MuteRule(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	input MonitorV2MuteRuleInput,
+) (*createMonitorV2MuteRuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "createMonitorV2MuteRule",
+		Query:  createMonitorV2MuteRule_Operation,
+		Variables: &__createMonitorV2MuteRuleInput{
+			WorkspaceId: workspaceId,
+			Input:       input,
+		},
+	}
+	var err error
+
+	var data createMonitorV2MuteRuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createOrUpdateBookmark.
+const createOrUpdateBookmark_Operation = `
+mutation createOrUpdateBookmark ($id: ObjectId, $bookmark: BookmarkInput!) {
+	bookmark: createOrUpdateBookmark(id: $id, bookmark: $bookmark) {
+		... Bookmark
+	}
+}
+fragment Bookmark on Bookmark {
+	id
+	name
+	iconUrl
+	targetId
+	targetIdKind
+	groupId
+	bookmarkKind
+}
+`
+
+func createOrUpdateBookmark(
+	ctx context.Context,
+	client graphql.Client,
+	id *string,
+	bookmark BookmarkInput,
+) (*createOrUpdateBookmarkResponse, error) {
+	req := &graphql.Request{
+		OpName: "createOrUpdateBookmark",
+		Query:  createOrUpdateBookmark_Operation,
+		Variables: &__createOrUpdateBookmarkInput{
+			Id:       id,
+			Bookmark: bookmark,
+		},
+	}
+	var err error
+
+	var data createOrUpdateBookmarkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createOrUpdateBookmarkGroup.
+const createOrUpdateBookmarkGroup_Operation = `
+mutation createOrUpdateBookmarkGroup ($id: ObjectId, $group: BookmarkGroupInput!) {
+	bookmarkGroup: createOrUpdateBookmarkGroup(id: $id, group: $group) {
+		... BookmarkGroup
+	}
+}
+fragment BookmarkGroup on BookmarkGroup {
+	id
+	name
+	description
+	iconUrl
+	workspaceId
+	isHome
+}
+`
+
+func createOrUpdateBookmarkGroup(
+	ctx context.Context,
+	client graphql.Client,
+	id *string,
+	group BookmarkGroupInput,
+) (*createOrUpdateBookmarkGroupResponse, error) {
+	req := &graphql.Request{
+		OpName: "createOrUpdateBookmarkGroup",
+		Query:  createOrUpdateBookmarkGroup_Operation,
+		Variables: &__createOrUpdateBookmarkGroupInput{
+			Id:    id,
+			Group: group,
+		},
+	}
+	var err error
+
+	var data createOrUpdateBookmarkGroupResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createPoller.
+const createPoller_Operation = `
+mutation createPoller ($workspaceId: ObjectId!, $poller: PollerInput!) {
+	poller: createPoller(workspaceId: $workspaceId, poller: $poller) {
+		... Poller
+	}
+}
+fragment Poller on Poller {
+	id
+	workspaceId
+	customerId
+	datastreamId
+	disabled
+	kind
+	config {
+		__typename
+		name
+		retries
+		interval
+		tags
+		chunk {
+			enabled
+			size
+		}
+		... on PollerPubSubConfig {
+			projectId
+			jsonKey
+			subscriptionId
+		}
+		... on PollerHTTPConfig {
+			method
+			body
+			endpoint
+			contentType
+			headers
+			template {
+				... HttpRequestConfig
+			}
+			requests {
+				... HttpRequestConfig
+			}
+			rules {
+				match {
+					... HttpRequestConfig
+				}
+				follow
+				decoder {
+					type
+				}
+			}
+			timestamps {
+				name
+				source
+				format
+				offset
+				truncate
+			}
+		}
+		... on PollerGCPMonitoringConfig {
+			projectId
+			jsonKey
+			includeMetricTypePrefixes
+			excludeMetricTypePrefixes
+			rateLimit
+			totalLimit
+		}
+		... on PollerMongoDBAtlasConfig {
+			publicKey
+			privateKey
+			includeGroups
+			excludeGroups
+		}
+		... on PollerConfluentCloudConfig {
+			key
+			secret
+		}
+		... on PollerCloudWatchMetricsConfig {
+			period
+			delay
+			region
+			assumeRoleArn
+			queries {
+				namespace
+				metricNames
+				dimensions {
+					name
+					value
+				}
+				resourceFilter {
+					resourceType
+					pattern
+					dimensionName
+					tagFilters {
+						key
+						values
+					}
+				}
+			}
+		}
+		... on PollerAWSSnapshotConfig {
+			region
+			assumeRoleArn
+			includeActions
+		}
+	}
+}
+fragment HttpRequestConfig on PollerHTTPRequestConfig {
+	url
+	method
+	username
+	password
+	authScheme
+	body
+	headers
+	params
+}
+`
+
+func createPoller(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	poller PollerInput,
+) (*createPollerResponse, error) {
+	req := &graphql.Request{
+		OpName: "createPoller",
+		Query:  createPoller_Operation,
+		Variables: &__createPollerInput{
+			WorkspaceId: workspaceId,
+			Poller:      poller,
+		},
+	}
+	var err error
+
+	var data createPollerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createPreferredPath.
+const createPreferredPath_Operation = `
+mutation createPreferredPath ($workspaceId: ObjectId!, $config: PreferredPathInput!) {
+	preferredPathWithStatus: createPreferredPath(workspaceId: $workspaceId, path: $config) {
+		... PreferredPathWithStatus
+	}
+}
+fragment PreferredPathWithStatus on PreferredPathWithStatus {
+	path {
+		... PreferredPath
+	}
+	error
+}
+fragment PreferredPath on PreferredPath {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	folderId
+	sourceDataset
+	path {
+		linkId
+		reverse
+		linkName
+		reverseFromDataset
+	}
+}
+`
+
+// More workarounds for server-side struggles
+func createPreferredPath(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	config PreferredPathInput,
+) (*createPreferredPathResponse, error) {
+	req := &graphql.Request{
+		OpName: "createPreferredPath",
+		Query:  createPreferredPath_Operation,
+		Variables: &__createPreferredPathInput{
+			WorkspaceId: workspaceId,
+			Config:      config,
+		},
+	}
+	var err error
+
+	var data createPreferredPathResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createRbacGroup.
+const createRbacGroup_Operation = `
+mutation createRbacGroup ($config: RbacGroupInput!) {
+	rbacGroup: createRbacGroup(input: $config) {
+		... RbacGroup
+	}
+}
+fragment RbacGroup on RbacGroup {
+	id
+	name
+	description
+}
+`
+
+func createRbacGroup(
+	ctx context.Context,
+	client graphql.Client,
+	config RbacGroupInput,
+) (*createRbacGroupResponse, error) {
+	req := &graphql.Request{
+		OpName: "createRbacGroup",
+		Query:  createRbacGroup_Operation,
+		Variables: &__createRbacGroupInput{
+			Config: config,
+		},
+	}
+	var err error
+
+	var data createRbacGroupResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createRbacGroupmember.
+const createRbacGroupmember_Operation = `
+mutation createRbacGroupmember ($config: RbacGroupmemberInput!) {
+	rbacGroupmember: createRbacGroupmember(input: $config) {
+		... RbacGroupmember
+	}
+}
+fragment RbacGroupmember on RbacGroupmember {
+	id
+	description
+	groupId
+	memberUserId
+	memberGroupId
+}
+`
+
+func createRbacGroupmember(
+	ctx context.Context,
+	client graphql.Client,
+	config RbacGroupmemberInput,
+) (*createRbacGroupmemberResponse, error) {
+	req := &graphql.Request{
+		OpName: "createRbacGroupmember",
+		Query:  createRbacGroupmember_Operation,
+		Variables: &__createRbacGroupmemberInput{
+			Config: config,
+		},
+	}
+	var err error
+
+	var data createRbacGroupmemberResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createRbacStatement.
+const createRbacStatement_Operation = `
+mutation createRbacStatement ($config: RbacStatementInput!) {
+	rbacStatement: createRbacStatement(input: $config) {
+		... RbacStatement
+	}
+}
+fragment RbacStatement on RbacStatement {
+	id
+	description
+	subject {
+		userId
+		groupId
+		all
+	}
+	object {
+		objectId
+		folderId
+		workspaceId
+		type
+		name
+		owner
+		all
+	}
+	role
+	version
+}
+`
+
+func createRbacStatement(
+	ctx context.Context,
+	client graphql.Client,
+	config RbacStatementInput,
+) (*createRbacStatementResponse, error) {
+	req := &graphql.Request{
+		OpName: "createRbacStatement",
+		Query:  createRbacStatement_Operation,
+		Variables: &__createRbacStatementInput{
+			Config: config,
+		},
+	}
+	var err error
+
+	var data createRbacStatementResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createReferenceTable.
+const createReferenceTable_Operation = `
+mutation createReferenceTable ($workspaceId: ObjectId!, $input: ReferenceTableInput!) {
+	referenceTable: createReferenceTable(workspaceId: $workspaceId, input: $input) {
+		... ReferenceTable
+	}
+}
+fragment ReferenceTable on ReferenceTable {
+	id
+	workspaceId
+	name
+	iconUrl
+	description
+	datasetID
+	managedById
+}
+`
+
+func createReferenceTable(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	input ReferenceTableInput,
+) (*createReferenceTableResponse, error) {
+	req := &graphql.Request{
+		OpName: "createReferenceTable",
+		Query:  createReferenceTable_Operation,
+		Variables: &__createReferenceTableInput{
+			WorkspaceId: workspaceId,
+			Input:       input,
+		},
+	}
+	var err error
+
+	var data createReferenceTableResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createSnowflakeOutboundShare.
+const createSnowflakeOutboundShare_Operation = `
+mutation createSnowflakeOutboundShare ($workspaceId: ObjectId!, $input: SnowflakeOutboundShareInput!) {
+	share: createSnowflakeOutboundShare(workspaceId: $workspaceId, input: $input) {
+		... SnowflakeOutboundShare
+	}
+}
+fragment SnowflakeOutboundShare on SnowflakeOutboundShare {
+	id
+	name
+	description
+	workspaceId
+	shareName
+	accounts {
+		... SnowflakeAccount
+	}
+}
+fragment SnowflakeAccount on SnowflakeAccount {
+	organization
+	account
+}
+`
+
+func createSnowflakeOutboundShare(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	input SnowflakeOutboundShareInput,
+) (*createSnowflakeOutboundShareResponse, error) {
+	req := &graphql.Request{
+		OpName: "createSnowflakeOutboundShare",
+		Query:  createSnowflakeOutboundShare_Operation,
+		Variables: &__createSnowflakeOutboundShareInput{
+			WorkspaceId: workspaceId,
+			Input:       input,
+		},
+	}
+	var err error
+
+	var data createSnowflakeOutboundShareResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by createWorkspace.
+const createWorkspace_Operation = `
+mutation createWorkspace ($config: WorkspaceInput!) {
+	workspace: createWorkspace(definition: $config) {
+		... Workspace
+	}
+}
+fragment Workspace on Project {
+	id
+	label
+}
+`
+
+func createWorkspace(
+	ctx context.Context,
+	client graphql.Client,
+	config WorkspaceInput,
+) (*createWorkspaceResponse, error) {
+	req := &graphql.Request{
+		OpName: "createWorkspace",
+		Query:  createWorkspace_Operation,
+		Variables: &__createWorkspaceInput{
+			Config: config,
+		},
+	}
+	var err error
+
+	var data createWorkspaceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteApiToken.
+const deleteApiToken_Operation = `
+mutation deleteApiToken ($id: String!) {
+	resultStatus: deleteAuthtoken(id: $id) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func deleteApiToken(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteApiTokenResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteApiToken",
+		Query:  deleteApiToken_Operation,
+		Variables: &__deleteApiTokenInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteApiTokenResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteApp.
+const deleteApp_Operation = `
+mutation deleteApp ($id: ObjectId!) {
+	resultStatus: deleteApp(id: $id) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func deleteApp(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteAppResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteApp",
+		Query:  deleteApp_Operation,
+		Variables: &__deleteAppInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteAppResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteAppDataSource.
+const deleteAppDataSource_Operation = `
+mutation deleteAppDataSource ($id: ObjectId!) {
+	resultStatus: deleteAppDataSource(id: $id) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func deleteAppDataSource(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteAppDataSourceResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteAppDataSource",
+		Query:  deleteAppDataSource_Operation,
+		Variables: &__deleteAppDataSourceInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteAppDataSourceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteBoard.
+const deleteBoard_Operation = `
+mutation deleteBoard ($id: ObjectId!) {
+	resultStatus: deleteBoard(id: $id) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func deleteBoard(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteBoardResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteBoard",
+		Query:  deleteBoard_Operation,
+		Variables: &__deleteBoardInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteBoardResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteBookmark.
+const deleteBookmark_Operation = `
+mutation deleteBookmark ($id: ObjectId!) {
+	resultStatus: deleteBookmark(id: $id) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func deleteBookmark(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteBookmarkResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteBookmark",
+		Query:  deleteBookmark_Operation,
+		Variables: &__deleteBookmarkInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteBookmarkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteBookmarkGroup.
+const deleteBookmarkGroup_Operation = `
+mutation deleteBookmarkGroup ($id: ObjectId!) {
+	resultStatus: deleteBookmarkGroup(id: $id) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func deleteBookmarkGroup(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteBookmarkGroupResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteBookmarkGroup",
+		Query:  deleteBookmarkGroup_Operation,
+		Variables: &__deleteBookmarkGroupInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteBookmarkGroupResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteChannel.
+const deleteChannel_Operation = `
+mutation deleteChannel ($id: ObjectId!) {
+	resultStatus: deleteChannel(id: $id) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func deleteChannel(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteChannelResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteChannel",
+		Query:  deleteChannel_Operation,
+		Variables: &__deleteChannelInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteChannelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteChannelAction.
+const deleteChannelAction_Operation = `
+mutation deleteChannelAction ($id: ObjectId!) {
+	resultStatus: deleteChannelAction(id: $id) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createAppDataSource(
+func deleteChannelAction(
 	ctx context.Context,
 	client graphql.Client,
-	config AppDataSourceInput,
-) (*createAppDataSourceResponse, error) {
+	id string,
+) (*deleteChannelActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "createAppDataSource",
-		Query:  createAppDataSource_Operation,
-		Variables: &__createAppDataSourceInput{
-			Config: config,
+		OpName: "deleteChannelAction",
+		Query:  deleteChannelAction_Operation,
+		Variables: &__deleteChannelActionInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createAppDataSourceResponse
+	var data deleteChannelActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -11960,42 +19354,35 @@ func createAppDataSource(
 	return &data, err
 }
 
-// The query or mutation executed by createBoard.
-const createBoard_Operation = `
-mutation createBoard ($datasetId: ObjectId!, $boardType: BoardType!, $board: BoardInput!) {
-	board: createBoard(datasetId: $datasetId, type: $boardType, board: $board) {
-		... Board
+// The query or mutation executed by deleteDashboard.
+const deleteDashboard_Operation = `
+mutation deleteDashboard ($id: ObjectId!) {
+	resultStatus: deleteDashboard(id: $id) {
+		... ResultStatus
 	}
 }
-fragment Board on Board {
-	id
-	datasetId
-	name
-	type
-	boardJson: board
-	source
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createBoard(
+func deleteDashboard(
 	ctx context.Context,
 	client graphql.Client,
-	datasetId string,
-	boardType BoardType,
-	board BoardInput,
-) (*createBoardResponse, error) {
+	id string,
+) (*deleteDashboardResponse, error) {
 	req := &graphql.Request{
-		OpName: "createBoard",
-		Query:  createBoard_Operation,
-		Variables: &__createBoardInput{
-			DatasetId: datasetId,
-			BoardType: boardType,
-			Board:     board,
+		OpName: "deleteDashboard",
+		Query:  deleteDashboard_Operation,
+		Variables: &__deleteDashboardInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createBoardResponse
+	var data deleteDashboardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12007,42 +19394,35 @@ func createBoard(
 	return &data, err
 }
 
-// The query or mutation executed by createChannel.
-const createChannel_Operation = `
-mutation createChannel ($workspaceId: ObjectId!, $channel: ChannelInput!) {
-	channel: createChannel(workspaceId: $workspaceId, channel: $channel) {
-		... Channel
+// The query or mutation executed by deleteDashboardLink.
+const deleteDashboardLink_Operation = `
+mutation deleteDashboardLink ($id: ObjectId!) {
+	resultStatus: deleteDashboardLink(id: $id) {
+		... ResultStatus
 	}
 }
-fragment Channel on Channel {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	monitors {
-		id
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createChannel(
+func deleteDashboardLink(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	channel ChannelInput,
-) (*createChannelResponse, error) {
+	id string,
+) (*deleteDashboardLinkResponse, error) {
 	req := &graphql.Request{
-		OpName: "createChannel",
-		Query:  createChannel_Operation,
-		Variables: &__createChannelInput{
-			WorkspaceId: workspaceId,
-			Channel:     channel,
+		OpName: "deleteDashboardLink",
+		Query:  deleteDashboardLink_Operation,
+		Variables: &__deleteDashboardLinkInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createChannelResponse
+	var data deleteDashboardLinkResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12054,61 +19434,77 @@ func createChannel(
 	return &data, err
 }
 
-// The query or mutation executed by createChannelAction.
-const createChannelAction_Operation = `
-mutation createChannelAction ($workspaceId: ObjectId!, $action: ActionInput!) {
-	channelAction: createChannelAction(workspaceId: $workspaceId, action: $action) {
-		__typename
-		... ChannelAction
+// The query or mutation executed by deleteDataConnection.
+const deleteDataConnection_Operation = `
+mutation deleteDataConnection ($id: ObjectId!) {
+	resultStatus: deleteDataConnection(id: $id) {
+		... ResultStatus
 	}
 }
-fragment ChannelAction on ChannelAction {
-	id
-	name
-	iconUrl
-	description
-	notifyOnClose
-	workspaceId
-	channels {
-		id
-	}
-	rateLimit
-	__typename
-	... on WebhookAction {
-		urlTemplate
-		bodyTemplate
-		method
-		headers {
-			header
-			valueTemplate
-		}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func deleteDataConnection(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteDataConnectionResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteDataConnection",
+		Query:  deleteDataConnection_Operation,
+		Variables: &__deleteDataConnectionInput{
+			Id: id,
+		},
 	}
-	... on EmailAction {
-		targetAddresses
-		subjectTemplate
-		bodyTemplate
-		isHtml
+	var err error
+
+	var data deleteDataConnectionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteDataset.
+const deleteDataset_Operation = `
+mutation deleteDataset ($id: ObjectId!, $dep: DependencyHandlingInput) {
+	resultStatus: deleteDataset(dsid: $id, dependencyHandling: $dep) {
+		... ResultStatus
 	}
 }
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
 `
 
-func createChannelAction(
+func deleteDataset(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	action ActionInput,
-) (*createChannelActionResponse, error) {
+	id string,
+	dep *DependencyHandlingInput,
+) (*deleteDatasetResponse, error) {
 	req := &graphql.Request{
-		OpName: "createChannelAction",
-		Query:  createChannelAction_Operation,
-		Variables: &__createChannelActionInput{
-			WorkspaceId: workspaceId,
-			Action:      action,
+		OpName: "deleteDataset",
+		Query:  deleteDataset_Operation,
+		Variables: &__deleteDatasetInput{
+			Id:  id,
+			Dep: dep,
 		},
 	}
 	var err error
 
-	var data createChannelActionResponse
+	var data deleteDatasetResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12120,42 +19516,75 @@ func createChannelAction(
 	return &data, err
 }
 
-// The query or mutation executed by createDashboardLink.
-const createDashboardLink_Operation = `
-mutation createDashboardLink ($input: DashboardLinkInput!) {
-	dashboardLink: createDashboardLink(link: $input) {
-		... DashboardLink
+// The query or mutation executed by deleteDatasetOutboundShare.
+const deleteDatasetOutboundShare_Operation = `
+mutation deleteDatasetOutboundShare ($id: ObjectId!) {
+	resultStatus: deleteDatasetOutboundShare(id: $id) {
+		... ResultStatus
 	}
 }
-fragment DashboardLink on DashboardLink {
-	id
-	name
-	workspaceId
-	folderId
-	managedById
-	description
-	fromDashboard: fromDashboardId
-	toDashboard: toDashboardId
-	fromCard
-	linkLabel
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
+}
+`
+
+func deleteDatasetOutboundShare(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteDatasetOutboundShareResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteDatasetOutboundShare",
+		Query:  deleteDatasetOutboundShare_Operation,
+		Variables: &__deleteDatasetOutboundShareInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteDatasetOutboundShareResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by deleteDatasource.
+const deleteDatasource_Operation = `
+mutation deleteDatasource ($id: ObjectId!) {
+	resultStatus: deleteDatasource(id: $id) {
+		... ResultStatus
+	}
+}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createDashboardLink(
+func deleteDatasource(
 	ctx context.Context,
 	client graphql.Client,
-	input DashboardLinkInput,
-) (*createDashboardLinkResponse, error) {
+	id string,
+) (*deleteDatasourceResponse, error) {
 	req := &graphql.Request{
-		OpName: "createDashboardLink",
-		Query:  createDashboardLink_Operation,
-		Variables: &__createDashboardLinkInput{
-			Input: input,
+		OpName: "deleteDatasource",
+		Query:  deleteDatasource_Operation,
+		Variables: &__deleteDatasourceInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createDashboardLinkResponse
+	var data deleteDatasourceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12167,52 +19596,35 @@ func createDashboardLink(
 	return &data, err
 }
 
-// The query or mutation executed by createDatasetOutboundShare.
-const createDatasetOutboundShare_Operation = `
-mutation createDatasetOutboundShare ($workspaceId: ObjectId!, $datasetID: ObjectId!, $outboundShareID: ObjectId!, $input: DatasetOutboundShareInput!) {
-	datasetOutboundShare: createDatasetOutboundShare(workspaceId: $workspaceId, datasetID: $datasetID, outboundShareID: $outboundShareID, input: $input) {
-		... DatasetOutboundShare
+// The query or mutation executed by deleteDatastream.
+const deleteDatastream_Operation = `
+mutation deleteDatastream ($id: ObjectId!) {
+	resultStatus: deleteDatastream(id: $id) {
+		... ResultStatus
 	}
 }
-fragment DatasetOutboundShare on DatasetOutboundShare {
-	id
-	name
-	description
-	workspaceId
-	folderId
-	datasetID
-	outboundShareID
-	schemaName
-	viewName
-	freshnessGoal
-	status {
-		state
-		error
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createDatasetOutboundShare(
+func deleteDatastream(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	datasetID string,
-	outboundShareID string,
-	input DatasetOutboundShareInput,
-) (*createDatasetOutboundShareResponse, error) {
+	id string,
+) (*deleteDatastreamResponse, error) {
 	req := &graphql.Request{
-		OpName: "createDatasetOutboundShare",
-		Query:  createDatasetOutboundShare_Operation,
-		Variables: &__createDatasetOutboundShareInput{
-			WorkspaceId:     workspaceId,
-			DatasetID:       datasetID,
-			OutboundShareID: outboundShareID,
-			Input:           input,
+		OpName: "deleteDatastream",
+		Query:  deleteDatastream_Operation,
+		Variables: &__deleteDatastreamInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createDatasetOutboundShareResponse
+	var data deleteDatastreamResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12224,40 +19636,35 @@ func createDatasetOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by createDatastream.
-const createDatastream_Operation = `
-mutation createDatastream ($workspaceId: ObjectId!, $datastream: DatastreamInput!) {
-	datastream: createDatastream(workspaceId: $workspaceId, datastream: $datastream) {
-		... Datastream
+// The query or mutation executed by deleteDatastreamToken.
+const deleteDatastreamToken_Operation = `
+mutation deleteDatastreamToken ($id: String!) {
+	resultStatus: deleteDatastreamToken(id: $id) {
+		... ResultStatus
 	}
 }
-fragment Datastream on Datastream {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	datasetId
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createDatastream(
+func deleteDatastreamToken(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	datastream DatastreamInput,
-) (*createDatastreamResponse, error) {
+	id string,
+) (*deleteDatastreamTokenResponse, error) {
 	req := &graphql.Request{
-		OpName: "createDatastream",
-		Query:  createDatastream_Operation,
-		Variables: &__createDatastreamInput{
-			WorkspaceId: workspaceId,
-			Datastream:  datastream,
+		OpName: "deleteDatastreamToken",
+		Query:  deleteDatastreamToken_Operation,
+		Variables: &__deleteDatastreamTokenInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createDatastreamResponse
+	var data deleteDatastreamTokenResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12269,42 +19676,35 @@ func createDatastream(
 	return &data, err
 }
 
-// The query or mutation executed by createDatastreamToken.
-const createDatastreamToken_Operation = `
-mutation createDatastreamToken ($datastreamId: ObjectId!, $token: DatastreamTokenInput!, $password: String) {
-	datastreamToken: createDatastreamToken(datastreamId: $datastreamId, token: $token, password: $password) {
-		... DatastreamToken
+// The query or mutation executed by deleteDeferredForeignKey.
+const deleteDeferredForeignKey_Operation = `
+mutation deleteDeferredForeignKey ($id: ObjectId!) {
+	resultStatus: deleteDeferredForeignKey(id: $id) {
+		... ResultStatus
 	}
 }
-fragment DatastreamToken on DatastreamToken {
-	id
-	name
-	description
-	disabled
-	datastreamId
-	secret
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createDatastreamToken(
+func deleteDeferredForeignKey(
 	ctx context.Context,
 	client graphql.Client,
-	datastreamId string,
-	token DatastreamTokenInput,
-	password *string,
-) (*createDatastreamTokenResponse, error) {
+	id string,
+) (*deleteDeferredForeignKeyResponse, error) {
 	req := &graphql.Request{
-		OpName: "createDatastreamToken",
-		Query:  createDatastreamToken_Operation,
-		Variables: &__createDatastreamTokenInput{
-			DatastreamId: datastreamId,
-			Token:        token,
-			Password:     password,
+		OpName: "deleteDeferredForeignKey",
+		Query:  deleteDeferredForeignKey_Operation,
+		Variables: &__deleteDeferredForeignKeyInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createDatastreamTokenResponse
+	var data deleteDeferredForeignKeyResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12316,52 +19716,35 @@ func createDatastreamToken(
 	return &data, err
 }
 
-// The query or mutation executed by createDeferredForeignKey.
-const createDeferredForeignKey_Operation = `
-mutation createDeferredForeignKey ($workspaceId: ObjectId!, $keyInput: DeferredForeignKeyInput!) {
-	deferredForeignKey: createDeferredForeignKey(workspaceId: $workspaceId, data: $keyInput) {
-		... DeferredForeignKey
+// The query or mutation executed by deleteFiledrop.
+const deleteFiledrop_Operation = `
+mutation deleteFiledrop ($id: ObjectId!) {
+	resultStatus: deleteFiledrop(id: $id) {
+		... ResultStatus
 	}
 }
-fragment DeferredForeignKey on DeferredForeignKey {
-	id
-	workspaceId
-	sourceDataset {
-		datasetId
-	}
-	targetDataset {
-		datasetId
-	}
-	srcFields
-	dstFields
-	label
-	resolution {
-		sourceId
-		targetId
-	}
-	status {
-		errorText
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createDeferredForeignKey(
+func deleteFiledrop(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	keyInput DeferredForeignKeyInput,
-) (*createDeferredForeignKeyResponse, error) {
+	id string,
+) (*deleteFiledropResponse, error) {
 	req := &graphql.Request{
-		OpName: "createDeferredForeignKey",
-		Query:  createDeferredForeignKey_Operation,
-		Variables: &__createDeferredForeignKeyInput{
-			WorkspaceId: workspaceId,
-			KeyInput:    keyInput,
+		OpName: "deleteFiledrop",
+		Query:  deleteFiledrop_Operation,
+		Variables: &__deleteFiledropInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createDeferredForeignKeyResponse
+	var data deleteFiledropResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12373,60 +19756,35 @@ func createDeferredForeignKey(
 	return &data, err
 }
 
-// The query or mutation executed by createFiledrop.
-const createFiledrop_Operation = `
-mutation createFiledrop ($workspaceID: ObjectId!, $datastreamID: ObjectId!, $input: FiledropInput!) {
-	filedrop: createFiledrop(workspaceId: $workspaceID, datastreamID: $datastreamID, input: $input) {
-		... Filedrop
+// The query or mutation executed by deleteFolder.
+const deleteFolder_Operation = `
+mutation deleteFolder ($id: ObjectId!) {
+	resultStatus: deleteFolder(id: $id) {
+		... ResultStatus
 	}
 }
-fragment Filedrop on Filedrop {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	status
-	datastreamID
-	config {
-		provider {
-			__typename
-			... on FiledropProviderAwsConfig {
-				region
-				roleArn
-			}
-		}
-	}
-	endpoint {
-		__typename
-		... on FiledropS3Endpoint {
-			arn
-			bucket
-			prefix
-		}
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createFiledrop(
+func deleteFolder(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceID string,
-	datastreamID string,
-	input FiledropInput,
-) (*createFiledropResponse, error) {
+	id string,
+) (*deleteFolderResponse, error) {
 	req := &graphql.Request{
-		OpName: "createFiledrop",
-		Query:  createFiledrop_Operation,
-		Variables: &__createFiledropInput{
-			WorkspaceID:  workspaceID,
-			DatastreamID: datastreamID,
-			Input:        input,
+		OpName: "deleteFolder",
+		Query:  deleteFolder_Operation,
+		Variables: &__deleteFolderInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createFiledropResponse
+	var data deleteFolderResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12438,39 +19796,35 @@ func createFiledrop(
 	return &data, err
 }
 
-// The query or mutation executed by createFolder.
-const createFolder_Operation = `
-mutation createFolder ($workspaceId: ObjectId!, $config: FolderInput!) {
-	folder: createFolder(workspaceId: $workspaceId, folder: $config) {
-		... Folder
+// The query or mutation executed by deleteIncident.
+const deleteIncident_Operation = `
+mutation deleteIncident ($id: ObjectId!) {
+	resultStatus: deleteIncident(id: $id) {
+		... ResultStatus
 	}
 }
-fragment Folder on Folder {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createFolder(
+func deleteIncident(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	config FolderInput,
-) (*createFolderResponse, error) {
+	id string,
+) (*deleteIncidentResponse, error) {
 	req := &graphql.Request{
-		OpName: "createFolder",
-		Query:  createFolder_Operation,
-		Variables: &__createFolderInput{
-			WorkspaceId: workspaceId,
-			Config:      config,
+		OpName: "deleteIncident",
+		Query:  deleteIncident_Operation,
+		Variables: &__deleteIncidentInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createFolderResponse
+	var data deleteIncidentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12482,71 +19836,35 @@ func createFolder(
 	return &data, err
 }
 
-// The query or mutation executed by createLayeredSettingRecord.
-const createLayeredSettingRecord_Operation = `
-mutation createLayeredSettingRecord ($settingRecord: LayeredSettingRecordInput!) {
-	layeredSettingRecord: createLayeredSettingRecord(settingRecord: $settingRecord) {
-		... LayeredSettingRecord
-	}
-}
-fragment LayeredSettingRecord on LayeredSettingRecord {
-	settingAndTargetScope {
-		... SettingAndTargetScope
-	}
-	value {
-		... PrimitiveValue
-	}
-	id
-	name
-	description
-	iconUrl
-	workspaceId
-	managedById
-	folderId
-}
-fragment SettingAndTargetScope on SettingAndTargetScope {
-	setting
-	target {
-		... LayeredSettingRecordTarget
+// The query or mutation executed by deleteInvestigationNotebook.
+const deleteInvestigationNotebook_Operation = `
+mutation deleteInvestigationNotebook ($id: ObjectId!) {
+	resultStatus: deleteInvestigationNotebook(id: $id) {
+		... ResultStatus
 	}
 }
-fragment PrimitiveValue on PrimitiveValue {
-	bool
-	float64
-	int64
-	string
-	timestamp
-	duration
-}
-fragment LayeredSettingRecordTarget on LayeredSettingRecordTarget {
-	customerId
-	workspaceId
-	folderId
-	appId
-	monitorId
-	worksheetId
-	dashboardId
-	datasetId
-	datastreamId
-	userId
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createLayeredSettingRecord(
+func deleteInvestigationNotebook(
 	ctx context.Context,
 	client graphql.Client,
-	settingRecord LayeredSettingRecordInput,
-) (*createLayeredSettingRecordResponse, error) {
+	id string,
+) (*deleteInvestigationNotebookResponse, error) {
 	req := &graphql.Request{
-		OpName: "createLayeredSettingRecord",
-		Query:  createLayeredSettingRecord_Operation,
-		Variables: &__createLayeredSettingRecordInput{
-			SettingRecord: settingRecord,
+		OpName: "deleteInvestigationNotebook",
+		Query:  deleteInvestigationNotebook_Operation,
+		Variables: &__deleteInvestigationNotebookInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createLayeredSettingRecordResponse
+	var data deleteInvestigationNotebookResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12558,123 +19876,37 @@ func createLayeredSettingRecord(
 	return &data, err
 }
 
-// The query or mutation executed by createMonitor.
-const createMonitor_Operation = `
-mutation createMonitor ($workspaceId: ObjectId!, $monitor: MonitorInput!) {
-	monitor: createMonitor(workspaceId: $workspaceId, monitor: $monitor) {
-		monitor {
-			... Monitor
-		}
-	}
-}
-fragment Monitor on Monitor {
-	workspaceId
-	id
-	name
-	description
-	comment
-	iconUrl
-	isTemplate
-	disabled
-	freshnessGoal
-	useDefaultFreshness
-	source
-	definition
-	managedById
-	query {
-		outputStage
-		stages {
-			... StageQuery
-		}
-	}
-	rule {
-		__typename
-		sourceColumn
-		groupByGroups {
-			groupName
-			columns
-		}
-		... on MonitorRuleCount {
-			compareFunction
-			compareValues
-			lookbackTime
-		}
-		... on MonitorRuleChange {
-			changeType
-			compareFunction
-			compareValues
-			aggregateFunction
-			lookbackTime
-			baselineTime
-		}
-		... on MonitorRuleFacet {
-			facetFunction
-			facetValues
-			timeFunction
-			timeValue
-			lookbackTime
-		}
-		... on MonitorRuleThreshold {
-			compareFunction
-			compareValues
-			lookbackTime
-			thresholdAggFunction
-		}
-		... on MonitorRulePromote {
-			kindField
-			descriptionField
-			primaryKey
-		}
-		... on MonitorRuleLog {
-			compareFunction
-			compareValues
-			lookbackTime
-			expressionSummary
-			logStageId
-			sourceLogDatasetId
+// The query or mutation executed by deleteLayeredSettingRecord.
+const deleteLayeredSettingRecord_Operation = `
+mutation deleteLayeredSettingRecord ($id: ObjectId!) {
+	deleteLayeredSettingRecord(id: $id) {
+		resultStatus: result {
+			... ResultStatus
 		}
 	}
-	notificationSpec {
-		merge
-		importance
-		notifyOnReminder
-		reminderFrequency
-		notifyOnClose
-	}
 }
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-// More workarounds for server-side struggles
-func createMonitor(
+func deleteLayeredSettingRecord(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	monitor MonitorInput,
-) (*createMonitorResponse, error) {
+	id string,
+) (*deleteLayeredSettingRecordResponse, error) {
 	req := &graphql.Request{
-		OpName: "createMonitor",
-		Query:  createMonitor_Operation,
-		Variables: &__createMonitorInput{
-			WorkspaceId: workspaceId,
-			Monitor:     monitor,
+		OpName: "deleteLayeredSettingRecord",
+		Query:  deleteLayeredSettingRecord_Operation,
+		Variables: &__deleteLayeredSettingRecordInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createMonitorResponse
+	var data deleteLayeredSettingRecordResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12686,57 +19918,35 @@ func createMonitor(
 	return &data, err
 }
 
-// The query or mutation executed by createMonitorAction.
-const createMonitorAction_Operation = `
-mutation createMonitorAction ($monitorAction: MonitorActionInput!) {
-	monitorAction: createMonitorAction(input: $monitorAction) {
-		__typename
-		... MonitorAction
+// The query or mutation executed by deleteMonitor.
+const deleteMonitor_Operation = `
+mutation deleteMonitor ($id: ObjectId!) {
+	resultStatus: deleteMonitor(id: $id) {
+		... ResultStatus
 	}
 }
-fragment MonitorAction on MonitorAction {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	rateLimit
-	notifyOnClose
-	isPrivate
-	__typename
-	... on EmailAction {
-		targetAddresses
-		subjectTemplate
-		bodyTemplate
-		isHtml
-	}
-	... on WebhookAction {
-		urlTemplate
-		method
-		headers {
-			header
-			valueTemplate
-		}
-		bodyTemplate
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createMonitorAction(
+func deleteMonitor(
 	ctx context.Context,
 	client graphql.Client,
-	monitorAction MonitorActionInput,
-) (*createMonitorActionResponse, error) {
+	id string,
+) (*deleteMonitorResponse, error) {
 	req := &graphql.Request{
-		OpName: "createMonitorAction",
-		Query:  createMonitorAction_Operation,
-		Variables: &__createMonitorActionInput{
-			MonitorAction: monitorAction,
+		OpName: "deleteMonitor",
+		Query:  deleteMonitor_Operation,
+		Variables: &__deleteMonitorInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createMonitorActionResponse
+	var data deleteMonitorResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12748,39 +19958,35 @@ func createMonitorAction(
 	return &data, err
 }
 
-// The query or mutation executed by createMonitorActionAttachment.
-const createMonitorActionAttachment_Operation = `
-mutation createMonitorActionAttachment ($monitorActionAttachment: MonitorActionAttachmentInput!) {
-	monitorActionAttachment: createMonitorActionAttachment(input: $monitorActionAttachment) {
-		... MonitorActionAttachment
+// The query or mutation executed by deleteMonitorAction.
+const deleteMonitorAction_Operation = `
+mutation deleteMonitorAction ($id: ObjectId!) {
+	resultStatus: deleteMonitorAction(id: $id) {
+		... ResultStatus
 	}
 }
-fragment MonitorActionAttachment on MonitorActionAttachment {
-	monitorID
-	actionID
-	id
-	workspaceId
-	name
-	iconUrl
-	description
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createMonitorActionAttachment(
+func deleteMonitorAction(
 	ctx context.Context,
 	client graphql.Client,
-	monitorActionAttachment MonitorActionAttachmentInput,
-) (*createMonitorActionAttachmentResponse, error) {
+	id string,
+) (*deleteMonitorActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "createMonitorActionAttachment",
-		Query:  createMonitorActionAttachment_Operation,
-		Variables: &__createMonitorActionAttachmentInput{
-			MonitorActionAttachment: monitorActionAttachment,
+		OpName: "deleteMonitorAction",
+		Query:  deleteMonitorAction_Operation,
+		Variables: &__deleteMonitorActionInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createMonitorActionAttachmentResponse
+	var data deleteMonitorActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12792,186 +19998,75 @@ func createMonitorActionAttachment(
 	return &data, err
 }
 
-// The query or mutation executed by createMonitorV2.
-const createMonitorV2_Operation = `
-mutation createMonitorV2 ($workspaceId: ObjectId!, $input: MonitorV2Input!) {
-	monitorV2: createMonitorV2(workspaceId: $workspaceId, input: $input) {
-		... MonitorV2
-	}
-}
-fragment MonitorV2 on MonitorV2 {
-	id
-	workspaceId
-	createdBy
-	createdDate
-	name
-	iconUrl
-	description
-	managedById
-	folderId
-	rollupStatus
-	ruleKind
-	definition {
-		... MonitorV2Definition
-	}
-	actionRules {
-		... MonitorV2ActionRule
-	}
-}
-fragment MonitorV2Definition on MonitorV2Definition {
-	inputQuery {
-		outputStage
-		stages {
-			... StageQuery
-		}
-	}
-	rules {
-		... MonitorV2Rule
-	}
-	lookbackTime
-	dataStabilizationDelay
-	groupings {
-		... MonitorV2Column
-	}
-	scheduling {
-		... MonitorV2Scheduling
-	}
-}
-fragment MonitorV2ActionRule on MonitorV2ActionRule {
-	actionID
-	levels
-	sendEndNotifications
-	sendRemindersInterval
-}
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
-	}
-}
-fragment MonitorV2Rule on MonitorV2Rule {
-	level
-	count {
-		... MonitorV2CountRule
-	}
-	threshold {
-		... MonitorV2ThresholdRule
-	}
-	promote {
-		... MonitorV2PromoteRule
-	}
-}
-fragment MonitorV2Column on MonitorV2Column {
-	linkColumn {
-		... MonitorV2LinkColumn
-	}
-	columnPath {
-		... MonitorV2ColumnPath
-	}
-}
-fragment MonitorV2Scheduling on MonitorV2Scheduling {
-	interval {
-		... MonitorV2IntervalSchedule
-	}
-	transform {
-		... MonitorV2TransformSchedule
-	}
-}
-fragment MonitorV2CountRule on MonitorV2CountRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	compareGroups {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	valueColumnName
-	aggregation
-	compareGroups {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
-	compareColumns {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
-	name
-	meta {
-		... MonitorV2LinkColumnMeta
+// The query or mutation executed by deleteMonitorActionAttachment.
+const deleteMonitorActionAttachment_Operation = `
+mutation deleteMonitorActionAttachment ($id: ObjectId!) {
+	resultStatus: deleteMonitorActionAttachment(id: $id) {
+		... ResultStatus
 	}
 }
-fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
-	name
-	path
-}
-fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
-	interval
-	randomize
-}
-fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
-	freshnessGoal
-}
-fragment MonitorV2Comparison on MonitorV2Comparison {
-	compareFn
-	compareValue {
-		... PrimitiveValue
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
-fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
-	column {
-		... MonitorV2Column
-	}
-	compareValues {
-		... MonitorV2Comparison
+`
+
+func deleteMonitorActionAttachment(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteMonitorActionAttachmentResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteMonitorActionAttachment",
+		Query:  deleteMonitorActionAttachment_Operation,
+		Variables: &__deleteMonitorActionAttachmentInput{
+			Id: id,
+		},
 	}
+	var err error
+
+	var data deleteMonitorActionAttachmentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
-fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
-	srcFields {
-		... MonitorV2ColumnPath
+
+// The query or mutation executed by deleteMonitorMuteRule.
+const deleteMonitorMuteRule_Operation = `
+mutation deleteMonitorMuteRule ($id: ObjectId!) {
+	resultStatus: deleteMonitorMuteRule(id: $id) {
+		... ResultStatus
 	}
-	dstFields
-	targetDataset
 }
-fragment PrimitiveValue on PrimitiveValue {
-	bool
-	float64
-	int64
-	string
-	timestamp
-	duration
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createMonitorV2(
+func deleteMonitorMuteRule(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	input MonitorV2Input,
-) (*createMonitorV2Response, error) {
+	id string,
+) (*deleteMonitorMuteRuleResponse, error) {
 	req := &graphql.Request{
-		OpName: "createMonitorV2",
-		Query:  createMonitorV2_Operation,
-		Variables: &__createMonitorV2Input{
-			WorkspaceId: workspaceId,
-			Input:       input,
+		OpName: "deleteMonitorMuteRule",
+		Query:  deleteMonitorMuteRule_Operation,
+		Variables: &__deleteMonitorMuteRuleInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createMonitorV2Response
+	var data deleteMonitorMuteRuleResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -12983,69 +20078,35 @@ func createMonitorV2(
 	return &data, err
 }
 
-// The query or mutation executed by createMonitorV2Action.
-const createMonitorV2Action_Operation = `
-mutation createMonitorV2Action ($workspaceId: ObjectId!, $input: MonitorV2ActionInput!) {
-	monitorV2Action: createMonitorV2Action(workspaceId: $workspaceId, input: $input) {
-		... MonitorV2Action
-	}
-}
-fragment MonitorV2Action on MonitorV2Action {
-	inline
-	type
-	email {
-		... MonitorV2EmailAction
-	}
-	webhook {
-		... MonitorV2WebhookAction
-	}
-	id
-	workspaceId
-	name
-	iconUrl
-	description
-	createdBy
-	createdDate
-}
-fragment MonitorV2EmailAction on MonitorV2EmailAction {
-	users
-	addresses
-	subject
-	body
-	fragments
-}
-fragment MonitorV2WebhookAction on MonitorV2WebhookAction {
-	headers {
-		... MonitorV2WebhookHeader
+// The query or mutation executed by deleteMonitorV2.
+const deleteMonitorV2_Operation = `
+mutation deleteMonitorV2 ($id: ObjectId!) {
+	resultStatus: deleteMonitorV2(id: $id) {
+		... ResultStatus
 	}
-	body
-	fragments
-	url
-	method
 }
-fragment MonitorV2WebhookHeader on MonitorV2WebhookHeader {
-	header
-	value
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createMonitorV2Action(
+func deleteMonitorV2(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	input MonitorV2ActionInput,
-) (*createMonitorV2ActionResponse, error) {
+	id string,
+) (*deleteMonitorV2Response, error) {
 	req := &graphql.Request{
-		OpName: "createMonitorV2Action",
-		Query:  createMonitorV2Action_Operation,
-		Variables: &__createMonitorV2ActionInput{
-			WorkspaceId: workspaceId,
-			Input:       input,
+		OpName: "deleteMonitorV2",
+		Query:  deleteMonitorV2_Operation,
+		Variables: &__deleteMonitorV2Input{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createMonitorV2ActionResponse
+	var data deleteMonitorV2Response
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13057,41 +20118,35 @@ func createMonitorV2Action(
 	return &data, err
 }
 
-// The query or mutation executed by createOrUpdateBookmark.
-const createOrUpdateBookmark_Operation = `
-mutation createOrUpdateBookmark ($id: ObjectId, $bookmark: BookmarkInput!) {
-	bookmark: createOrUpdateBookmark(id: $id, bookmark: $bookmark) {
-		... Bookmark
+// The query or mutation executed by deleteMonitorV2Action.
+const deleteMonitorV2Action_Operation = `
+mutation deleteMonitorV2Action ($id: ObjectId!) {
+	resultStatus: deleteMonitorV2Action(id: $id) {
+		... ResultStatus
 	}
 }
-fragment Bookmark on Bookmark {
-	id
-	name
-	iconUrl
-	targetId
-	targetIdKind
-	groupId
-	bookmarkKind
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createOrUpdateBookmark(
+func deleteMonitorV2Action(
 	ctx context.Context,
 	client graphql.Client,
-	id *string,
-	bookmark BookmarkInput,
-) (*createOrUpdateBookmarkResponse, error) {
+	id string,
+) (*deleteMonitorV2ActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "createOrUpdateBookmark",
-		Query:  createOrUpdateBookmark_Operation,
-		Variables: &__createOrUpdateBookmarkInput{
-			Id:       id,
-			Bookmark: bookmark,
+		OpName: "deleteMonitorV2Action",
+		Query:  deleteMonitorV2Action_Operation,
+		Variables: &__deleteMonitorV2ActionInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createOrUpdateBookmarkResponse
+	var data deleteMonitorV2ActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13103,40 +20158,35 @@ func createOrUpdateBookmark(
 	return &data, err
 }
 
-// The query or mutation executed by createOrUpdateBookmarkGroup.
-const createOrUpdateBookmarkGroup_Operation = `
-mutation createOrUpdateBookmarkGroup ($id: ObjectId, $group: BookmarkGroupInput!) {
-	bookmarkGroup: createOrUpdateBookmarkGroup(id: $id, group: $group) {
-		... BookmarkGroup
+// The query or mutation executed by deleteMonitorV2MuteRule.
+const deleteMonitorV2MuteRule_Operation = `
+mutation deleteMonitorV2MuteRule ($id: ObjectId!) {
+	resultStatus: deleteMonitorV2MuteRule(id: $id) {
+		... ResultStatus
 	}
 }
-fragment BookmarkGroup on BookmarkGroup {
-	id
-	name
-	description
-	iconUrl
-	workspaceId
-	isHome
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createOrUpdateBookmarkGroup(
+func deleteMonitorV2MuteRule(
 	ctx context.Context,
 	client graphql.Client,
-	id *string,
-	group BookmarkGroupInput,
-) (*createOrUpdateBookmarkGroupResponse, error) {
+	id string,
+) (*deleteMonitorV2MuteRuleResponse, error) {
 	req := &graphql.Request{
-		OpName: "createOrUpdateBookmarkGroup",
-		Query:  createOrUpdateBookmarkGroup_Operation,
-		Variables: &__createOrUpdateBookmarkGroupInput{
-			Id:    id,
-			Group: group,
+		OpName: "deleteMonitorV2MuteRule",
+		Query:  deleteMonitorV2MuteRule_Operation,
+		Variables: &__deleteMonitorV2MuteRuleInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createOrUpdateBookmarkGroupResponse
+	var data deleteMonitorV2MuteRuleResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13148,137 +20198,35 @@ func createOrUpdateBookmarkGroup(
 	return &data, err
 }
 
-// The query or mutation executed by createPoller.
-const createPoller_Operation = `
-mutation createPoller ($workspaceId: ObjectId!, $poller: PollerInput!) {
-	poller: createPoller(workspaceId: $workspaceId, poller: $poller) {
-		... Poller
-	}
-}
-fragment Poller on Poller {
-	id
-	workspaceId
-	customerId
-	datastreamId
-	disabled
-	kind
-	config {
-		__typename
-		name
-		retries
-		interval
-		tags
-		chunk {
-			enabled
-			size
-		}
-		... on PollerPubSubConfig {
-			projectId
-			jsonKey
-			subscriptionId
-		}
-		... on PollerHTTPConfig {
-			method
-			body
-			endpoint
-			contentType
-			headers
-			template {
-				... HttpRequestConfig
-			}
-			requests {
-				... HttpRequestConfig
-			}
-			rules {
-				match {
-					... HttpRequestConfig
-				}
-				follow
-				decoder {
-					type
-				}
-			}
-			timestamps {
-				name
-				source
-				format
-				offset
-				truncate
-			}
-		}
-		... on PollerGCPMonitoringConfig {
-			projectId
-			jsonKey
-			includeMetricTypePrefixes
-			excludeMetricTypePrefixes
-			rateLimit
-			totalLimit
-		}
-		... on PollerMongoDBAtlasConfig {
-			publicKey
-			privateKey
-			includeGroups
-			excludeGroups
-		}
-		... on PollerCloudWatchMetricsConfig {
-			period
-			delay
-			region
-			assumeRoleArn
-			queries {
-				namespace
-				metricNames
-				dimensions {
-					name
-					value
-				}
-				resourceFilter {
-					resourceType
-					pattern
-					dimensionName
-					tagFilters {
-						key
-						values
-					}
-				}
-			}
-		}
-		... on PollerAWSSnapshotConfig {
-			region
-			assumeRoleArn
-			includeActions
-		}
+// The query or mutation executed by deletePoller.
+const deletePoller_Operation = `
+mutation deletePoller ($id: ObjectId!) {
+	resultStatus: deletePoller(id: $id) {
+		... ResultStatus
 	}
 }
-fragment HttpRequestConfig on PollerHTTPRequestConfig {
-	url
-	method
-	username
-	password
-	authScheme
-	body
-	headers
-	params
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createPoller(
+func deletePoller(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	poller PollerInput,
-) (*createPollerResponse, error) {
+	id string,
+) (*deletePollerResponse, error) {
 	req := &graphql.Request{
-		OpName: "createPoller",
-		Query:  createPoller_Operation,
-		Variables: &__createPollerInput{
-			WorkspaceId: workspaceId,
-			Poller:      poller,
+		OpName: "deletePoller",
+		Query:  deletePoller_Operation,
+		Variables: &__deletePollerInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createPollerResponse
+	var data deletePollerResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13290,54 +20238,35 @@ func createPoller(
 	return &data, err
 }
 
-// The query or mutation executed by createPreferredPath.
-const createPreferredPath_Operation = `
-mutation createPreferredPath ($workspaceId: ObjectId!, $config: PreferredPathInput!) {
-	preferredPathWithStatus: createPreferredPath(workspaceId: $workspaceId, path: $config) {
-		... PreferredPathWithStatus
-	}
-}
-fragment PreferredPathWithStatus on PreferredPathWithStatus {
-	path {
-		... PreferredPath
+// The query or mutation executed by deletePreferredPath.
+const deletePreferredPath_Operation = `
+mutation deletePreferredPath ($id: ObjectId!) {
+	resultStatus: deletePreferredPath(id: $id) {
+		... ResultStatus
 	}
-	error
 }
-fragment PreferredPath on PreferredPath {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	folderId
-	sourceDataset
-	path {
-		linkId
-		reverse
-		linkName
-		reverseFromDataset
-	}
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-// More workarounds for server-side struggles
-func createPreferredPath(
+func deletePreferredPath(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	config PreferredPathInput,
-) (*createPreferredPathResponse, error) {
+	id string,
+) (*deletePreferredPathResponse, error) {
 	req := &graphql.Request{
-		OpName: "createPreferredPath",
-		Query:  createPreferredPath_Operation,
-		Variables: &__createPreferredPathInput{
-			WorkspaceId: workspaceId,
-			Config:      config,
+		OpName: "deletePreferredPath",
+		Query:  deletePreferredPath_Operation,
+		Variables: &__deletePreferredPathInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createPreferredPathResponse
+	var data deletePreferredPathResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13349,35 +20278,35 @@ func createPreferredPath(
 	return &data, err
 }
 
-// The query or mutation executed by createRbacGroup.
-const createRbacGroup_Operation = `
-mutation createRbacGroup ($config: RbacGroupInput!) {
-	rbacGroup: createRbacGroup(input: $config) {
-		... RbacGroup
+// The query or mutation executed by deleteRbacGroup.
+const deleteRbacGroup_Operation = `
+mutation deleteRbacGroup ($id: ORN!) {
+	resultStatus: deleteRbacGroup(id: $id) {
+		... ResultStatus
 	}
 }
-fragment RbacGroup on RbacGroup {
-	id
-	name
-	description
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createRbacGroup(
+func deleteRbacGroup(
 	ctx context.Context,
 	client graphql.Client,
-	config RbacGroupInput,
-) (*createRbacGroupResponse, error) {
+	id string,
+) (*deleteRbacGroupResponse, error) {
 	req := &graphql.Request{
-		OpName: "createRbacGroup",
-		Query:  createRbacGroup_Operation,
-		Variables: &__createRbacGroupInput{
-			Config: config,
+		OpName: "deleteRbacGroup",
+		Query:  deleteRbacGroup_Operation,
+		Variables: &__deleteRbacGroupInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createRbacGroupResponse
+	var data deleteRbacGroupResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13389,37 +20318,35 @@ func createRbacGroup(
 	return &data, err
 }
 
-// The query or mutation executed by createRbacGroupmember.
-const createRbacGroupmember_Operation = `
-mutation createRbacGroupmember ($config: RbacGroupmemberInput!) {
-	rbacGroupmember: createRbacGroupmember(input: $config) {
-		... RbacGroupmember
+// The query or mutation executed by deleteRbacGroupmember.
+const deleteRbacGroupmember_Operation = `
+mutation deleteRbacGroupmember ($id: ORN!) {
+	resultStatus: deleteRbacGroupmember(id: $id) {
+		... ResultStatus
 	}
 }
-fragment RbacGroupmember on RbacGroupmember {
-	id
-	description
-	groupId
-	memberUserId
-	memberGroupId
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createRbacGroupmember(
+func deleteRbacGroupmember(
 	ctx context.Context,
 	client graphql.Client,
-	config RbacGroupmemberInput,
-) (*createRbacGroupmemberResponse, error) {
+	id string,
+) (*deleteRbacGroupmemberResponse, error) {
 	req := &graphql.Request{
-		OpName: "createRbacGroupmember",
-		Query:  createRbacGroupmember_Operation,
-		Variables: &__createRbacGroupmemberInput{
-			Config: config,
+		OpName: "deleteRbacGroupmember",
+		Query:  deleteRbacGroupmember_Operation,
+		Variables: &__deleteRbacGroupmemberInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createRbacGroupmemberResponse
+	var data deleteRbacGroupmemberResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13431,50 +20358,35 @@ func createRbacGroupmember(
 	return &data, err
 }
 
-// The query or mutation executed by createRbacStatement.
-const createRbacStatement_Operation = `
-mutation createRbacStatement ($config: RbacStatementInput!) {
-	rbacStatement: createRbacStatement(input: $config) {
-		... RbacStatement
+// The query or mutation executed by deleteRbacStatement.
+const deleteRbacStatement_Operation = `
+mutation deleteRbacStatement ($id: ORN!) {
+	resultStatus: deleteRbacStatement(id: $id) {
+		... ResultStatus
 	}
 }
-fragment RbacStatement on RbacStatement {
-	id
-	description
-	subject {
-		userId
-		groupId
-		all
-	}
-	object {
-		objectId
-		folderId
-		workspaceId
-		type
-		name
-		owner
-		all
-	}
-	role
-	version
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createRbacStatement(
+func deleteRbacStatement(
 	ctx context.Context,
 	client graphql.Client,
-	config RbacStatementInput,
-) (*createRbacStatementResponse, error) {
+	id string,
+) (*deleteRbacStatementResponse, error) {
 	req := &graphql.Request{
-		OpName: "createRbacStatement",
-		Query:  createRbacStatement_Operation,
-		Variables: &__createRbacStatementInput{
-			Config: config,
+		OpName: "deleteRbacStatement",
+		Query:  deleteRbacStatement_Operation,
+		Variables: &__deleteRbacStatementInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createRbacStatementResponse
+	var data deleteRbacStatementResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13486,46 +20398,35 @@ func createRbacStatement(
 	return &data, err
 }
 
-// The query or mutation executed by createSnowflakeOutboundShare.
-const createSnowflakeOutboundShare_Operation = `
-mutation createSnowflakeOutboundShare ($workspaceId: ObjectId!, $input: SnowflakeOutboundShareInput!) {
-	share: createSnowflakeOutboundShare(workspaceId: $workspaceId, input: $input) {
-		... SnowflakeOutboundShare
-	}
-}
-fragment SnowflakeOutboundShare on SnowflakeOutboundShare {
-	id
-	name
-	description
-	workspaceId
-	shareName
-	accounts {
-		... SnowflakeAccount
+// The query or mutation executed by deleteReferenceTable.
+const deleteReferenceTable_Operation = `
+mutation deleteReferenceTable ($id: ObjectId!) {
+	resultStatus: deleteReferenceTable(id: $id) {
+		... ResultStatus
 	}
 }
-fragment SnowflakeAccount on SnowflakeAccount {
-	organization
-	account
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createSnowflakeOutboundShare(
+func deleteReferenceTable(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	input SnowflakeOutboundShareInput,
-) (*createSnowflakeOutboundShareResponse, error) {
+	id string,
+) (*deleteReferenceTableResponse, error) {
 	req := &graphql.Request{
-		OpName: "createSnowflakeOutboundShare",
-		Query:  createSnowflakeOutboundShare_Operation,
-		Variables: &__createSnowflakeOutboundShareInput{
-			WorkspaceId: workspaceId,
-			Input:       input,
+		OpName: "deleteReferenceTable",
+		Query:  deleteReferenceTable_Operation,
+		Variables: &__deleteReferenceTableInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createSnowflakeOutboundShareResponse
+	var data deleteReferenceTableResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13537,34 +20438,35 @@ func createSnowflakeOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by createWorkspace.
-const createWorkspace_Operation = `
-mutation createWorkspace ($config: WorkspaceInput!) {
-	workspace: createWorkspace(definition: $config) {
-		... Workspace
+// The query or mutation executed by deleteSnowflakeOutboundShare.
+const deleteSnowflakeOutboundShare_Operation = `
+mutation deleteSnowflakeOutboundShare ($id: ObjectId!) {
+	resultStatus: deleteSnowflakeOutboundShare(id: $id) {
+		... ResultStatus
 	}
 }
-fragment Workspace on Project {
-	id
-	label
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func createWorkspace(
+func deleteSnowflakeOutboundShare(
 	ctx context.Context,
 	client graphql.Client,
-	config WorkspaceInput,
-) (*createWorkspaceResponse, error) {
+	id string,
+) (*deleteSnowflakeOutboundShareResponse, error) {
 	req := &graphql.Request{
-		OpName: "createWorkspace",
-		Query:  createWorkspace_Operation,
-		Variables: &__createWorkspaceInput{
-			Config: config,
+		OpName: "deleteSnowflakeOutboundShare",
+		Query:  deleteSnowflakeOutboundShare_Operation,
+		Variables: &__deleteSnowflakeOutboundShareInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data createWorkspaceResponse
+	var data deleteSnowflakeOutboundShareResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13576,10 +20478,10 @@ func createWorkspace(
 	return &data, err
 }
 
-// The query or mutation executed by deleteApp.
-const deleteApp_Operation = `
-mutation deleteApp ($id: ObjectId!) {
-	resultStatus: deleteApp(id: $id) {
+// The query or mutation executed by deleteWorksheet.
+const deleteWorksheet_Operation = `
+mutation deleteWorksheet ($id: ObjectId!) {
+	resultStatus: deleteWorksheet(wks: $id) {
 		... ResultStatus
 	}
 }
@@ -13590,21 +20492,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteApp(
+func deleteWorksheet(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteAppResponse, error) {
+) (*deleteWorksheetResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteApp",
-		Query:  deleteApp_Operation,
-		Variables: &__deleteAppInput{
+		OpName: "deleteWorksheet",
+		Query:  deleteWorksheet_Operation,
+		Variables: &__deleteWorksheetInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteAppResponse
+	var data deleteWorksheetResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13616,10 +20518,10 @@ func deleteApp(
 	return &data, err
 }
 
-// The query or mutation executed by deleteAppDataSource.
-const deleteAppDataSource_Operation = `
-mutation deleteAppDataSource ($id: ObjectId!) {
-	resultStatus: deleteAppDataSource(id: $id) {
+// The query or mutation executed by deleteWorkspace.
+const deleteWorkspace_Operation = `
+mutation deleteWorkspace ($id: ObjectId!) {
+	resultStatus: deleteWorkspace(id: $id) {
 		... ResultStatus
 	}
 }
@@ -13630,21 +20532,21 @@ fragment ResultStatus on ResultStatus {
 }
 `
 
-func deleteAppDataSource(
+func deleteWorkspace(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteAppDataSourceResponse, error) {
+) (*deleteWorkspaceResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteAppDataSource",
-		Query:  deleteAppDataSource_Operation,
-		Variables: &__deleteAppDataSourceInput{
+		OpName: "deleteWorkspace",
+		Query:  deleteWorkspace_Operation,
+		Variables: &__deleteWorkspaceInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteAppDataSourceResponse
+	var data deleteWorkspaceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13656,35 +20558,37 @@ func deleteAppDataSource(
 	return &data, err
 }
 
-// The query or mutation executed by deleteBoard.
-const deleteBoard_Operation = `
-mutation deleteBoard ($id: ObjectId!) {
-	resultStatus: deleteBoard(id: $id) {
-		... ResultStatus
+// The query or mutation executed by estimateRematerializationCost.
+const estimateRematerializationCost_Operation = `
+query estimateRematerializationCost ($job: RematerializationInput!) {
+	estimates: estimateRematerializationCost(job: $job) {
+		... DatasetCostEstimate
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment DatasetCostEstimate on DatasetCostEstimate {
+	datasetId
+	absoluteCostEstimate
+	additionalCostEstimate
+	confidenceAbsoluteCostEstimate
+	confidenceAdditionalCostEstimate
 }
 `
 
-func deleteBoard(
+func estimateRematerializationCost(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteBoardResponse, error) {
+	job RematerializationInput,
+) (*estimateRematerializationCostResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteBoard",
-		Query:  deleteBoard_Operation,
-		Variables: &__deleteBoardInput{
-			Id: id,
+		OpName: "estimateRematerializationCost",
+		Query:  estimateRematerializationCost_Operation,
+		Variables: &__estimateRematerializationCostInput{
+			Job: job,
 		},
 	}
 	var err error
 
-	var data deleteBoardResponse
+	var data estimateRematerializationCostResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13696,35 +20600,45 @@ func deleteBoard(
 	return &data, err
 }
 
-// The query or mutation executed by deleteBookmark.
-const deleteBookmark_Operation = `
-mutation deleteBookmark ($id: ObjectId!) {
-	resultStatus: deleteBookmark(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getAccelerationJob.
+const getAccelerationJob_Operation = `
+query getAccelerationJob ($jobId: String!) {
+	job: accelerationJobStatus(jobId: $jobId) {
+		... AccelerationJob
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment AccelerationJob on AccelerationJob {
+	jobId
+	context
+	createdDate
+	state
+	stateLastUpdatedDate
+	progress
+	credits
+	datasetStatuses {
+		datasetId
+		isDirect
+		progress
+		credits
+	}
 }
 `
 
-func deleteBookmark(
+func getAccelerationJob(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteBookmarkResponse, error) {
+	jobId string,
+) (*getAccelerationJobResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteBookmark",
-		Query:  deleteBookmark_Operation,
-		Variables: &__deleteBookmarkInput{
-			Id: id,
+		OpName: "getAccelerationJob",
+		Query:  getAccelerationJob_Operation,
+		Variables: &__getAccelerationJobInput{
+			JobId: jobId,
 		},
 	}
 	var err error
 
-	var data deleteBookmarkResponse
+	var data getAccelerationJobResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13736,35 +20650,42 @@ func deleteBookmark(
 	return &data, err
 }
 
-// The query or mutation executed by deleteBookmarkGroup.
-const deleteBookmarkGroup_Operation = `
-mutation deleteBookmarkGroup ($id: ObjectId!) {
-	resultStatus: deleteBookmarkGroup(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getApiToken.
+const getApiToken_Operation = `
+query getApiToken ($id: String!) {
+	apiToken: authtoken(id: $id) {
+		... ApiToken
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment ApiToken on Authtoken {
+	id
+	name
+	description
+	disabled
+	expiration
+	extensionSeconds
+	kind
+	user
+	createdDate
+	updatedDate
 }
 `
 
-func deleteBookmarkGroup(
+func getApiToken(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteBookmarkGroupResponse, error) {
+) (*getApiTokenResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteBookmarkGroup",
-		Query:  deleteBookmarkGroup_Operation,
-		Variables: &__deleteBookmarkGroupInput{
+		OpName: "getApiToken",
+		Query:  getApiToken_Operation,
+		Variables: &__getApiTokenInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteBookmarkGroupResponse
+	var data getApiTokenResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13776,35 +20697,47 @@ func deleteBookmarkGroup(
 	return &data, err
 }
 
-// The query or mutation executed by deleteChannel.
-const deleteChannel_Operation = `
-mutation deleteChannel ($id: ObjectId!) {
-	resultStatus: deleteChannel(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getApp.
+const getApp_Operation = `
+query getApp ($id: ObjectId!) {
+	app(id: $id) {
+		... App
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment App on App {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	folderId
+	config {
+		moduleId
+		version
+	}
+	status {
+		state
+		internalError
+	}
+	outputs
 }
 `
 
-func deleteChannel(
+func getApp(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteChannelResponse, error) {
+) (*getAppResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteChannel",
-		Query:  deleteChannel_Operation,
-		Variables: &__deleteChannelInput{
+		OpName: "getApp",
+		Query:  getApp_Operation,
+		Variables: &__getAppInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteChannelResponse
+	var data getAppResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13816,35 +20749,40 @@ func deleteChannel(
 	return &data, err
 }
 
-// The query or mutation executed by deleteChannelAction.
-const deleteChannelAction_Operation = `
-mutation deleteChannelAction ($id: ObjectId!) {
-	resultStatus: deleteChannelAction(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getAppDataSource.
+const getAppDataSource_Operation = `
+query getAppDataSource ($id: ObjectId!) {
+	appdatasource: appDataSource(id: $id) {
+		... AppDataSource
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment AppDataSource on AppDataSource {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	managedById
+	sourceUrl
+	instructions
 }
 `
 
-func deleteChannelAction(
+func getAppDataSource(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteChannelActionResponse, error) {
+) (*getAppDataSourceResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteChannelAction",
-		Query:  deleteChannelAction_Operation,
-		Variables: &__deleteChannelActionInput{
+		OpName: "getAppDataSource",
+		Query:  getAppDataSource_Operation,
+		Variables: &__getAppDataSourceInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteChannelActionResponse
+	var data getAppDataSourceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13856,35 +20794,38 @@ func deleteChannelAction(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDashboard.
-const deleteDashboard_Operation = `
-mutation deleteDashboard ($id: ObjectId!) {
-	resultStatus: deleteDashboard(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getBoard.
+const getBoard_Operation = `
+query getBoard ($id: ObjectId!) {
+	board: getBoard(id: $id) {
+		... Board
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Board on Board {
+	id
+	datasetId
+	name
+	type
+	boardJson: board
+	source
 }
 `
 
-func deleteDashboard(
+func getBoard(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteDashboardResponse, error) {
+) (*getBoardResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDashboard",
-		Query:  deleteDashboard_Operation,
-		Variables: &__deleteDashboardInput{
+		OpName: "getBoard",
+		Query:  getBoard_Operation,
+		Variables: &__getBoardInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteDashboardResponse
+	var data getBoardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13896,35 +20837,39 @@ func deleteDashboard(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDashboardLink.
-const deleteDashboardLink_Operation = `
-mutation deleteDashboardLink ($id: ObjectId!) {
-	resultStatus: deleteDashboardLink(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getBookmark.
+const getBookmark_Operation = `
+query getBookmark ($id: ObjectId!) {
+	bookmark(id: $id) {
+		... Bookmark
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Bookmark on Bookmark {
+	id
+	name
+	iconUrl
+	targetId
+	targetIdKind
+	groupId
+	bookmarkKind
 }
 `
 
-func deleteDashboardLink(
+func getBookmark(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteDashboardLinkResponse, error) {
+) (*getBookmarkResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDashboardLink",
-		Query:  deleteDashboardLink_Operation,
-		Variables: &__deleteDashboardLinkInput{
+		OpName: "getBookmark",
+		Query:  getBookmark_Operation,
+		Variables: &__getBookmarkInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteDashboardLinkResponse
+	var data getBookmarkResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13936,37 +20881,38 @@ func deleteDashboardLink(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDataset.
-const deleteDataset_Operation = `
-mutation deleteDataset ($id: ObjectId!, $dep: DependencyHandlingInput) {
-	resultStatus: deleteDataset(dsid: $id, dependencyHandling: $dep) {
-		... ResultStatus
+// The query or mutation executed by getBookmarkGroup.
+const getBookmarkGroup_Operation = `
+query getBookmarkGroup ($id: ObjectId!) {
+	bookmarkGroup(id: $id) {
+		... BookmarkGroup
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment BookmarkGroup on BookmarkGroup {
+	id
+	name
+	description
+	iconUrl
+	workspaceId
+	isHome
 }
 `
 
-func deleteDataset(
+func getBookmarkGroup(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-	dep *DependencyHandlingInput,
-) (*deleteDatasetResponse, error) {
+) (*getBookmarkGroupResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDataset",
-		Query:  deleteDataset_Operation,
-		Variables: &__deleteDatasetInput{
-			Id:  id,
-			Dep: dep,
+		OpName: "getBookmarkGroup",
+		Query:  getBookmarkGroup_Operation,
+		Variables: &__getBookmarkGroupInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteDatasetResponse
+	var data getBookmarkGroupResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -13978,35 +20924,40 @@ func deleteDataset(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDatasetOutboundShare.
-const deleteDatasetOutboundShare_Operation = `
-mutation deleteDatasetOutboundShare ($id: ObjectId!) {
-	resultStatus: deleteDatasetOutboundShare(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getChannel.
+const getChannel_Operation = `
+query getChannel ($id: ObjectId!) {
+	channel: getChannel(id: $id) {
+		... Channel
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Channel on Channel {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	monitors {
+		id
+	}
 }
 `
 
-func deleteDatasetOutboundShare(
+func getChannel(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteDatasetOutboundShareResponse, error) {
+) (*getChannelResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDatasetOutboundShare",
-		Query:  deleteDatasetOutboundShare_Operation,
-		Variables: &__deleteDatasetOutboundShareInput{
+		OpName: "getChannel",
+		Query:  getChannel_Operation,
+		Variables: &__getChannelInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteDatasetOutboundShareResponse
+	var data getChannelResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14018,35 +20969,59 @@ func deleteDatasetOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDatastream.
-const deleteDatastream_Operation = `
-mutation deleteDatastream ($id: ObjectId!) {
-	resultStatus: deleteDatastream(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getChannelAction.
+const getChannelAction_Operation = `
+query getChannelAction ($id: ObjectId!) {
+	channelAction: getChannelAction(id: $id) {
+		__typename
+		... ChannelAction
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment ChannelAction on ChannelAction {
+	id
+	name
+	iconUrl
+	description
+	notifyOnClose
+	workspaceId
+	channels {
+		id
+	}
+	rateLimit
+	__typename
+	... on WebhookAction {
+		urlTemplate
+		bodyTemplate
+		method
+		headers {
+			header
+			valueTemplate
+		}
+	}
+	... on EmailAction {
+		targetAddresses
+		subjectTemplate
+		bodyTemplate
+		isHtml
+	}
 }
 `
 
-func deleteDatastream(
+func getChannelAction(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteDatastreamResponse, error) {
+) (*getChannelActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDatastream",
-		Query:  deleteDatastream_Operation,
-		Variables: &__deleteDatastreamInput{
+		OpName: "getChannelAction",
+		Query:  getChannelAction_Operation,
+		Variables: &__getChannelActionInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteDatastreamResponse
+	var data getChannelActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14058,35 +21033,33 @@ func deleteDatastream(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDatastreamToken.
-const deleteDatastreamToken_Operation = `
-mutation deleteDatastreamToken ($id: String!) {
-	resultStatus: deleteDatastreamToken(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getCloudInfo.
+const getCloudInfo_Operation = `
+query getCloudInfo {
+	cloud: currentCustomer {
+		cloudInfo {
+			... CloudInfo
+		}
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment CloudInfo on CloudInfo {
+	accountId
+	region
+	provider
 }
 `
 
-func deleteDatastreamToken(
+func getCloudInfo(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteDatastreamTokenResponse, error) {
+) (*getCloudInfoResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDatastreamToken",
-		Query:  deleteDatastreamToken_Operation,
-		Variables: &__deleteDatastreamTokenInput{
-			Id: id,
-		},
+		OpName: "getCloudInfo",
+		Query:  getCloudInfo_Operation,
 	}
 	var err error
 
-	var data deleteDatastreamTokenResponse
+	var data getCloudInfoResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14098,35 +21071,34 @@ func deleteDatastreamToken(
 	return &data, err
 }
 
-// The query or mutation executed by deleteDeferredForeignKey.
-const deleteDeferredForeignKey_Operation = `
-mutation deleteDeferredForeignKey ($id: ObjectId!) {
-	resultStatus: deleteDeferredForeignKey(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getCurrentCustomer.
+const getCurrentCustomer_Operation = `
+query getCurrentCustomer {
+	customer: currentCustomer {
+		users {
+			... User
+		}
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment User on User {
+	id
+	email
+	comment
+	label
 }
 `
 
-func deleteDeferredForeignKey(
+func getCurrentCustomer(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteDeferredForeignKeyResponse, error) {
+) (*getCurrentCustomerResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteDeferredForeignKey",
-		Query:  deleteDeferredForeignKey_Operation,
-		Variables: &__deleteDeferredForeignKeyInput{
-			Id: id,
-		},
+		OpName: "getCurrentCustomer",
+		Query:  getCurrentCustomer_Operation,
 	}
 	var err error
 
-	var data deleteDeferredForeignKeyResponse
+	var data getCurrentCustomerResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14138,35 +21110,105 @@ func deleteDeferredForeignKey(
 	return &data, err
 }
 
-// The query or mutation executed by deleteFiledrop.
-const deleteFiledrop_Operation = `
-mutation deleteFiledrop ($id: ObjectId!) {
-	resultStatus: deleteFiledrop(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getDashboard.
+const getDashboard_Operation = `
+query getDashboard ($id: ObjectId!) {
+	dashboard(id: $id) {
+		... Dashboard
+	}
+}
+fragment Dashboard on Dashboard {
+	id
+	name
+	iconUrl
+	workspaceId
+	managedById
+	folderId
+	layout
+	stages {
+		id
+		input {
+			inputName
+			inputRole
+			datasetId
+			datasetPath
+			stageId
+		}
+		params
+		layout
+		pipeline
+	}
+	parameters {
+		id
+		name
+		defaultValue {
+			... valueFields
+		}
+		valueKind {
+			type
+			keyForDatasetId
+			arrayItemType {
+				type
+				keyForDatasetId
+			}
+		}
+	}
+	parameterValues {
+		id
+		value {
+			... valueFields
+		}
+	}
+}
+fragment valueFields on Value {
+	bool
+	float64
+	int64
+	string
+	array {
+		value {
+			... primitiveValueFields
+		}
+	}
+	link {
+		datasetId
+		primaryKeyValue {
+			name
+			value {
+				... primitiveValueFields
+			}
+		}
+		storedLabel
+	}
+	datasetref {
+		datasetId
+		datasetPath
+		stageId
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment primitiveValueFields on PrimitiveValue {
+	bool
+	float64
+	int64
+	string
 }
 `
 
-func deleteFiledrop(
+func getDashboard(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteFiledropResponse, error) {
+) (*getDashboardResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteFiledrop",
-		Query:  deleteFiledrop_Operation,
-		Variables: &__deleteFiledropInput{
+		OpName: "getDashboard",
+		Query:  getDashboard_Operation,
+		Variables: &__getDashboardInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteFiledropResponse
+	var data getDashboardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14178,35 +21220,42 @@ func deleteFiledrop(
 	return &data, err
 }
 
-// The query or mutation executed by deleteFolder.
-const deleteFolder_Operation = `
-mutation deleteFolder ($id: ObjectId!) {
-	resultStatus: deleteFolder(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getDashboardLink.
+const getDashboardLink_Operation = `
+query getDashboardLink ($id: ObjectId!) {
+	dashboardLink(id: $id) {
+		... DashboardLink
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment DashboardLink on DashboardLink {
+	id
+	name
+	workspaceId
+	folderId
+	managedById
+	description
+	fromDashboard: fromDashboardId
+	toDashboard: toDashboardId
+	fromCard
+	linkLabel
 }
 `
 
-func deleteFolder(
+func getDashboardLink(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteFolderResponse, error) {
+) (*getDashboardLinkResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteFolder",
-		Query:  deleteFolder_Operation,
-		Variables: &__deleteFolderInput{
+		OpName: "getDashboardLink",
+		Query:  getDashboardLink_Operation,
+		Variables: &__getDashboardLinkInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteFolderResponse
+	var data getDashboardLinkResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14218,37 +21267,52 @@ func deleteFolder(
 	return &data, err
 }
 
-// The query or mutation executed by deleteLayeredSettingRecord.
-const deleteLayeredSettingRecord_Operation = `
-mutation deleteLayeredSettingRecord ($id: ObjectId!) {
-	deleteLayeredSettingRecord(id: $id) {
-		resultStatus: result {
-			... ResultStatus
-		}
+// The query or mutation executed by getDataConnection.
+const getDataConnection_Operation = `
+query getDataConnection ($id: ObjectId!) {
+	dataConnection(id: $id) {
+		... DataConnection
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment DataConnection on DataConnection {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	moduleID
+	version
+	variables {
+		... DataVariable
+	}
+	outputs {
+		name
+		target
+	}
+}
+fragment DataVariable on DataVariable {
+	name
+	value
+	sensitive
 }
 `
 
-func deleteLayeredSettingRecord(
+func getDataConnection(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteLayeredSettingRecordResponse, error) {
+) (*getDataConnectionResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteLayeredSettingRecord",
-		Query:  deleteLayeredSettingRecord_Operation,
-		Variables: &__deleteLayeredSettingRecordInput{
+		OpName: "getDataConnection",
+		Query:  getDataConnection_Operation,
+		Variables: &__getDataConnectionInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteLayeredSettingRecordResponse
+	var data getDataConnectionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14260,35 +21324,114 @@ func deleteLayeredSettingRecord(
 	return &data, err
 }
 
-// The query or mutation executed by deleteMonitor.
-const deleteMonitor_Operation = `
-mutation deleteMonitor ($id: ObjectId!) {
-	resultStatus: deleteMonitor(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getDataset.
+const getDataset_Operation = `
+query getDataset ($id: ObjectId!) {
+	dataset(id: $id) {
+		... Dataset
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Dataset on Dataset {
+	workspaceId
+	id
+	name
+	freshnessDesired
+	description
+	iconUrl
+	accelerationDisabled
+	version
+	updatedDate
+	pathCost
+	source
+	managedById
+	onDemandMaterializationLength
+	dataTableViewState
+	foreignKeys {
+		label
+		targetDataset
+		srcFields
+		dstFields
+	}
+	transform {
+		current {
+			query {
+				outputStage
+				stages {
+					... StageQuery
+				}
+			}
+		}
+	}
+	typedef {
+		label
+		def {
+			anykey
+			fields {
+				name
+				type {
+					rep
+					nullable
+				}
+				isEnum
+				isSearchable
+				isHidden
+				isConst
+				isMetric
+			}
+		}
+	}
+	sourceTable {
+		schema
+		partitions {
+			name
+		}
+		sourceUpdateTableName
+		isInsertOnly
+		batchSeqField
+		validFromField
+		fields {
+			name
+			sqlType
+		}
+	}
+	correlationTagMappings {
+		tag
+		path {
+			column
+			path
+		}
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
 }
 `
 
-func deleteMonitor(
+func getDataset(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteMonitorResponse, error) {
+) (*getDatasetResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteMonitor",
-		Query:  deleteMonitor_Operation,
-		Variables: &__deleteMonitorInput{
+		OpName: "getDataset",
+		Query:  getDataset_Operation,
+		Variables: &__getDatasetInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteMonitorResponse
+	var data getDatasetResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14300,35 +21443,52 @@ func deleteMonitor(
 	return &data, err
 }
 
-// The query or mutation executed by deleteMonitorAction.
-const deleteMonitorAction_Operation = `
-mutation deleteMonitorAction ($id: ObjectId!) {
-	resultStatus: deleteMonitorAction(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getDatasetAccelerationStatus.
+const getDatasetAccelerationStatus_Operation = `
+query getDatasetAccelerationStatus ($datasetId: ObjectId!) {
+	dataset(id: $datasetId) {
+		accelerationInfo {
+			... DatasetAccelerationInfo
+		}
+	}
+	status: datasetAccelerationStatus(datasetId: $datasetId) {
+		progress
+		runningJobs {
+			jobId
+		}
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment DatasetAccelerationInfo on AccelerationInfo {
+	state
+	stalenessSeconds
+	targetStalenessSeconds
+	configuredTargetStalenessSeconds
+	freshnessTime
+	errors {
+		datasetId
+		datasetName
+		transformId
+		time
+		errorText
+	}
 }
 `
 
-func deleteMonitorAction(
+func getDatasetAccelerationStatus(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteMonitorActionResponse, error) {
+	datasetId string,
+) (*getDatasetAccelerationStatusResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteMonitorAction",
-		Query:  deleteMonitorAction_Operation,
-		Variables: &__deleteMonitorActionInput{
-			Id: id,
+		OpName: "getDatasetAccelerationStatus",
+		Query:  getDatasetAccelerationStatus_Operation,
+		Variables: &__getDatasetAccelerationStatusInput{
+			DatasetId: datasetId,
 		},
 	}
 	var err error
 
-	var data deleteMonitorActionResponse
+	var data getDatasetAccelerationStatusResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14340,35 +21500,36 @@ func deleteMonitorAction(
 	return &data, err
 }
 
-// The query or mutation executed by deleteMonitorActionAttachment.
-const deleteMonitorActionAttachment_Operation = `
-mutation deleteMonitorActionAttachment ($id: ObjectId!) {
-	resultStatus: deleteMonitorActionAttachment(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getDatasetCorrelationTags.
+const getDatasetCorrelationTags_Operation = `
+query getDatasetCorrelationTags ($datasetId: ObjectId!) {
+	correlationTags: dataset(id: $datasetId) {
+		correlationTagMappings {
+			tag
+			path {
+				column
+				path
+			}
+		}
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
-}
 `
 
-func deleteMonitorActionAttachment(
+func getDatasetCorrelationTags(
 	ctx context.Context,
-	client graphql.Client,
-	id string,
-) (*deleteMonitorActionAttachmentResponse, error) {
+	client graphql.Client,
+	datasetId string,
+) (*getDatasetCorrelationTagsResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteMonitorActionAttachment",
-		Query:  deleteMonitorActionAttachment_Operation,
-		Variables: &__deleteMonitorActionAttachmentInput{
-			Id: id,
+		OpName: "getDatasetCorrelationTags",
+		Query:  getDatasetCorrelationTags_Operation,
+		Variables: &__getDatasetCorrelationTagsInput{
+			DatasetId: datasetId,
 		},
 	}
 	var err error
 
-	var data deleteMonitorActionAttachmentResponse
+	var data getDatasetCorrelationTagsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14380,35 +21541,86 @@ func deleteMonitorActionAttachment(
 	return &data, err
 }
 
-// The query or mutation executed by deleteMonitorV2.
-const deleteMonitorV2_Operation = `
-mutation deleteMonitorV2 ($id: ObjectId!) {
-	resultStatus: deleteMonitorV2(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getDatasetDoctorReport.
+const getDatasetDoctorReport_Operation = `
+query getDatasetDoctorReport ($datasetId: ObjectId!, $upLevels: Int) {
+	report: datasetDoctor(dsid: $datasetId, upLevels: $upLevels) {
+		doctorForDataset
+		datasets {
+			... DatasetReport
+		}
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment DatasetReport on DatasetReport {
+	datasetId
+	datasetLabel
+	doctorComments
+	stageNotes {
+		stageId
+		errors {
+			... PipelineSymbol
+		}
+		warnings {
+			symbol {
+				... PipelineSymbol
+			}
+		}
+	}
+	inputDatasets
+	accelerationInfo {
+		... DatasetAccelerationInfo
+	}
+	ongoingErrorReason {
+		text
+		time
+	}
+	backfillErrorReason {
+		text
+		time
+	}
+}
+fragment PipelineSymbol on PipelineSymbol {
+	comment
+	span {
+		start {
+			row
+			col
+		}
+	}
+}
+fragment DatasetAccelerationInfo on AccelerationInfo {
+	state
+	stalenessSeconds
+	targetStalenessSeconds
+	configuredTargetStalenessSeconds
+	freshnessTime
+	errors {
+		datasetId
+		datasetName
+		transformId
+		time
+		errorText
+	}
 }
 `
 
-func deleteMonitorV2(
+func getDatasetDoctorReport(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteMonitorV2Response, error) {
+	datasetId string,
+	upLevels *int,
+) (*getDatasetDoctorReportResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteMonitorV2",
-		Query:  deleteMonitorV2_Operation,
-		Variables: &__deleteMonitorV2Input{
-			Id: id,
+		OpName: "getDatasetDoctorReport",
+		Query:  getDatasetDoctorReport_Operation,
+		Variables: &__getDatasetDoctorReportInput{
+			DatasetId: datasetId,
+			UpLevels:  upLevels,
 		},
 	}
 	var err error
 
-	var data deleteMonitorV2Response
+	var data getDatasetDoctorReportResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14420,35 +21632,46 @@ func deleteMonitorV2(
 	return &data, err
 }
 
-// The query or mutation executed by deleteMonitorV2Action.
-const deleteMonitorV2Action_Operation = `
-mutation deleteMonitorV2Action ($id: ObjectId!) {
-	resultStatus: deleteMonitorV2Action(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getDatasetOutboundShare.
+const getDatasetOutboundShare_Operation = `
+query getDatasetOutboundShare ($id: ObjectId!) {
+	datasetOutboundShare(id: $id) {
+		... DatasetOutboundShare
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment DatasetOutboundShare on DatasetOutboundShare {
+	id
+	name
+	description
+	workspaceId
+	folderId
+	datasetID
+	outboundShareID
+	schemaName
+	viewName
+	freshnessGoal
+	status {
+		state
+		error
+	}
 }
 `
 
-func deleteMonitorV2Action(
+func getDatasetOutboundShare(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteMonitorV2ActionResponse, error) {
+) (*getDatasetOutboundShareResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteMonitorV2Action",
-		Query:  deleteMonitorV2Action_Operation,
-		Variables: &__deleteMonitorV2ActionInput{
+		OpName: "getDatasetOutboundShare",
+		Query:  getDatasetOutboundShare_Operation,
+		Variables: &__getDatasetOutboundShareInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteMonitorV2ActionResponse
+	var data getDatasetOutboundShareResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14460,35 +21683,42 @@ func deleteMonitorV2Action(
 	return &data, err
 }
 
-// The query or mutation executed by deletePoller.
-const deletePoller_Operation = `
-mutation deletePoller ($id: ObjectId!) {
-	resultStatus: deletePoller(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getDatasetQueryOutput.
+const getDatasetQueryOutput_Operation = `
+query getDatasetQueryOutput ($query: [StageInput!]!, $params: QueryParams!) {
+	taskResult: datasetQueryOutput(query: $query, params: $params) {
+		... TaskResult
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment TaskResult on TaskResult {
+	queryId
+	stageId
+	startTime
+	endTime
+	resultCursor
+	resultSchema {
+		typedefDefinition
+	}
 }
 `
 
-func deletePoller(
+func getDatasetQueryOutput(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deletePollerResponse, error) {
+	query []*StageInput,
+	params QueryParams,
+) (*getDatasetQueryOutputResponse, error) {
 	req := &graphql.Request{
-		OpName: "deletePoller",
-		Query:  deletePoller_Operation,
-		Variables: &__deletePollerInput{
-			Id: id,
+		OpName: "getDatasetQueryOutput",
+		Query:  getDatasetQueryOutput_Operation,
+		Variables: &__getDatasetQueryOutputInput{
+			Query:  query,
+			Params: params,
 		},
 	}
 	var err error
 
-	var data deletePollerResponse
+	var data getDatasetQueryOutputResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14500,35 +21730,56 @@ func deletePoller(
 	return &data, err
 }
 
-// The query or mutation executed by deletePreferredPath.
-const deletePreferredPath_Operation = `
-mutation deletePreferredPath ($id: ObjectId!) {
-	resultStatus: deletePreferredPath(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getDatasource.
+const getDatasource_Operation = `
+query getDatasource ($id: ObjectId!) {
+	datasource(id: $id) {
+		... Datasource
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Datasource on Datasource {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	dataConnectionID
+	datastreamID
+	datastreamTokenID
+	type
+	status {
+		state
+	}
+	variables {
+		... DataVariable
+	}
+	clientStackAttributes {
+		... DataVariable
+	}
+}
+fragment DataVariable on DataVariable {
+	name
+	value
+	sensitive
 }
 `
 
-func deletePreferredPath(
+func getDatasource(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deletePreferredPathResponse, error) {
+) (*getDatasourceResponse, error) {
 	req := &graphql.Request{
-		OpName: "deletePreferredPath",
-		Query:  deletePreferredPath_Operation,
-		Variables: &__deletePreferredPathInput{
+		OpName: "getDatasource",
+		Query:  getDatasource_Operation,
+		Variables: &__getDatasourceInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deletePreferredPathResponse
+	var data getDatasourceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14540,35 +21791,38 @@ func deletePreferredPath(
 	return &data, err
 }
 
-// The query or mutation executed by deleteRbacGroup.
-const deleteRbacGroup_Operation = `
-mutation deleteRbacGroup ($id: ORN!) {
-	resultStatus: deleteRbacGroup(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getDatastream.
+const getDatastream_Operation = `
+query getDatastream ($id: ObjectId!) {
+	datastream(id: $id) {
+		... Datastream
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Datastream on Datastream {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	datasetId
 }
 `
 
-func deleteRbacGroup(
+func getDatastream(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteRbacGroupResponse, error) {
+) (*getDatastreamResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteRbacGroup",
-		Query:  deleteRbacGroup_Operation,
-		Variables: &__deleteRbacGroupInput{
+		OpName: "getDatastream",
+		Query:  getDatastream_Operation,
+		Variables: &__getDatastreamInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteRbacGroupResponse
+	var data getDatastreamResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14580,35 +21834,38 @@ func deleteRbacGroup(
 	return &data, err
 }
 
-// The query or mutation executed by deleteRbacGroupmember.
-const deleteRbacGroupmember_Operation = `
-mutation deleteRbacGroupmember ($id: ORN!) {
-	resultStatus: deleteRbacGroupmember(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getDatastreamToken.
+const getDatastreamToken_Operation = `
+query getDatastreamToken ($id: String!) {
+	datastreamToken(id: $id) {
+		... DatastreamToken
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment DatastreamToken on DatastreamToken {
+	id
+	name
+	description
+	disabled
+	datastreamId
+	secret
 }
 `
 
-func deleteRbacGroupmember(
+func getDatastreamToken(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteRbacGroupmemberResponse, error) {
+) (*getDatastreamTokenResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteRbacGroupmember",
-		Query:  deleteRbacGroupmember_Operation,
-		Variables: &__deleteRbacGroupmemberInput{
+		OpName: "getDatastreamToken",
+		Query:  getDatastreamToken_Operation,
+		Variables: &__getDatastreamTokenInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteRbacGroupmemberResponse
+	var data getDatastreamTokenResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14620,35 +21877,28 @@ func deleteRbacGroupmember(
 	return &data, err
 }
 
-// The query or mutation executed by deleteRbacStatement.
-const deleteRbacStatement_Operation = `
-mutation deleteRbacStatement ($id: ORN!) {
-	resultStatus: deleteRbacStatement(id: $id) {
-		... ResultStatus
-	}
-}
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+// The query or mutation executed by getDefaultDashboard.
+const getDefaultDashboard_Operation = `
+query getDefaultDashboard ($dsid: ObjectId!) {
+	defaultDashboard(dsid: $dsid)
 }
 `
 
-func deleteRbacStatement(
+func getDefaultDashboard(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteRbacStatementResponse, error) {
-	req := &graphql.Request{
-		OpName: "deleteRbacStatement",
-		Query:  deleteRbacStatement_Operation,
-		Variables: &__deleteRbacStatementInput{
-			Id: id,
+	dsid string,
+) (*getDefaultDashboardResponse, error) {
+	req := &graphql.Request{
+		OpName: "getDefaultDashboard",
+		Query:  getDefaultDashboard_Operation,
+		Variables: &__getDefaultDashboardInput{
+			Dsid: dsid,
 		},
 	}
 	var err error
 
-	var data deleteRbacStatementResponse
+	var data getDefaultDashboardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14660,35 +21910,50 @@ func deleteRbacStatement(
 	return &data, err
 }
 
-// The query or mutation executed by deleteSnowflakeOutboundShare.
-const deleteSnowflakeOutboundShare_Operation = `
-mutation deleteSnowflakeOutboundShare ($id: ObjectId!) {
-	resultStatus: deleteSnowflakeOutboundShare(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getDeferredForeignKey.
+const getDeferredForeignKey_Operation = `
+query getDeferredForeignKey ($id: ObjectId!) {
+	deferredForeignKey(id: $id) {
+		... DeferredForeignKey
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment DeferredForeignKey on DeferredForeignKey {
+	id
+	workspaceId
+	sourceDataset {
+		datasetId
+	}
+	targetDataset {
+		datasetId
+	}
+	srcFields
+	dstFields
+	label
+	resolution {
+		sourceId
+		targetId
+	}
+	status {
+		errorText
+	}
 }
 `
 
-func deleteSnowflakeOutboundShare(
+func getDeferredForeignKey(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteSnowflakeOutboundShareResponse, error) {
+) (*getDeferredForeignKeyResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteSnowflakeOutboundShare",
-		Query:  deleteSnowflakeOutboundShare_Operation,
-		Variables: &__deleteSnowflakeOutboundShareInput{
+		OpName: "getDeferredForeignKey",
+		Query:  getDeferredForeignKey_Operation,
+		Variables: &__getDeferredForeignKeyInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteSnowflakeOutboundShareResponse
+	var data getDeferredForeignKeyResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14700,35 +21965,56 @@ func deleteSnowflakeOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by deleteWorksheet.
-const deleteWorksheet_Operation = `
-mutation deleteWorksheet ($id: ObjectId!) {
-	resultStatus: deleteWorksheet(wks: $id) {
-		... ResultStatus
+// The query or mutation executed by getFiledrop.
+const getFiledrop_Operation = `
+query getFiledrop ($id: ObjectId!) {
+	filedrop(id: $id) {
+		... Filedrop
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Filedrop on Filedrop {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	status
+	datastreamID
+	config {
+		provider {
+			__typename
+			... on FiledropProviderAwsConfig {
+				region
+				roleArn
+			}
+		}
+	}
+	endpoint {
+		__typename
+		... on FiledropS3Endpoint {
+			arn
+			bucket
+			prefix
+		}
+	}
 }
 `
 
-func deleteWorksheet(
+func getFiledrop(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteWorksheetResponse, error) {
+) (*getFiledropResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteWorksheet",
-		Query:  deleteWorksheet_Operation,
-		Variables: &__deleteWorksheetInput{
+		OpName: "getFiledrop",
+		Query:  getFiledrop_Operation,
+		Variables: &__getFiledropInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteWorksheetResponse
+	var data getFiledropResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14740,35 +22026,42 @@ func deleteWorksheet(
 	return &data, err
 }
 
-// The query or mutation executed by deleteWorkspace.
-const deleteWorkspace_Operation = `
-mutation deleteWorkspace ($id: ObjectId!) {
-	resultStatus: deleteWorkspace(id: $id) {
-		... ResultStatus
+// The query or mutation executed by getFolder.
+const getFolder_Operation = `
+query getFolder ($id: ObjectId!) {
+	folder(id: $id) {
+		... Folder
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
+fragment Folder on Folder {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	effectiveSettings {
+		scanner {
+			powerLevel
+		}
+	}
 }
 `
 
-func deleteWorkspace(
+func getFolder(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*deleteWorkspaceResponse, error) {
+) (*getFolderResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteWorkspace",
-		Query:  deleteWorkspace_Operation,
-		Variables: &__deleteWorkspaceInput{
+		OpName: "getFolder",
+		Query:  getFolder_Operation,
+		Variables: &__getFolderInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data deleteWorkspaceResponse
+	var data getFolderResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14780,47 +22073,50 @@ func deleteWorkspace(
 	return &data, err
 }
 
-// The query or mutation executed by getApp.
-const getApp_Operation = `
-query getApp ($id: ObjectId!) {
-	app(id: $id) {
-		... App
+// The query or mutation executed by getIncident.
+const getIncident_Operation = `
+query getIncident ($id: ObjectId!) {
+	incident(id: $id) {
+		... Incident
 	}
 }
-fragment App on App {
+fragment Incident on Incident {
 	id
+	workspaceId
+	folderId
 	name
 	iconUrl
 	description
-	workspaceId
-	folderId
-	config {
-		moduleId
-		version
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
 	}
-	status {
-		state
-		internalError
+	slackChannels {
+		connectionID
+		slackchannelID
 	}
-	outputs
+	worksheets
+	dashboards
 }
 `
 
-func getApp(
+func getIncident(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getAppResponse, error) {
+) (*getIncidentResponse, error) {
 	req := &graphql.Request{
-		OpName: "getApp",
-		Query:  getApp_Operation,
-		Variables: &__getAppInput{
+		OpName: "getIncident",
+		Query:  getIncident_Operation,
+		Variables: &__getIncidentInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getAppResponse
+	var data getIncidentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14832,40 +22128,54 @@ func getApp(
 	return &data, err
 }
 
-// The query or mutation executed by getAppDataSource.
-const getAppDataSource_Operation = `
-query getAppDataSource ($id: ObjectId!) {
-	appdatasource: appDataSource(id: $id) {
-		... AppDataSource
+// The query or mutation executed by getIncidentsForStatus.
+const getIncidentsForStatus_Operation = `
+query getIncidentsForStatus ($status: IncidentStatus!, $startingAt: Time, $endingAt: Time) {
+	incidents: getIncidentsForStatus(s: $status, startingAt: $startingAt, endingAt: $endingAt) {
+		... Incident
 	}
 }
-fragment AppDataSource on AppDataSource {
+fragment Incident on Incident {
 	id
+	workspaceId
+	folderId
 	name
 	iconUrl
 	description
-	workspaceId
-	managedById
-	sourceUrl
-	instructions
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
+	}
+	worksheets
+	dashboards
 }
 `
 
-func getAppDataSource(
+func getIncidentsForStatus(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getAppDataSourceResponse, error) {
+	status IncidentStatus,
+	startingAt *types.TimeScalar,
+	endingAt *types.TimeScalar,
+) (*getIncidentsForStatusResponse, error) {
 	req := &graphql.Request{
-		OpName: "getAppDataSource",
-		Query:  getAppDataSource_Operation,
-		Variables: &__getAppDataSourceInput{
-			Id: id,
+		OpName: "getIncidentsForStatus",
+		Query:  getIncidentsForStatus_Operation,
+		Variables: &__getIncidentsForStatusInput{
+			Status:     status,
+			StartingAt: startingAt,
+			EndingAt:   endingAt,
 		},
 	}
 	var err error
 
-	var data getAppDataSourceResponse
+	var data getIncidentsForStatusResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14877,38 +22187,34 @@ func getAppDataSource(
 	return &data, err
 }
 
-// The query or mutation executed by getBoard.
-const getBoard_Operation = `
-query getBoard ($id: ObjectId!) {
-	board: getBoard(id: $id) {
-		... Board
+// The query or mutation executed by getIngestInfo.
+const getIngestInfo_Operation = `
+query getIngestInfo {
+	ingest: currentCustomer {
+		ingestInfo {
+			... IngestInfo
+		}
 	}
 }
-fragment Board on Board {
-	id
-	datasetId
-	name
-	type
-	boardJson: board
-	source
+fragment IngestInfo on IngestInfo {
+	collectUrl
+	domain
+	scheme
+	port
 }
 `
 
-func getBoard(
+func getIngestInfo(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getBoardResponse, error) {
+) (*getIngestInfoResponse, error) {
 	req := &graphql.Request{
-		OpName: "getBoard",
-		Query:  getBoard_Operation,
-		Variables: &__getBoardInput{
-			Id: id,
-		},
+		OpName: "getIngestInfo",
+		Query:  getIngestInfo_Operation,
 	}
 	var err error
 
-	var data getBoardResponse
+	var data getIngestInfoResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14920,39 +22226,112 @@ func getBoard(
 	return &data, err
 }
 
-// The query or mutation executed by getBookmark.
-const getBookmark_Operation = `
-query getBookmark ($id: ObjectId!) {
-	bookmark(id: $id) {
-		... Bookmark
+// The query or mutation executed by getInvestigationNotebook.
+const getInvestigationNotebook_Operation = `
+query getInvestigationNotebook ($id: ObjectId!) {
+	investigationNotebook(id: $id) {
+		... InvestigationNotebook
+	}
+}
+fragment InvestigationNotebook on InvestigationNotebook {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	incidentID
+	runbook {
+		url
+	}
+	blocks {
+		... NotebookBlock
+	}
+}
+fragment NotebookBlock on NotebookBlock {
+	type
+	id
+	properties {
+		markdown {
+			text
+		}
+		query {
+			description
+			query {
+				outputStage
+				stages {
+					... StageQuery
+				}
+			}
+		}
+		image {
+			base64
+			description
+		}
+		raiseIncident {
+			preview {
+				text
+			}
+			summary
+			severity
+			slack {
+				channelName
+			}
+			teams
+			incidentOwner
+			confirmation
+		}
+		ping {
+			preview {
+				text
+			}
+			user
+			confirmation
+		}
+		ticket {
+			preview {
+				text
+			}
+			name
+			description
+			priority
+			confirmation
+		}
+		o11yPlaceholder {
+			text
+		}
 	}
 }
-fragment Bookmark on Bookmark {
+fragment StageQuery on StageQuery {
 	id
-	name
-	iconUrl
-	targetId
-	targetIdKind
-	groupId
-	bookmarkKind
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
 }
 `
 
-func getBookmark(
+func getInvestigationNotebook(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getBookmarkResponse, error) {
+) (*getInvestigationNotebookResponse, error) {
 	req := &graphql.Request{
-		OpName: "getBookmark",
-		Query:  getBookmark_Operation,
-		Variables: &__getBookmarkInput{
+		OpName: "getInvestigationNotebook",
+		Query:  getInvestigationNotebook_Operation,
+		Variables: &__getInvestigationNotebookInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getBookmarkResponse
+	var data getInvestigationNotebookResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -14964,38 +22343,71 @@ func getBookmark(
 	return &data, err
 }
 
-// The query or mutation executed by getBookmarkGroup.
-const getBookmarkGroup_Operation = `
-query getBookmarkGroup ($id: ObjectId!) {
-	bookmarkGroup(id: $id) {
-		... BookmarkGroup
+// The query or mutation executed by getLayeredSettingRecord.
+const getLayeredSettingRecord_Operation = `
+query getLayeredSettingRecord ($id: ObjectId!) {
+	layeredSettingRecord(id: $id) {
+		... LayeredSettingRecord
 	}
 }
-fragment BookmarkGroup on BookmarkGroup {
+fragment LayeredSettingRecord on LayeredSettingRecord {
+	settingAndTargetScope {
+		... SettingAndTargetScope
+	}
+	value {
+		... PrimitiveValue
+	}
 	id
 	name
 	description
 	iconUrl
 	workspaceId
-	isHome
+	managedById
+	folderId
+}
+fragment SettingAndTargetScope on SettingAndTargetScope {
+	setting
+	target {
+		... LayeredSettingRecordTarget
+	}
+}
+fragment PrimitiveValue on PrimitiveValue {
+	bool
+	float64
+	int64
+	string
+	timestamp
+	duration
+}
+fragment LayeredSettingRecordTarget on LayeredSettingRecordTarget {
+	customerId
+	workspaceId
+	folderId
+	appId
+	monitorId
+	worksheetId
+	dashboardId
+	datasetId
+	datastreamId
+	userId
 }
 `
 
-func getBookmarkGroup(
+func getLayeredSettingRecord(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getBookmarkGroupResponse, error) {
+) (*getLayeredSettingRecordResponse, error) {
 	req := &graphql.Request{
-		OpName: "getBookmarkGroup",
-		Query:  getBookmarkGroup_Operation,
-		Variables: &__getBookmarkGroupInput{
+		OpName: "getLayeredSettingRecord",
+		Query:  getLayeredSettingRecord_Operation,
+		Variables: &__getLayeredSettingRecordInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getBookmarkGroupResponse
+	var data getLayeredSettingRecordResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15007,40 +22419,118 @@ func getBookmarkGroup(
 	return &data, err
 }
 
-// The query or mutation executed by getChannel.
-const getChannel_Operation = `
-query getChannel ($id: ObjectId!) {
-	channel: getChannel(id: $id) {
-		... Channel
+// The query or mutation executed by getMonitor.
+const getMonitor_Operation = `
+query getMonitor ($id: ObjectId!) {
+	monitor(id: $id) {
+		... Monitor
 	}
 }
-fragment Channel on Channel {
+fragment Monitor on Monitor {
+	workspaceId
 	id
 	name
-	iconUrl
 	description
-	workspaceId
-	monitors {
-		id
+	comment
+	iconUrl
+	isTemplate
+	disabled
+	freshnessGoal
+	useDefaultFreshness
+	source
+	definition
+	managedById
+	query {
+		outputStage
+		stages {
+			... StageQuery
+		}
+	}
+	rule {
+		__typename
+		sourceColumn
+		groupByGroups {
+			groupName
+			columns
+		}
+		... on MonitorRuleCount {
+			compareFunction
+			compareValues
+			lookbackTime
+		}
+		... on MonitorRuleChange {
+			changeType
+			compareFunction
+			compareValues
+			aggregateFunction
+			lookbackTime
+			baselineTime
+		}
+		... on MonitorRuleFacet {
+			facetFunction
+			facetValues
+			timeFunction
+			timeValue
+			lookbackTime
+		}
+		... on MonitorRuleThreshold {
+			compareFunction
+			compareValues
+			lookbackTime
+			thresholdAggFunction
+		}
+		... on MonitorRulePromote {
+			kindField
+			descriptionField
+			primaryKey
+		}
+		... on MonitorRuleLog {
+			compareFunction
+			compareValues
+			lookbackTime
+			expressionSummary
+			logStageId
+			sourceLogDatasetId
+		}
+	}
+	notificationSpec {
+		merge
+		importance
+		notifyOnReminder
+		reminderFrequency
+		notifyOnClose
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
 	}
 }
 `
 
-func getChannel(
+func getMonitor(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getChannelResponse, error) {
+) (*getMonitorResponse, error) {
 	req := &graphql.Request{
-		OpName: "getChannel",
-		Query:  getChannel_Operation,
-		Variables: &__getChannelInput{
+		OpName: "getMonitor",
+		Query:  getMonitor_Operation,
+		Variables: &__getMonitorInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getChannelResponse
+	var data getMonitorResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15052,59 +22542,57 @@ func getChannel(
 	return &data, err
 }
 
-// The query or mutation executed by getChannelAction.
-const getChannelAction_Operation = `
-query getChannelAction ($id: ObjectId!) {
-	channelAction: getChannelAction(id: $id) {
+// The query or mutation executed by getMonitorAction.
+const getMonitorAction_Operation = `
+query getMonitorAction ($id: ObjectId!) {
+	monitorAction(id: $id) {
 		__typename
-		... ChannelAction
+		... MonitorAction
 	}
 }
-fragment ChannelAction on ChannelAction {
+fragment MonitorAction on MonitorAction {
 	id
 	name
 	iconUrl
 	description
-	notifyOnClose
 	workspaceId
-	channels {
-		id
-	}
 	rateLimit
+	notifyOnClose
+	isPrivate
 	__typename
+	... on EmailAction {
+		targetAddresses
+		subjectTemplate
+		bodyTemplate
+		isHtml
+	}
 	... on WebhookAction {
 		urlTemplate
-		bodyTemplate
 		method
 		headers {
 			header
 			valueTemplate
 		}
-	}
-	... on EmailAction {
-		targetAddresses
-		subjectTemplate
 		bodyTemplate
-		isHtml
 	}
 }
 `
 
-func getChannelAction(
+func getMonitorAction(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getChannelActionResponse, error) {
+) (*getMonitorActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "getChannelAction",
-		Query:  getChannelAction_Operation,
-		Variables: &__getChannelActionInput{
+		OpName: "getMonitorAction",
+		Query:  getMonitorAction_Operation,
+		Variables: &__getMonitorActionInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getChannelActionResponse
+	var data getMonitorActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15116,33 +22604,39 @@ func getChannelAction(
 	return &data, err
 }
 
-// The query or mutation executed by getCloudInfo.
-const getCloudInfo_Operation = `
-query getCloudInfo {
-	cloud: currentCustomer {
-		cloudInfo {
-			... CloudInfo
-		}
+// The query or mutation executed by getMonitorActionAttachment.
+const getMonitorActionAttachment_Operation = `
+query getMonitorActionAttachment ($id: ObjectId!) {
+	monitorActionAttachment(id: $id) {
+		... MonitorActionAttachment
 	}
 }
-fragment CloudInfo on CloudInfo {
-	accountId
-	region
-	provider
-}
+fragment MonitorActionAttachment on MonitorActionAttachment {
+	monitorID
+	actionID
+	id
+	workspaceId
+	name
+	iconUrl
+	description
+}
 `
 
-func getCloudInfo(
+func getMonitorActionAttachment(
 	ctx context.Context,
 	client graphql.Client,
-) (*getCloudInfoResponse, error) {
+	id string,
+) (*getMonitorActionAttachmentResponse, error) {
 	req := &graphql.Request{
-		OpName: "getCloudInfo",
-		Query:  getCloudInfo_Operation,
+		OpName: "getMonitorActionAttachment",
+		Query:  getMonitorActionAttachment_Operation,
+		Variables: &__getMonitorActionAttachmentInput{
+			Id: id,
+		},
 	}
 	var err error
 
-	var data getCloudInfoResponse
+	var data getMonitorActionAttachmentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15154,34 +22648,60 @@ func getCloudInfo(
 	return &data, err
 }
 
-// The query or mutation executed by getCurrentCustomer.
-const getCurrentCustomer_Operation = `
-query getCurrentCustomer {
-	customer: currentCustomer {
-		users {
-			... User
-		}
+// The query or mutation executed by getMonitorMuteRule.
+const getMonitorMuteRule_Operation = `
+query getMonitorMuteRule ($id: ObjectId!) {
+	monitorMuteRule(id: $id) {
+		... MonitorMuteRule
 	}
 }
-fragment User on User {
+fragment MonitorMuteRule on MonitorMuteRule {
 	id
-	email
-	comment
-	label
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	startDate
+	duration
+	filter {
+		__typename
+		filterType
+		... on MonitorMuteRuleFilterPerColumn {
+			columnID
+			values
+		}
+		... on MonitorMuteRuleFilterPerResource {
+			resourceIds {
+				... MonitorMuteRuleResourceId
+			}
+		}
+	}
+}
+fragment MonitorMuteRuleResourceId on ResourceId {
+	datasetId
+	primaryKeyValue {
+		name
+		value
+	}
 }
 `
 
-func getCurrentCustomer(
+func getMonitorMuteRule(
 	ctx context.Context,
 	client graphql.Client,
-) (*getCurrentCustomerResponse, error) {
+	id string,
+) (*getMonitorMuteRuleResponse, error) {
 	req := &graphql.Request{
-		OpName: "getCurrentCustomer",
-		Query:  getCurrentCustomer_Operation,
+		OpName: "getMonitorMuteRule",
+		Query:  getMonitorMuteRule_Operation,
+		Variables: &__getMonitorMuteRuleInput{
+			Id: id,
+		},
 	}
 	var err error
 
-	var data getCurrentCustomerResponse
+	var data getMonitorMuteRuleResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15193,105 +22713,203 @@ func getCurrentCustomer(
 	return &data, err
 }
 
-// The query or mutation executed by getDashboard.
-const getDashboard_Operation = `
-query getDashboard ($id: ObjectId!) {
-	dashboard(id: $id) {
-		... Dashboard
+// The query or mutation executed by getMonitorV2.
+const getMonitorV2_Operation = `
+query getMonitorV2 ($id: ObjectId!) {
+	monitorV2(id: $id) {
+		... MonitorV2
 	}
 }
-fragment Dashboard on Dashboard {
+fragment MonitorV2 on MonitorV2 {
 	id
+	workspaceId
+	createdBy
+	createdDate
 	name
 	iconUrl
-	workspaceId
+	description
 	managedById
 	folderId
-	layout
-	stages {
-		id
-		input {
-			inputName
-			inputRole
-			datasetId
-			datasetPath
-			stageId
-		}
-		params
-		layout
-		pipeline
+	rollupStatus
+	ruleKind
+	definition {
+		... MonitorV2Definition
 	}
-	parameters {
-		id
-		name
-		defaultValue {
-			... valueFields
-		}
-		valueKind {
-			type
-			keyForDatasetId
-			arrayItemType {
-				type
-				keyForDatasetId
-			}
-		}
+	actionRules {
+		... MonitorV2ActionRule
 	}
-	parameterValues {
-		id
-		value {
-			... valueFields
-		}
+	mutes {
+		... MonitorV2MuteRule
 	}
 }
-fragment valueFields on Value {
-	bool
-	float64
-	int64
-	string
-	array {
-		value {
-			... primitiveValueFields
+fragment MonitorV2Definition on MonitorV2Definition {
+	inputQuery {
+		outputStage
+		stages {
+			... StageQuery
 		}
 	}
-	link {
-		datasetId
-		primaryKeyValue {
-			name
-			value {
-				... primitiveValueFields
-			}
+	rules {
+		... MonitorV2Rule
+	}
+	lookbackTime
+	dataStabilizationDelay
+	groupings {
+		... MonitorV2Column
+	}
+	scheduling {
+		... MonitorV2Scheduling
+	}
+}
+fragment MonitorV2ActionRule on MonitorV2ActionRule {
+	actionID
+	levels
+	sendEndNotifications
+	sendRemindersInterval
+}
+fragment MonitorV2MuteRule on MonitorV2MuteRule {
+	id
+	workspaceId
+	name
+	description
+	monitorID
+	schedule {
+		type
+		oneTime {
+			startTime
+			endTime
 		}
-		storedLabel
 	}
-	datasetref {
+	validFrom
+	validTo
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
 		datasetId
 		datasetPath
 		stageId
 	}
 }
-fragment primitiveValueFields on PrimitiveValue {
+fragment MonitorV2Rule on MonitorV2Rule {
+	level
+	count {
+		... MonitorV2CountRule
+	}
+	threshold {
+		... MonitorV2ThresholdRule
+	}
+	promote {
+		... MonitorV2PromoteRule
+	}
+}
+fragment MonitorV2Column on MonitorV2Column {
+	linkColumn {
+		... MonitorV2LinkColumn
+	}
+	columnPath {
+		... MonitorV2ColumnPath
+	}
+}
+fragment MonitorV2Scheduling on MonitorV2Scheduling {
+	interval {
+		... MonitorV2IntervalSchedule
+	}
+	transform {
+		... MonitorV2TransformSchedule
+	}
+}
+fragment MonitorV2CountRule on MonitorV2CountRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	valueColumnName
+	aggregation
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
+	compareColumns {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
+	name
+	meta {
+		... MonitorV2LinkColumnMeta
+	}
+}
+fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
+	name
+	path
+}
+fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
+	interval
+	randomize
+}
+fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
+	freshnessGoal
+}
+fragment MonitorV2Comparison on MonitorV2Comparison {
+	compareFn
+	compareValue {
+		... PrimitiveValue
+	}
+}
+fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
+	column {
+		... MonitorV2Column
+	}
+	compareValues {
+		... MonitorV2Comparison
+	}
+}
+fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
+	srcFields {
+		... MonitorV2ColumnPath
+	}
+	dstFields
+	targetDataset
+}
+fragment PrimitiveValue on PrimitiveValue {
 	bool
 	float64
 	int64
 	string
+	timestamp
+	duration
 }
 `
 
-func getDashboard(
+func getMonitorV2(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getDashboardResponse, error) {
+) (*getMonitorV2Response, error) {
 	req := &graphql.Request{
-		OpName: "getDashboard",
-		Query:  getDashboard_Operation,
-		Variables: &__getDashboardInput{
+		OpName: "getMonitorV2",
+		Query:  getMonitorV2_Operation,
+		Variables: &__getMonitorV2Input{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getDashboardResponse
+	var data getMonitorV2Response
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15303,42 +22921,67 @@ func getDashboard(
 	return &data, err
 }
 
-// The query or mutation executed by getDashboardLink.
-const getDashboardLink_Operation = `
-query getDashboardLink ($id: ObjectId!) {
-	dashboardLink(id: $id) {
-		... DashboardLink
+// The query or mutation executed by getMonitorV2Action.
+const getMonitorV2Action_Operation = `
+query getMonitorV2Action ($id: ObjectId!) {
+	monitorV2Action(id: $id) {
+		... MonitorV2Action
 	}
 }
-fragment DashboardLink on DashboardLink {
+fragment MonitorV2Action on MonitorV2Action {
+	inline
+	type
+	email {
+		... MonitorV2EmailAction
+	}
+	webhook {
+		... MonitorV2WebhookAction
+	}
 	id
-	name
 	workspaceId
-	folderId
-	managedById
+	name
+	iconUrl
 	description
-	fromDashboard: fromDashboardId
-	toDashboard: toDashboardId
-	fromCard
-	linkLabel
+	createdBy
+	createdDate
+}
+fragment MonitorV2EmailAction on MonitorV2EmailAction {
+	users
+	addresses
+	subject
+	body
+	fragments
+}
+fragment MonitorV2WebhookAction on MonitorV2WebhookAction {
+	headers {
+		... MonitorV2WebhookHeader
+	}
+	body
+	fragments
+	url
+	method
+}
+fragment MonitorV2WebhookHeader on MonitorV2WebhookHeader {
+	header
+	value
 }
 `
 
-func getDashboardLink(
+func getMonitorV2Action(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getDashboardLinkResponse, error) {
+) (*getMonitorV2ActionResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDashboardLink",
-		Query:  getDashboardLink_Operation,
-		Variables: &__getDashboardLinkInput{
+		OpName: "getMonitorV2Action",
+		Query:  getMonitorV2Action_Operation,
+		Variables: &__getMonitorV2ActionInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getDashboardLinkResponse
+	var data getMonitorV2ActionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15350,114 +22993,178 @@ func getDashboardLink(
 	return &data, err
 }
 
-// The query or mutation executed by getDataset.
-const getDataset_Operation = `
-query getDataset ($id: ObjectId!) {
-	dataset(id: $id) {
-		... Dataset
+// The query or mutation executed by getMonitorV2TemplateDictionary.
+const getMonitorV2TemplateDictionary_Operation = `
+query getMonitorV2TemplateDictionary ($alertType: MonitorV2AlertType, $monitorInput: MonitorV2Input!, $alarmInput: MonitorV2AlarmInput!) {
+	templateDictionary: monitorV2TemplateDictionary(alertType: $alertType, monitorInput: $monitorInput, alarmInput: $alarmInput) {
+		dictionary
 	}
 }
-fragment Dataset on Dataset {
-	workspaceId
-	id
-	name
-	freshnessDesired
-	description
-	iconUrl
-	accelerationDisabled
-	version
-	updatedDate
-	pathCost
-	source
-	managedById
-	onDemandMaterializationLength
-	dataTableViewState
-	foreignKeys {
-		label
-		targetDataset
-		srcFields
-		dstFields
+`
+
+func getMonitorV2TemplateDictionary(
+	ctx context.Context,
+	client graphql.Client,
+	alertType *MonitorV2AlertType,
+	monitorInput MonitorV2Input,
+	alarmInput MonitorV2AlarmInput,
+) (*getMonitorV2TemplateDictionaryResponse, error) {
+	req := &graphql.Request{
+		OpName: "getMonitorV2TemplateDictionary",
+		Query:  getMonitorV2TemplateDictionary_Operation,
+		Variables: &__getMonitorV2TemplateDictionaryInput{
+			AlertType:    alertType,
+			MonitorInput: monitorInput,
+			AlarmInput:   alarmInput,
+		},
 	}
-	transform {
-		current {
-			query {
-				outputStage
-				stages {
-					... StageQuery
+	var err error
+
+	var data getMonitorV2TemplateDictionaryResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by getPoller.
+const getPoller_Operation = `
+query getPoller ($id: ObjectId!) {
+	poller(id: $id) {
+		... Poller
+	}
+}
+fragment Poller on Poller {
+	id
+	workspaceId
+	customerId
+	datastreamId
+	disabled
+	kind
+	config {
+		__typename
+		name
+		retries
+		interval
+		tags
+		chunk {
+			enabled
+			size
+		}
+		... on PollerPubSubConfig {
+			projectId
+			jsonKey
+			subscriptionId
+		}
+		... on PollerHTTPConfig {
+			method
+			body
+			endpoint
+			contentType
+			headers
+			template {
+				... HttpRequestConfig
+			}
+			requests {
+				... HttpRequestConfig
+			}
+			rules {
+				match {
+					... HttpRequestConfig
+				}
+				follow
+				decoder {
+					type
 				}
 			}
-		}
-	}
-	typedef {
-		label
-		def {
-			anykey
-			fields {
+			timestamps {
 				name
-				type {
-					rep
-					nullable
-				}
-				isEnum
-				isSearchable
-				isHidden
-				isConst
-				isMetric
+				source
+				format
+				offset
+				truncate
 			}
 		}
-	}
-	sourceTable {
-		schema
-		partitions {
-			name
+		... on PollerGCPMonitoringConfig {
+			projectId
+			jsonKey
+			includeMetricTypePrefixes
+			excludeMetricTypePrefixes
+			rateLimit
+			totalLimit
 		}
-		sourceUpdateTableName
-		isInsertOnly
-		batchSeqField
-		validFromField
-		fields {
-			name
-			sqlType
+		... on PollerMongoDBAtlasConfig {
+			publicKey
+			privateKey
+			includeGroups
+			excludeGroups
 		}
-	}
-	correlationTagMappings {
-		tag
-		path {
-			column
-			path
+		... on PollerConfluentCloudConfig {
+			key
+			secret
+		}
+		... on PollerCloudWatchMetricsConfig {
+			period
+			delay
+			region
+			assumeRoleArn
+			queries {
+				namespace
+				metricNames
+				dimensions {
+					name
+					value
+				}
+				resourceFilter {
+					resourceType
+					pattern
+					dimensionName
+					tagFilters {
+						key
+						values
+					}
+				}
+			}
+		}
+		... on PollerAWSSnapshotConfig {
+			region
+			assumeRoleArn
+			includeActions
 		}
 	}
 }
-fragment StageQuery on StageQuery {
-	id
-	pipeline
+fragment HttpRequestConfig on PollerHTTPRequestConfig {
+	url
+	method
+	username
+	password
+	authScheme
+	body
+	headers
 	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
-	}
 }
 `
 
-func getDataset(
+func getPoller(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getDatasetResponse, error) {
+) (*getPollerResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDataset",
-		Query:  getDataset_Operation,
-		Variables: &__getDatasetInput{
+		OpName: "getPoller",
+		Query:  getPoller_Operation,
+		Variables: &__getPollerInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getDatasetResponse
+	var data getPollerResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15469,36 +23176,51 @@ func getDataset(
 	return &data, err
 }
 
-// The query or mutation executed by getDatasetCorrelationTags.
-const getDatasetCorrelationTags_Operation = `
-query getDatasetCorrelationTags ($datasetId: ObjectId!) {
-	correlationTags: dataset(id: $datasetId) {
-		correlationTagMappings {
-			tag
-			path {
-				column
-				path
-			}
-		}
+// The query or mutation executed by getPreferredPath.
+const getPreferredPath_Operation = `
+query getPreferredPath ($id: ObjectId!) {
+	preferredPathWithStatus: preferredPath(id: $id) {
+		... PreferredPathWithStatus
+	}
+}
+fragment PreferredPathWithStatus on PreferredPathWithStatus {
+	path {
+		... PreferredPath
+	}
+	error
+}
+fragment PreferredPath on PreferredPath {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	folderId
+	sourceDataset
+	path {
+		linkId
+		reverse
+		linkName
+		reverseFromDataset
 	}
 }
 `
 
-func getDatasetCorrelationTags(
+func getPreferredPath(
 	ctx context.Context,
 	client graphql.Client,
-	datasetId string,
-) (*getDatasetCorrelationTagsResponse, error) {
+	id string,
+) (*getPreferredPathResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDatasetCorrelationTags",
-		Query:  getDatasetCorrelationTags_Operation,
-		Variables: &__getDatasetCorrelationTagsInput{
-			DatasetId: datasetId,
+		OpName: "getPreferredPath",
+		Query:  getPreferredPath_Operation,
+		Variables: &__getPreferredPathInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data getDatasetCorrelationTagsResponse
+	var data getPreferredPathResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15510,46 +23232,31 @@ func getDatasetCorrelationTags(
 	return &data, err
 }
 
-// The query or mutation executed by getDatasetOutboundShare.
-const getDatasetOutboundShare_Operation = `
-query getDatasetOutboundShare ($id: ObjectId!) {
-	datasetOutboundShare(id: $id) {
-		... DatasetOutboundShare
+// The query or mutation executed by getRbacDefaultGroup.
+const getRbacDefaultGroup_Operation = `
+query getRbacDefaultGroup {
+	rbacDefaultGroup {
+		... RbacGroup
 	}
 }
-fragment DatasetOutboundShare on DatasetOutboundShare {
+fragment RbacGroup on RbacGroup {
 	id
 	name
 	description
-	workspaceId
-	folderId
-	datasetID
-	outboundShareID
-	schemaName
-	viewName
-	freshnessGoal
-	status {
-		state
-		error
-	}
 }
 `
 
-func getDatasetOutboundShare(
+func getRbacDefaultGroup(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getDatasetOutboundShareResponse, error) {
+) (*getRbacDefaultGroupResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDatasetOutboundShare",
-		Query:  getDatasetOutboundShare_Operation,
-		Variables: &__getDatasetOutboundShareInput{
-			Id: id,
-		},
+		OpName: "getRbacDefaultGroup",
+		Query:  getRbacDefaultGroup_Operation,
 	}
 	var err error
 
-	var data getDatasetOutboundShareResponse
+	var data getRbacDefaultGroupResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15561,42 +23268,35 @@ func getDatasetOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by getDatasetQueryOutput.
-const getDatasetQueryOutput_Operation = `
-query getDatasetQueryOutput ($query: [StageInput!]!, $params: QueryParams!) {
-	taskResult: datasetQueryOutput(query: $query, params: $params) {
-		... TaskResult
+// The query or mutation executed by getRbacGroup.
+const getRbacGroup_Operation = `
+query getRbacGroup ($id: ORN!) {
+	rbacGroup(id: $id) {
+		... RbacGroup
 	}
 }
-fragment TaskResult on TaskResult {
-	queryId
-	stageId
-	startTime
-	endTime
-	resultCursor
-	resultSchema {
-		typedefDefinition
-	}
+fragment RbacGroup on RbacGroup {
+	id
+	name
+	description
 }
 `
 
-func getDatasetQueryOutput(
+func getRbacGroup(
 	ctx context.Context,
 	client graphql.Client,
-	query []*StageInput,
-	params QueryParams,
-) (*getDatasetQueryOutputResponse, error) {
+	id string,
+) (*getRbacGroupResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDatasetQueryOutput",
-		Query:  getDatasetQueryOutput_Operation,
-		Variables: &__getDatasetQueryOutputInput{
-			Query:  query,
-			Params: params,
+		OpName: "getRbacGroup",
+		Query:  getRbacGroup_Operation,
+		Variables: &__getRbacGroupInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data getDatasetQueryOutputResponse
+	var data getRbacGroupResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15608,38 +23308,37 @@ func getDatasetQueryOutput(
 	return &data, err
 }
 
-// The query or mutation executed by getDatastream.
-const getDatastream_Operation = `
-query getDatastream ($id: ObjectId!) {
-	datastream(id: $id) {
-		... Datastream
+// The query or mutation executed by getRbacGroupmember.
+const getRbacGroupmember_Operation = `
+query getRbacGroupmember ($id: ORN!) {
+	rbacGroupmember(id: $id) {
+		... RbacGroupmember
 	}
 }
-fragment Datastream on Datastream {
+fragment RbacGroupmember on RbacGroupmember {
 	id
-	name
-	iconUrl
 	description
-	workspaceId
-	datasetId
+	groupId
+	memberUserId
+	memberGroupId
 }
 `
 
-func getDatastream(
+func getRbacGroupmember(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getDatastreamResponse, error) {
+) (*getRbacGroupmemberResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDatastream",
-		Query:  getDatastream_Operation,
-		Variables: &__getDatastreamInput{
+		OpName: "getRbacGroupmember",
+		Query:  getRbacGroupmember_Operation,
+		Variables: &__getRbacGroupmemberInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getDatastreamResponse
+	var data getRbacGroupmemberResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15651,38 +23350,31 @@ func getDatastream(
 	return &data, err
 }
 
-// The query or mutation executed by getDatastreamToken.
-const getDatastreamToken_Operation = `
-query getDatastreamToken ($id: String!) {
-	datastreamToken(id: $id) {
-		... DatastreamToken
+// The query or mutation executed by getRbacGroups.
+const getRbacGroups_Operation = `
+query getRbacGroups {
+	rbacGroups {
+		... RbacGroup
 	}
 }
-fragment DatastreamToken on DatastreamToken {
+fragment RbacGroup on RbacGroup {
 	id
 	name
 	description
-	disabled
-	datastreamId
-	secret
 }
 `
 
-func getDatastreamToken(
+func getRbacGroups(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getDatastreamTokenResponse, error) {
+) (*getRbacGroupsResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDatastreamToken",
-		Query:  getDatastreamToken_Operation,
-		Variables: &__getDatastreamTokenInput{
-			Id: id,
-		},
+		OpName: "getRbacGroups",
+		Query:  getRbacGroups_Operation,
 	}
 	var err error
 
-	var data getDatastreamTokenResponse
+	var data getRbacGroupsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15694,28 +23386,50 @@ func getDatastreamToken(
 	return &data, err
 }
 
-// The query or mutation executed by getDefaultDashboard.
-const getDefaultDashboard_Operation = `
-query getDefaultDashboard ($dsid: ObjectId!) {
-	defaultDashboard(dsid: $dsid)
+// The query or mutation executed by getRbacStatement.
+const getRbacStatement_Operation = `
+query getRbacStatement ($id: ORN!) {
+	rbacStatement(id: $id) {
+		... RbacStatement
+	}
+}
+fragment RbacStatement on RbacStatement {
+	id
+	description
+	subject {
+		userId
+		groupId
+		all
+	}
+	object {
+		objectId
+		folderId
+		workspaceId
+		type
+		name
+		owner
+		all
+	}
+	role
+	version
 }
 `
 
-func getDefaultDashboard(
+func getRbacStatement(
 	ctx context.Context,
 	client graphql.Client,
-	dsid string,
-) (*getDefaultDashboardResponse, error) {
+	id string,
+) (*getRbacStatementResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDefaultDashboard",
-		Query:  getDefaultDashboard_Operation,
-		Variables: &__getDefaultDashboardInput{
-			Dsid: dsid,
+		OpName: "getRbacStatement",
+		Query:  getRbacStatement_Operation,
+		Variables: &__getRbacStatementInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data getDefaultDashboardResponse
+	var data getRbacStatementResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15727,50 +23441,39 @@ func getDefaultDashboard(
 	return &data, err
 }
 
-// The query or mutation executed by getDeferredForeignKey.
-const getDeferredForeignKey_Operation = `
-query getDeferredForeignKey ($id: ObjectId!) {
-	deferredForeignKey(id: $id) {
-		... DeferredForeignKey
+// The query or mutation executed by getReferenceTable.
+const getReferenceTable_Operation = `
+query getReferenceTable ($id: ObjectId!) {
+	referenceTable(id: $id) {
+		... ReferenceTable
 	}
 }
-fragment DeferredForeignKey on DeferredForeignKey {
+fragment ReferenceTable on ReferenceTable {
 	id
 	workspaceId
-	sourceDataset {
-		datasetId
-	}
-	targetDataset {
-		datasetId
-	}
-	srcFields
-	dstFields
-	label
-	resolution {
-		sourceId
-		targetId
-	}
-	status {
-		errorText
-	}
+	name
+	iconUrl
+	description
+	datasetID
+	managedById
 }
 `
 
-func getDeferredForeignKey(
+func getReferenceTable(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getDeferredForeignKeyResponse, error) {
+) (*getReferenceTableResponse, error) {
 	req := &graphql.Request{
-		OpName: "getDeferredForeignKey",
-		Query:  getDeferredForeignKey_Operation,
-		Variables: &__getDeferredForeignKeyInput{
+		OpName: "getReferenceTable",
+		Query:  getReferenceTable_Operation,
+		Variables: &__getReferenceTableInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getDeferredForeignKeyResponse
+	var data getReferenceTableResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15782,56 +23485,44 @@ func getDeferredForeignKey(
 	return &data, err
 }
 
-// The query or mutation executed by getFiledrop.
-const getFiledrop_Operation = `
-query getFiledrop ($id: ObjectId!) {
-	filedrop(id: $id) {
-		... Filedrop
+// The query or mutation executed by getSnowflakeOutboundShare.
+const getSnowflakeOutboundShare_Operation = `
+query getSnowflakeOutboundShare ($id: ObjectId!) {
+	share: snowflakeOutboundShare(id: $id) {
+		... SnowflakeOutboundShare
 	}
 }
-fragment Filedrop on Filedrop {
+fragment SnowflakeOutboundShare on SnowflakeOutboundShare {
 	id
 	name
-	iconUrl
 	description
 	workspaceId
-	status
-	datastreamID
-	config {
-		provider {
-			__typename
-			... on FiledropProviderAwsConfig {
-				region
-				roleArn
-			}
-		}
-	}
-	endpoint {
-		__typename
-		... on FiledropS3Endpoint {
-			arn
-			bucket
-			prefix
-		}
+	shareName
+	accounts {
+		... SnowflakeAccount
 	}
 }
+fragment SnowflakeAccount on SnowflakeAccount {
+	organization
+	account
+}
 `
 
-func getFiledrop(
+func getSnowflakeOutboundShare(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getFiledropResponse, error) {
+) (*getSnowflakeOutboundShareResponse, error) {
 	req := &graphql.Request{
-		OpName: "getFiledrop",
-		Query:  getFiledrop_Operation,
-		Variables: &__getFiledropInput{
+		OpName: "getSnowflakeOutboundShare",
+		Query:  getSnowflakeOutboundShare_Operation,
+		Variables: &__getSnowflakeOutboundShareInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getFiledropResponse
+	var data getSnowflakeOutboundShareResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15843,37 +23534,38 @@ func getFiledrop(
 	return &data, err
 }
 
-// The query or mutation executed by getFolder.
-const getFolder_Operation = `
-query getFolder ($id: ObjectId!) {
-	folder(id: $id) {
-		... Folder
+// The query or mutation executed by getTerraform.
+const getTerraform_Operation = `
+query getTerraform ($id: ObjectId!, $ty: TerraformObjectType!) {
+	terraform: getTerraform(id: $id, type: $ty) {
+		... TerraformDefinition
 	}
 }
-fragment Folder on Folder {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
+fragment TerraformDefinition on TerraformDefinition {
+	dataSource
+	resource
+	importId
+	importName
 }
 `
 
-func getFolder(
+func getTerraform(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getFolderResponse, error) {
+	ty TerraformObjectType,
+) (*getTerraformResponse, error) {
 	req := &graphql.Request{
-		OpName: "getFolder",
-		Query:  getFolder_Operation,
-		Variables: &__getFolderInput{
+		OpName: "getTerraform",
+		Query:  getTerraform_Operation,
+		Variables: &__getTerraformInput{
 			Id: id,
+			Ty: ty,
 		},
 	}
 	var err error
 
-	var data getFolderResponse
+	var data getTerraformResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15885,34 +23577,36 @@ func getFolder(
 	return &data, err
 }
 
-// The query or mutation executed by getIngestInfo.
-const getIngestInfo_Operation = `
-query getIngestInfo {
-	ingest: currentCustomer {
-		ingestInfo {
-			... IngestInfo
-		}
+// The query or mutation executed by getUser.
+const getUser_Operation = `
+query getUser ($id: UserId!) {
+	user(id: $id) {
+		... User
 	}
 }
-fragment IngestInfo on IngestInfo {
-	collectUrl
-	domain
-	scheme
-	port
+fragment User on User {
+	id
+	email
+	comment
+	label
 }
 `
 
-func getIngestInfo(
+func getUser(
 	ctx context.Context,
 	client graphql.Client,
-) (*getIngestInfoResponse, error) {
+	id types.UserIdScalar,
+) (*getUserResponse, error) {
 	req := &graphql.Request{
-		OpName: "getIngestInfo",
-		Query:  getIngestInfo_Operation,
+		OpName: "getUser",
+		Query:  getUser_Operation,
+		Variables: &__getUserInput{
+			Id: id,
+		},
 	}
 	var err error
 
-	var data getIngestInfoResponse
+	var data getUserResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -15924,71 +23618,92 @@ func getIngestInfo(
 	return &data, err
 }
 
-// The query or mutation executed by getLayeredSettingRecord.
-const getLayeredSettingRecord_Operation = `
-query getLayeredSettingRecord ($id: ObjectId!) {
-	layeredSettingRecord(id: $id) {
-		... LayeredSettingRecord
+// The query or mutation executed by getWorksheet.
+const getWorksheet_Operation = `
+query getWorksheet ($id: ObjectId!) {
+	worksheet(id: $id) {
+		... Worksheet
 	}
 }
-fragment LayeredSettingRecord on LayeredSettingRecord {
-	settingAndTargetScope {
-		... SettingAndTargetScope
-	}
-	value {
-		... PrimitiveValue
-	}
+fragment Worksheet on Worksheet {
 	id
-	name
-	description
-	iconUrl
-	workspaceId
+	label
+	icon
 	managedById
-	folderId
+	workspaceId
+	stages {
+		... StageQuery
+	}
 }
-fragment SettingAndTargetScope on SettingAndTargetScope {
-	setting
-	target {
-		... LayeredSettingRecordTarget
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
 	}
 }
-fragment PrimitiveValue on PrimitiveValue {
-	bool
-	float64
-	int64
-	string
-	timestamp
-	duration
+`
+
+func getWorksheet(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getWorksheetResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWorksheet",
+		Query:  getWorksheet_Operation,
+		Variables: &__getWorksheetInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getWorksheetResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
-fragment LayeredSettingRecordTarget on LayeredSettingRecordTarget {
-	customerId
-	workspaceId
-	folderId
-	appId
-	monitorId
-	worksheetId
-	dashboardId
-	datasetId
-	datastreamId
-	userId
+
+// The query or mutation executed by getWorkspace.
+const getWorkspace_Operation = `
+query getWorkspace ($id: ObjectId!) {
+	workspace(id: $id) {
+		... Workspace
+	}
+}
+fragment Workspace on Project {
+	id
+	label
 }
 `
 
-func getLayeredSettingRecord(
+func getWorkspace(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getLayeredSettingRecordResponse, error) {
+) (*getWorkspaceResponse, error) {
 	req := &graphql.Request{
-		OpName: "getLayeredSettingRecord",
-		Query:  getLayeredSettingRecord_Operation,
-		Variables: &__getLayeredSettingRecordInput{
+		OpName: "getWorkspace",
+		Query:  getWorkspace_Operation,
+		Variables: &__getWorkspaceInput{
 			Id: id,
 		},
 	}
 	var err error
 
-	var data getLayeredSettingRecordResponse
+	var data getWorkspaceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16000,118 +23715,40 @@ func getLayeredSettingRecord(
 	return &data, err
 }
 
-// The query or mutation executed by getMonitor.
-const getMonitor_Operation = `
-query getMonitor ($id: ObjectId!) {
-	monitor(id: $id) {
-		... Monitor
-	}
-}
-fragment Monitor on Monitor {
-	workspaceId
-	id
-	name
-	description
-	comment
-	iconUrl
-	isTemplate
-	disabled
-	freshnessGoal
-	useDefaultFreshness
-	source
-	definition
-	managedById
-	query {
-		outputStage
-		stages {
-			... StageQuery
-		}
-	}
-	rule {
-		__typename
-		sourceColumn
-		groupByGroups {
-			groupName
-			columns
-		}
-		... on MonitorRuleCount {
-			compareFunction
-			compareValues
-			lookbackTime
-		}
-		... on MonitorRuleChange {
-			changeType
-			compareFunction
-			compareValues
-			aggregateFunction
-			lookbackTime
-			baselineTime
-		}
-		... on MonitorRuleFacet {
-			facetFunction
-			facetValues
-			timeFunction
-			timeValue
-			lookbackTime
-		}
-		... on MonitorRuleThreshold {
-			compareFunction
-			compareValues
-			lookbackTime
-			thresholdAggFunction
-		}
-		... on MonitorRulePromote {
-			kindField
-			descriptionField
-			primaryKey
-		}
-		... on MonitorRuleLog {
-			compareFunction
-			compareValues
-			lookbackTime
-			expressionSummary
-			logStageId
-			sourceLogDatasetId
+// The query or mutation executed by listDatasetInputs.
+const listDatasetInputs_Operation = `
+query listDatasetInputs ($workspaceId: ObjectId!) {
+	datasets: project(projectId: $workspaceId) {
+		datasets {
+			... DatasetInputs
 		}
 	}
-	notificationSpec {
-		merge
-		importance
-		notifyOnReminder
-		reminderFrequency
-		notifyOnClose
-	}
 }
-fragment StageQuery on StageQuery {
+fragment DatasetInputs on Dataset {
 	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
+	name
+	workspaceId
+	inputs {
 		datasetId
-		datasetPath
-		stageId
 	}
 }
 `
 
-func getMonitor(
+func listDatasetInputs(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getMonitorResponse, error) {
+	workspaceId string,
+) (*listDatasetInputsResponse, error) {
 	req := &graphql.Request{
-		OpName: "getMonitor",
-		Query:  getMonitor_Operation,
-		Variables: &__getMonitorInput{
-			Id: id,
+		OpName: "listDatasetInputs",
+		Query:  listDatasetInputs_Operation,
+		Variables: &__listDatasetInputsInput{
+			WorkspaceId: workspaceId,
 		},
 	}
 	var err error
 
-	var data getMonitorResponse
+	var data listDatasetInputsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16123,57 +23760,112 @@ func getMonitor(
 	return &data, err
 }
 
-// The query or mutation executed by getMonitorAction.
-const getMonitorAction_Operation = `
-query getMonitorAction ($id: ObjectId!) {
-	monitorAction(id: $id) {
-		__typename
-		... MonitorAction
+// The query or mutation executed by listDatasets.
+const listDatasets_Operation = `
+query listDatasets {
+	datasets: projects {
+		datasets {
+			... Dataset
+		}
 	}
 }
-fragment MonitorAction on MonitorAction {
+fragment Dataset on Dataset {
+	workspaceId
 	id
 	name
-	iconUrl
+	freshnessDesired
 	description
-	workspaceId
-	rateLimit
-	notifyOnClose
-	isPrivate
-	__typename
-	... on EmailAction {
-		targetAddresses
-		subjectTemplate
-		bodyTemplate
-		isHtml
+	iconUrl
+	accelerationDisabled
+	version
+	updatedDate
+	pathCost
+	source
+	managedById
+	onDemandMaterializationLength
+	dataTableViewState
+	foreignKeys {
+		label
+		targetDataset
+		srcFields
+		dstFields
 	}
-	... on WebhookAction {
-		urlTemplate
-		method
-		headers {
-			header
-			valueTemplate
+	transform {
+		current {
+			query {
+				outputStage
+				stages {
+					... StageQuery
+				}
+			}
 		}
-		bodyTemplate
+	}
+	typedef {
+		label
+		def {
+			anykey
+			fields {
+				name
+				type {
+					rep
+					nullable
+				}
+				isEnum
+				isSearchable
+				isHidden
+				isConst
+				isMetric
+			}
+		}
+	}
+	sourceTable {
+		schema
+		partitions {
+			name
+		}
+		sourceUpdateTableName
+		isInsertOnly
+		batchSeqField
+		validFromField
+		fields {
+			name
+			sqlType
+		}
+	}
+	correlationTagMappings {
+		tag
+		path {
+			column
+			path
+		}
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
 	}
 }
 `
 
-func getMonitorAction(
+func listDatasets(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getMonitorActionResponse, error) {
+) (*listDatasetsResponse, error) {
 	req := &graphql.Request{
-		OpName: "getMonitorAction",
-		Query:  getMonitorAction_Operation,
-		Variables: &__getMonitorActionInput{
-			Id: id,
-		},
+		OpName: "listDatasets",
+		Query:  listDatasets_Operation,
 	}
 	var err error
 
-	var data getMonitorActionResponse
+	var data listDatasetsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16185,39 +23877,32 @@ func getMonitorAction(
 	return &data, err
 }
 
-// The query or mutation executed by getMonitorActionAttachment.
-const getMonitorActionAttachment_Operation = `
-query getMonitorActionAttachment ($id: ObjectId!) {
-	monitorActionAttachment(id: $id) {
-		... MonitorActionAttachment
+// The query or mutation executed by listDatasetsIdNameOnly.
+const listDatasetsIdNameOnly_Operation = `
+query listDatasetsIdNameOnly {
+	datasets: datasetSearch {
+		dataset {
+			... DatasetIdName
+		}
 	}
 }
-fragment MonitorActionAttachment on MonitorActionAttachment {
-	monitorID
-	actionID
-	id
-	workspaceId
+fragment DatasetIdName on Dataset {
 	name
-	iconUrl
-	description
+	id
 }
 `
 
-func getMonitorActionAttachment(
+func listDatasetsIdNameOnly(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getMonitorActionAttachmentResponse, error) {
+) (*listDatasetsIdNameOnlyResponse, error) {
 	req := &graphql.Request{
-		OpName: "getMonitorActionAttachment",
-		Query:  getMonitorActionAttachment_Operation,
-		Variables: &__getMonitorActionAttachmentInput{
-			Id: id,
-		},
+		OpName: "listDatasetsIdNameOnly",
+		Query:  listDatasetsIdNameOnly_Operation,
 	}
 	var err error
 
-	var data getMonitorActionAttachmentResponse
+	var data listDatasetsIdNameOnlyResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16229,184 +23914,38 @@ func getMonitorActionAttachment(
 	return &data, err
 }
 
-// The query or mutation executed by getMonitorV2.
-const getMonitorV2_Operation = `
-query getMonitorV2 ($id: ObjectId!) {
-	monitorV2(id: $id) {
-		... MonitorV2
+// The query or mutation executed by listDatastreams.
+const listDatastreams_Operation = `
+query listDatastreams ($workspaceId: ObjectId!) {
+	datastreams(workspaceId: $workspaceId) {
+		... Datastream
 	}
 }
-fragment MonitorV2 on MonitorV2 {
+fragment Datastream on Datastream {
 	id
-	workspaceId
-	createdBy
-	createdDate
 	name
 	iconUrl
 	description
-	managedById
-	folderId
-	rollupStatus
-	ruleKind
-	definition {
-		... MonitorV2Definition
-	}
-	actionRules {
-		... MonitorV2ActionRule
-	}
-}
-fragment MonitorV2Definition on MonitorV2Definition {
-	inputQuery {
-		outputStage
-		stages {
-			... StageQuery
-		}
-	}
-	rules {
-		... MonitorV2Rule
-	}
-	lookbackTime
-	dataStabilizationDelay
-	groupings {
-		... MonitorV2Column
-	}
-	scheduling {
-		... MonitorV2Scheduling
-	}
-}
-fragment MonitorV2ActionRule on MonitorV2ActionRule {
-	actionID
-	levels
-	sendEndNotifications
-	sendRemindersInterval
-}
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
-	}
-}
-fragment MonitorV2Rule on MonitorV2Rule {
-	level
-	count {
-		... MonitorV2CountRule
-	}
-	threshold {
-		... MonitorV2ThresholdRule
-	}
-	promote {
-		... MonitorV2PromoteRule
-	}
-}
-fragment MonitorV2Column on MonitorV2Column {
-	linkColumn {
-		... MonitorV2LinkColumn
-	}
-	columnPath {
-		... MonitorV2ColumnPath
-	}
-}
-fragment MonitorV2Scheduling on MonitorV2Scheduling {
-	interval {
-		... MonitorV2IntervalSchedule
-	}
-	transform {
-		... MonitorV2TransformSchedule
-	}
-}
-fragment MonitorV2CountRule on MonitorV2CountRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	compareGroups {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	valueColumnName
-	aggregation
-	compareGroups {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
-	compareColumns {
-		... MonitorV2ColumnComparison
-	}
-}
-fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
-	name
-	meta {
-		... MonitorV2LinkColumnMeta
-	}
-}
-fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
-	name
-	path
-}
-fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
-	interval
-	randomize
-}
-fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
-	freshnessGoal
-}
-fragment MonitorV2Comparison on MonitorV2Comparison {
-	compareFn
-	compareValue {
-		... PrimitiveValue
-	}
-}
-fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
-	column {
-		... MonitorV2Column
-	}
-	compareValues {
-		... MonitorV2Comparison
-	}
-}
-fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
-	srcFields {
-		... MonitorV2ColumnPath
-	}
-	dstFields
-	targetDataset
-}
-fragment PrimitiveValue on PrimitiveValue {
-	bool
-	float64
-	int64
-	string
-	timestamp
-	duration
+	workspaceId
+	datasetId
 }
 `
 
-func getMonitorV2(
+func listDatastreams(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getMonitorV2Response, error) {
+	workspaceId string,
+) (*listDatastreamsResponse, error) {
 	req := &graphql.Request{
-		OpName: "getMonitorV2",
-		Query:  getMonitorV2_Operation,
-		Variables: &__getMonitorV2Input{
-			Id: id,
+		OpName: "listDatastreams",
+		Query:  listDatastreams_Operation,
+		Variables: &__listDatastreamsInput{
+			WorkspaceId: workspaceId,
 		},
 	}
 	var err error
 
-	var data getMonitorV2Response
+	var data listDatastreamsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16418,67 +23957,118 @@ func getMonitorV2(
 	return &data, err
 }
 
-// The query or mutation executed by getMonitorV2Action.
-const getMonitorV2Action_Operation = `
-query getMonitorV2Action ($id: ObjectId!) {
-	monitorV2Action(id: $id) {
-		... MonitorV2Action
+// The query or mutation executed by listMonitors.
+const listMonitors_Operation = `
+query listMonitors ($workspaceId: ObjectId!) {
+	monitors: monitorsInWorkspace(workspaceId: $workspaceId) {
+		... Monitor
 	}
 }
-fragment MonitorV2Action on MonitorV2Action {
-	inline
-	type
-	email {
-		... MonitorV2EmailAction
+fragment Monitor on Monitor {
+	workspaceId
+	id
+	name
+	description
+	comment
+	iconUrl
+	isTemplate
+	disabled
+	freshnessGoal
+	useDefaultFreshness
+	source
+	definition
+	managedById
+	query {
+		outputStage
+		stages {
+			... StageQuery
+		}
+	}
+	rule {
+		__typename
+		sourceColumn
+		groupByGroups {
+			groupName
+			columns
+		}
+		... on MonitorRuleCount {
+			compareFunction
+			compareValues
+			lookbackTime
+		}
+		... on MonitorRuleChange {
+			changeType
+			compareFunction
+			compareValues
+			aggregateFunction
+			lookbackTime
+			baselineTime
+		}
+		... on MonitorRuleFacet {
+			facetFunction
+			facetValues
+			timeFunction
+			timeValue
+			lookbackTime
+		}
+		... on MonitorRuleThreshold {
+			compareFunction
+			compareValues
+			lookbackTime
+			thresholdAggFunction
+		}
+		... on MonitorRulePromote {
+			kindField
+			descriptionField
+			primaryKey
+		}
+		... on MonitorRuleLog {
+			compareFunction
+			compareValues
+			lookbackTime
+			expressionSummary
+			logStageId
+			sourceLogDatasetId
+		}
 	}
-	webhook {
-		... MonitorV2WebhookAction
+	notificationSpec {
+		merge
+		importance
+		notifyOnReminder
+		reminderFrequency
+		notifyOnClose
 	}
-	id
-	workspaceId
-	name
-	iconUrl
-	description
-	createdBy
-	createdDate
-}
-fragment MonitorV2EmailAction on MonitorV2EmailAction {
-	users
-	addresses
-	subject
-	body
-	fragments
 }
-fragment MonitorV2WebhookAction on MonitorV2WebhookAction {
-	headers {
-		... MonitorV2WebhookHeader
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
 	}
-	body
-	fragments
-	url
-	method
-}
-fragment MonitorV2WebhookHeader on MonitorV2WebhookHeader {
-	header
-	value
 }
 `
 
-func getMonitorV2Action(
+func listMonitors(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getMonitorV2ActionResponse, error) {
+	workspaceId string,
+) (*listMonitorsResponse, error) {
 	req := &graphql.Request{
-		OpName: "getMonitorV2Action",
-		Query:  getMonitorV2Action_Operation,
-		Variables: &__getMonitorV2ActionInput{
-			Id: id,
+		OpName: "listMonitors",
+		Query:  listMonitors_Operation,
+		Variables: &__listMonitorsInput{
+			WorkspaceId: workspaceId,
 		},
 	}
 	var err error
 
-	var data getMonitorV2ActionResponse
+	var data listMonitorsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16490,10 +24080,10 @@ func getMonitorV2Action(
 	return &data, err
 }
 
-// The query or mutation executed by getPoller.
-const getPoller_Operation = `
-query getPoller ($id: ObjectId!) {
-	poller(id: $id) {
+// The query or mutation executed by listPollers.
+const listPollers_Operation = `
+query listPollers ($workspaceId: ObjectId!) {
+	pollers(workspaceId: $workspaceId) {
 		... Poller
 	}
 }
@@ -16562,6 +24152,10 @@ fragment Poller on Poller {
 			includeGroups
 			excludeGroups
 		}
+		... on PollerConfluentCloudConfig {
+			key
+			secret
+		}
 		... on PollerCloudWatchMetricsConfig {
 			period
 			delay
@@ -16583,252 +24177,42 @@ fragment Poller on Poller {
 						values
 					}
 				}
-			}
-		}
-		... on PollerAWSSnapshotConfig {
-			region
-			assumeRoleArn
-			includeActions
-		}
-	}
-}
-fragment HttpRequestConfig on PollerHTTPRequestConfig {
-	url
-	method
-	username
-	password
-	authScheme
-	body
-	headers
-	params
-}
-`
-
-func getPoller(
-	ctx context.Context,
-	client graphql.Client,
-	id string,
-) (*getPollerResponse, error) {
-	req := &graphql.Request{
-		OpName: "getPoller",
-		Query:  getPoller_Operation,
-		Variables: &__getPollerInput{
-			Id: id,
-		},
-	}
-	var err error
-
-	var data getPollerResponse
-	resp := &graphql.Response{Data: &data}
-
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
-
-	return &data, err
-}
-
-// The query or mutation executed by getPreferredPath.
-const getPreferredPath_Operation = `
-query getPreferredPath ($id: ObjectId!) {
-	preferredPathWithStatus: preferredPath(id: $id) {
-		... PreferredPathWithStatus
-	}
-}
-fragment PreferredPathWithStatus on PreferredPathWithStatus {
-	path {
-		... PreferredPath
-	}
-	error
-}
-fragment PreferredPath on PreferredPath {
-	id
-	name
-	iconUrl
-	description
-	workspaceId
-	folderId
-	sourceDataset
-	path {
-		linkId
-		reverse
-		linkName
-		reverseFromDataset
-	}
-}
-`
-
-func getPreferredPath(
-	ctx context.Context,
-	client graphql.Client,
-	id string,
-) (*getPreferredPathResponse, error) {
-	req := &graphql.Request{
-		OpName: "getPreferredPath",
-		Query:  getPreferredPath_Operation,
-		Variables: &__getPreferredPathInput{
-			Id: id,
-		},
-	}
-	var err error
-
-	var data getPreferredPathResponse
-	resp := &graphql.Response{Data: &data}
-
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
-
-	return &data, err
-}
-
-// The query or mutation executed by getRbacDefaultGroup.
-const getRbacDefaultGroup_Operation = `
-query getRbacDefaultGroup {
-	rbacDefaultGroup {
-		... RbacGroup
-	}
-}
-fragment RbacGroup on RbacGroup {
-	id
-	name
-	description
-}
-`
-
-func getRbacDefaultGroup(
-	ctx context.Context,
-	client graphql.Client,
-) (*getRbacDefaultGroupResponse, error) {
-	req := &graphql.Request{
-		OpName: "getRbacDefaultGroup",
-		Query:  getRbacDefaultGroup_Operation,
-	}
-	var err error
-
-	var data getRbacDefaultGroupResponse
-	resp := &graphql.Response{Data: &data}
-
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
-
-	return &data, err
-}
-
-// The query or mutation executed by getRbacGroup.
-const getRbacGroup_Operation = `
-query getRbacGroup ($id: ORN!) {
-	rbacGroup(id: $id) {
-		... RbacGroup
-	}
-}
-fragment RbacGroup on RbacGroup {
-	id
-	name
-	description
-}
-`
-
-func getRbacGroup(
-	ctx context.Context,
-	client graphql.Client,
-	id string,
-) (*getRbacGroupResponse, error) {
-	req := &graphql.Request{
-		OpName: "getRbacGroup",
-		Query:  getRbacGroup_Operation,
-		Variables: &__getRbacGroupInput{
-			Id: id,
-		},
-	}
-	var err error
-
-	var data getRbacGroupResponse
-	resp := &graphql.Response{Data: &data}
-
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
-
-	return &data, err
-}
-
-// The query or mutation executed by getRbacGroupmember.
-const getRbacGroupmember_Operation = `
-query getRbacGroupmember ($id: ORN!) {
-	rbacGroupmember(id: $id) {
-		... RbacGroupmember
+			}
+		}
+		... on PollerAWSSnapshotConfig {
+			region
+			assumeRoleArn
+			includeActions
+		}
 	}
 }
-fragment RbacGroupmember on RbacGroupmember {
-	id
-	description
-	groupId
-	memberUserId
-	memberGroupId
+fragment HttpRequestConfig on PollerHTTPRequestConfig {
+	url
+	method
+	username
+	password
+	authScheme
+	body
+	headers
+	params
 }
 `
 
-func getRbacGroupmember(
+func listPollers(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getRbacGroupmemberResponse, error) {
+	workspaceId string,
+) (*listPollersResponse, error) {
 	req := &graphql.Request{
-		OpName: "getRbacGroupmember",
-		Query:  getRbacGroupmember_Operation,
-		Variables: &__getRbacGroupmemberInput{
-			Id: id,
+		OpName: "listPollers",
+		Query:  listPollers_Operation,
+		Variables: &__listPollersInput{
+			WorkspaceId: workspaceId,
 		},
 	}
 	var err error
 
-	var data getRbacGroupmemberResponse
-	resp := &graphql.Response{Data: &data}
-
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
-
-	return &data, err
-}
-
-// The query or mutation executed by getRbacGroups.
-const getRbacGroups_Operation = `
-query getRbacGroups {
-	rbacGroups {
-		... RbacGroup
-	}
-}
-fragment RbacGroup on RbacGroup {
-	id
-	name
-	description
-}
-`
-
-func getRbacGroups(
-	ctx context.Context,
-	client graphql.Client,
-) (*getRbacGroupsResponse, error) {
-	req := &graphql.Request{
-		OpName: "getRbacGroups",
-		Query:  getRbacGroups_Operation,
-	}
-	var err error
-
-	var data getRbacGroupsResponse
+	var data listPollersResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16840,10 +24224,10 @@ func getRbacGroups(
 	return &data, err
 }
 
-// The query or mutation executed by getRbacStatement.
-const getRbacStatement_Operation = `
-query getRbacStatement ($id: ORN!) {
-	rbacStatement(id: $id) {
+// The query or mutation executed by listRbacStatements.
+const listRbacStatements_Operation = `
+query listRbacStatements {
+	rbacStatements {
 		... RbacStatement
 	}
 }
@@ -16869,21 +24253,17 @@ fragment RbacStatement on RbacStatement {
 }
 `
 
-func getRbacStatement(
+func listRbacStatements(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getRbacStatementResponse, error) {
+) (*listRbacStatementsResponse, error) {
 	req := &graphql.Request{
-		OpName: "getRbacStatement",
-		Query:  getRbacStatement_Operation,
-		Variables: &__getRbacStatementInput{
-			Id: id,
-		},
+		OpName: "listRbacStatements",
+		Query:  listRbacStatements_Operation,
 	}
 	var err error
 
-	var data getRbacStatementResponse
+	var data listRbacStatementsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16895,44 +24275,34 @@ func getRbacStatement(
 	return &data, err
 }
 
-// The query or mutation executed by getSnowflakeOutboundShare.
-const getSnowflakeOutboundShare_Operation = `
-query getSnowflakeOutboundShare ($id: ObjectId!) {
-	share: snowflakeOutboundShare(id: $id) {
-		... SnowflakeOutboundShare
+// The query or mutation executed by listUsers.
+const listUsers_Operation = `
+query listUsers {
+	users: currentCustomer {
+		users {
+			... User
+		}
 	}
 }
-fragment SnowflakeOutboundShare on SnowflakeOutboundShare {
+fragment User on User {
 	id
-	name
-	description
-	workspaceId
-	shareName
-	accounts {
-		... SnowflakeAccount
-	}
-}
-fragment SnowflakeAccount on SnowflakeAccount {
-	organization
-	account
+	email
+	comment
+	label
 }
 `
 
-func getSnowflakeOutboundShare(
+func listUsers(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getSnowflakeOutboundShareResponse, error) {
+) (*listUsersResponse, error) {
 	req := &graphql.Request{
-		OpName: "getSnowflakeOutboundShare",
-		Query:  getSnowflakeOutboundShare_Operation,
-		Variables: &__getSnowflakeOutboundShareInput{
-			Id: id,
-		},
+		OpName: "listUsers",
+		Query:  listUsers_Operation,
 	}
 	var err error
 
-	var data getSnowflakeOutboundShareResponse
+	var data listUsersResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16944,38 +24314,38 @@ func getSnowflakeOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by getTerraform.
-const getTerraform_Operation = `
-query getTerraform ($id: ObjectId!, $ty: TerraformObjectType!) {
-	terraform: getTerraform(id: $id, type: $ty) {
-		... TerraformDefinition
+// The query or mutation executed by listWorksheetsIdLabelOnly.
+const listWorksheetsIdLabelOnly_Operation = `
+query listWorksheetsIdLabelOnly ($workspaceId: ObjectId!) {
+	worksheetSearch(terms: {workspaceId:[$workspaceId]}) {
+		worksheets {
+			worksheet {
+				... WorksheetIdLabel
+			}
+		}
 	}
 }
-fragment TerraformDefinition on TerraformDefinition {
-	dataSource
-	resource
-	importId
-	importName
+fragment WorksheetIdLabel on Worksheet {
+	id
+	label
 }
 `
 
-func getTerraform(
+func listWorksheetsIdLabelOnly(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-	ty TerraformObjectType,
-) (*getTerraformResponse, error) {
+	workspaceId string,
+) (*listWorksheetsIdLabelOnlyResponse, error) {
 	req := &graphql.Request{
-		OpName: "getTerraform",
-		Query:  getTerraform_Operation,
-		Variables: &__getTerraformInput{
-			Id: id,
-			Ty: ty,
+		OpName: "listWorksheetsIdLabelOnly",
+		Query:  listWorksheetsIdLabelOnly_Operation,
+		Variables: &__listWorksheetsIdLabelOnlyInput{
+			WorkspaceId: workspaceId,
 		},
 	}
 	var err error
 
-	var data getTerraformResponse
+	var data listWorksheetsIdLabelOnlyResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -16987,36 +24357,30 @@ func getTerraform(
 	return &data, err
 }
 
-// The query or mutation executed by getUser.
-const getUser_Operation = `
-query getUser ($id: UserId!) {
-	user(id: $id) {
-		... User
+// The query or mutation executed by listWorkspaces.
+const listWorkspaces_Operation = `
+query listWorkspaces {
+	workspaces: projects {
+		... Workspace
 	}
 }
-fragment User on User {
+fragment Workspace on Project {
 	id
-	email
-	comment
 	label
 }
 `
 
-func getUser(
+func listWorkspaces(
 	ctx context.Context,
 	client graphql.Client,
-	id types.UserIdScalar,
-) (*getUserResponse, error) {
+) (*listWorkspacesResponse, error) {
 	req := &graphql.Request{
-		OpName: "getUser",
-		Query:  getUser_Operation,
-		Variables: &__getUserInput{
-			Id: id,
-		},
+		OpName: "listWorkspaces",
+		Query:  listWorkspaces_Operation,
 	}
 	var err error
 
-	var data getUserResponse
+	var data listWorkspacesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17028,53 +24392,51 @@ func getUser(
 	return &data, err
 }
 
-// The query or mutation executed by getWorksheet.
-const getWorksheet_Operation = `
-query getWorksheet ($id: ObjectId!) {
-	worksheet(id: $id) {
-		... Worksheet
+// The query or mutation executed by lookupApp.
+const lookupApp_Operation = `
+query lookupApp ($workspaceId: ObjectId!, $name: String!) {
+	apps(workspaceId: $workspaceId, name: $name) {
+		... App
 	}
 }
-fragment Worksheet on Worksheet {
+fragment App on App {
 	id
-	label
-	icon
-	managedById
+	name
+	iconUrl
+	description
 	workspaceId
-	stages {
-		... StageQuery
+	folderId
+	config {
+		moduleId
+		version
 	}
-}
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
+	status {
+		state
+		internalError
 	}
+	outputs
 }
 `
 
-func getWorksheet(
+// LookupApp retrieves app by name.
+// TODO: this should be bound to a folderId, not a workspace.
+func lookupApp(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getWorksheetResponse, error) {
+	workspaceId string,
+	name string,
+) (*lookupAppResponse, error) {
 	req := &graphql.Request{
-		OpName: "getWorksheet",
-		Query:  getWorksheet_Operation,
-		Variables: &__getWorksheetInput{
-			Id: id,
+		OpName: "lookupApp",
+		Query:  lookupApp_Operation,
+		Variables: &__lookupAppInput{
+			WorkspaceId: workspaceId,
+			Name:        name,
 		},
 	}
 	var err error
 
-	var data getWorksheetResponse
+	var data lookupAppResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17086,34 +24448,32 @@ func getWorksheet(
 	return &data, err
 }
 
-// The query or mutation executed by getWorkspace.
-const getWorkspace_Operation = `
-query getWorkspace ($id: ObjectId!) {
-	workspace(id: $id) {
-		... Workspace
+// The query or mutation executed by lookupDataConnectionModuleVersions.
+const lookupDataConnectionModuleVersions_Operation = `
+query lookupDataConnectionModuleVersions ($id: String!, $workspaceId: ObjectId!) {
+	moduleVersions: dataConnectionModuleVersions(id: $id, workspaceId: $workspaceId) {
+		version
 	}
 }
-fragment Workspace on Project {
-	id
-	label
-}
 `
 
-func getWorkspace(
+func lookupDataConnectionModuleVersions(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-) (*getWorkspaceResponse, error) {
+	workspaceId string,
+) (*lookupDataConnectionModuleVersionsResponse, error) {
 	req := &graphql.Request{
-		OpName: "getWorkspace",
-		Query:  getWorkspace_Operation,
-		Variables: &__getWorkspaceInput{
-			Id: id,
+		OpName: "lookupDataConnectionModuleVersions",
+		Query:  lookupDataConnectionModuleVersions_Operation,
+		Variables: &__lookupDataConnectionModuleVersionsInput{
+			Id:          id,
+			WorkspaceId: workspaceId,
 		},
 	}
 	var err error
 
-	var data getWorkspaceResponse
+	var data lookupDataConnectionModuleVersionsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17125,11 +24485,11 @@ func getWorkspace(
 	return &data, err
 }
 
-// The query or mutation executed by listDatasets.
-const listDatasets_Operation = `
-query listDatasets {
-	datasets: projects {
-		datasets {
+// The query or mutation executed by lookupDataset.
+const lookupDataset_Operation = `
+query lookupDataset ($workspaceId: ObjectId!, $name: String!) {
+	dataset: workspace(id: $workspaceId) {
+		dataset(label: $name) {
 			... Dataset
 		}
 	}
@@ -17220,17 +24580,23 @@ fragment StageQuery on StageQuery {
 }
 `
 
-func listDatasets(
+func lookupDataset(
 	ctx context.Context,
 	client graphql.Client,
-) (*listDatasetsResponse, error) {
+	workspaceId string,
+	name string,
+) (*lookupDatasetResponse, error) {
 	req := &graphql.Request{
-		OpName: "listDatasets",
-		Query:  listDatasets_Operation,
+		OpName: "lookupDataset",
+		Query:  lookupDataset_Operation,
+		Variables: &__lookupDatasetInput{
+			WorkspaceId: workspaceId,
+			Name:        name,
+		},
 	}
 	var err error
 
-	var data listDatasetsResponse
+	var data lookupDatasetResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17242,32 +24608,42 @@ func listDatasets(
 	return &data, err
 }
 
-// The query or mutation executed by listDatasetsIdNameOnly.
-const listDatasetsIdNameOnly_Operation = `
-query listDatasetsIdNameOnly {
-	datasets: datasetSearch {
-		dataset {
-			... DatasetIdName
+// The query or mutation executed by lookupDatastream.
+const lookupDatastream_Operation = `
+query lookupDatastream ($workspaceId: ObjectId!, $name: String!) {
+	datastream: workspace(id: $workspaceId) {
+		datastream(name: $name) {
+			... Datastream
 		}
 	}
 }
-fragment DatasetIdName on Dataset {
-	name
+fragment Datastream on Datastream {
 	id
+	name
+	iconUrl
+	description
+	workspaceId
+	datasetId
 }
 `
 
-func listDatasetsIdNameOnly(
+func lookupDatastream(
 	ctx context.Context,
 	client graphql.Client,
-) (*listDatasetsIdNameOnlyResponse, error) {
+	workspaceId string,
+	name string,
+) (*lookupDatastreamResponse, error) {
 	req := &graphql.Request{
-		OpName: "listDatasetsIdNameOnly",
-		Query:  listDatasetsIdNameOnly_Operation,
+		OpName: "lookupDatastream",
+		Query:  lookupDatastream_Operation,
+		Variables: &__lookupDatastreamInput{
+			WorkspaceId: workspaceId,
+			Name:        name,
+		},
 	}
 	var err error
 
-	var data listDatasetsIdNameOnlyResponse
+	var data lookupDatastreamResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17279,77 +24655,46 @@ func listDatasetsIdNameOnly(
 	return &data, err
 }
 
-// The query or mutation executed by listUsers.
-const listUsers_Operation = `
-query listUsers {
-	users: currentCustomer {
-		users {
-			... User
+// The query or mutation executed by lookupFolder.
+const lookupFolder_Operation = `
+query lookupFolder ($workspaceId: ObjectId!, $name: String!) {
+	folder: workspace(id: $workspaceId) {
+		folder(name: $name) {
+			... Folder
 		}
 	}
 }
-fragment User on User {
+fragment Folder on Folder {
 	id
-	email
-	comment
-	label
-}
-`
-
-func listUsers(
-	ctx context.Context,
-	client graphql.Client,
-) (*listUsersResponse, error) {
-	req := &graphql.Request{
-		OpName: "listUsers",
-		Query:  listUsers_Operation,
-	}
-	var err error
-
-	var data listUsersResponse
-	resp := &graphql.Response{Data: &data}
-
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
-
-	return &data, err
-}
-
-// The query or mutation executed by listWorksheetsIdLabelOnly.
-const listWorksheetsIdLabelOnly_Operation = `
-query listWorksheetsIdLabelOnly ($workspaceId: ObjectId!) {
-	worksheetSearch(terms: {workspaceId:[$workspaceId]}) {
-		worksheets {
-			worksheet {
-				... WorksheetIdLabel
-			}
+	name
+	iconUrl
+	description
+	workspaceId
+	effectiveSettings {
+		scanner {
+			powerLevel
 		}
 	}
 }
-fragment WorksheetIdLabel on Worksheet {
-	id
-	label
-}
 `
 
-func listWorksheetsIdLabelOnly(
+func lookupFolder(
 	ctx context.Context,
 	client graphql.Client,
 	workspaceId string,
-) (*listWorksheetsIdLabelOnlyResponse, error) {
+	name string,
+) (*lookupFolderResponse, error) {
 	req := &graphql.Request{
-		OpName: "listWorksheetsIdLabelOnly",
-		Query:  listWorksheetsIdLabelOnly_Operation,
-		Variables: &__listWorksheetsIdLabelOnlyInput{
+		OpName: "lookupFolder",
+		Query:  lookupFolder_Operation,
+		Variables: &__lookupFolderInput{
 			WorkspaceId: workspaceId,
+			Name:        name,
 		},
 	}
 	var err error
 
-	var data listWorksheetsIdLabelOnlyResponse
+	var data lookupFolderResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17361,30 +24706,33 @@ func listWorksheetsIdLabelOnly(
 	return &data, err
 }
 
-// The query or mutation executed by listWorkspaces.
-const listWorkspaces_Operation = `
-query listWorkspaces {
-	workspaces: projects {
-		... Workspace
+// The query or mutation executed by lookupModuleVersions.
+const lookupModuleVersions_Operation = `
+query lookupModuleVersions ($id: String!) {
+	moduleVersions(id: $id) {
+		... ModuleVersion
 	}
 }
-fragment Workspace on Project {
-	id
-	label
+fragment ModuleVersion on ModuleVersion {
+	version
 }
 `
 
-func listWorkspaces(
+func lookupModuleVersions(
 	ctx context.Context,
 	client graphql.Client,
-) (*listWorkspacesResponse, error) {
+	id string,
+) (*lookupModuleVersionsResponse, error) {
 	req := &graphql.Request{
-		OpName: "listWorkspaces",
-		Query:  listWorkspaces_Operation,
+		OpName: "lookupModuleVersions",
+		Query:  lookupModuleVersions_Operation,
+		Variables: &__lookupModuleVersionsInput{
+			Id: id,
+		},
 	}
 	var err error
 
-	var data listWorkspacesResponse
+	var data lookupModuleVersionsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17396,51 +24744,122 @@ func listWorkspaces(
 	return &data, err
 }
 
-// The query or mutation executed by lookupApp.
-const lookupApp_Operation = `
-query lookupApp ($workspaceId: ObjectId!, $name: String!) {
-	apps(workspaceId: $workspaceId, name: $name) {
-		... App
+// The query or mutation executed by lookupMonitor.
+const lookupMonitor_Operation = `
+query lookupMonitor ($workspaceId: ObjectId!, $name: String!) {
+	monitor: workspace(id: $workspaceId) {
+		monitor(name: $name) {
+			... Monitor
+		}
+	}
+}
+fragment Monitor on Monitor {
+	workspaceId
+	id
+	name
+	description
+	comment
+	iconUrl
+	isTemplate
+	disabled
+	freshnessGoal
+	useDefaultFreshness
+	source
+	definition
+	managedById
+	query {
+		outputStage
+		stages {
+			... StageQuery
+		}
+	}
+	rule {
+		__typename
+		sourceColumn
+		groupByGroups {
+			groupName
+			columns
+		}
+		... on MonitorRuleCount {
+			compareFunction
+			compareValues
+			lookbackTime
+		}
+		... on MonitorRuleChange {
+			changeType
+			compareFunction
+			compareValues
+			aggregateFunction
+			lookbackTime
+			baselineTime
+		}
+		... on MonitorRuleFacet {
+			facetFunction
+			facetValues
+			timeFunction
+			timeValue
+			lookbackTime
+		}
+		... on MonitorRuleThreshold {
+			compareFunction
+			compareValues
+			lookbackTime
+			thresholdAggFunction
+		}
+		... on MonitorRulePromote {
+			kindField
+			descriptionField
+			primaryKey
+		}
+		... on MonitorRuleLog {
+			compareFunction
+			compareValues
+			lookbackTime
+			expressionSummary
+			logStageId
+			sourceLogDatasetId
+		}
+	}
+	notificationSpec {
+		merge
+		importance
+		notifyOnReminder
+		reminderFrequency
+		notifyOnClose
 	}
 }
-fragment App on App {
+fragment StageQuery on StageQuery {
 	id
-	name
-	iconUrl
-	description
-	workspaceId
-	folderId
-	config {
-		moduleId
-		version
-	}
-	status {
-		state
-		internalError
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
 	}
-	outputs
 }
 `
 
-// LookupApp retrieves app by name.
-// TODO: this should be bound to a folderId, not a workspace.
-func lookupApp(
+func lookupMonitor(
 	ctx context.Context,
 	client graphql.Client,
 	workspaceId string,
 	name string,
-) (*lookupAppResponse, error) {
+) (*lookupMonitorResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupApp",
-		Query:  lookupApp_Operation,
-		Variables: &__lookupAppInput{
+		OpName: "lookupMonitor",
+		Query:  lookupMonitor_Operation,
+		Variables: &__lookupMonitorInput{
 			WorkspaceId: workspaceId,
 			Name:        name,
 		},
 	}
 	var err error
 
-	var data lookupAppResponse
+	var data lookupMonitorResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17452,118 +24871,214 @@ func lookupApp(
 	return &data, err
 }
 
-// The query or mutation executed by lookupDataset.
-const lookupDataset_Operation = `
-query lookupDataset ($workspaceId: ObjectId!, $name: String!) {
-	dataset: workspace(id: $workspaceId) {
-		dataset(label: $name) {
-			... Dataset
-		}
+// The query or mutation executed by lookupMonitorV2.
+const lookupMonitorV2_Operation = `
+query lookupMonitorV2 ($workspaceId: ObjectId, $folderId: ObjectId, $nameExact: String, $nameSubstring: String) {
+	monitorV2s: searchMonitorV2(workspaceId: $workspaceId, folderId: $folderId, nameExact: $nameExact, nameSubstring: $nameSubstring) {
+		... MonitorV2SearchResult
 	}
 }
-fragment Dataset on Dataset {
-	workspaceId
+fragment MonitorV2SearchResult on MonitorV2SearchResult {
+	results {
+		... MonitorV2
+	}
+}
+fragment MonitorV2 on MonitorV2 {
 	id
+	workspaceId
+	createdBy
+	createdDate
 	name
-	freshnessDesired
-	description
 	iconUrl
-	accelerationDisabled
-	version
-	updatedDate
-	pathCost
-	source
+	description
 	managedById
-	onDemandMaterializationLength
-	dataTableViewState
-	foreignKeys {
-		label
-		targetDataset
-		srcFields
-		dstFields
+	folderId
+	rollupStatus
+	ruleKind
+	definition {
+		... MonitorV2Definition
 	}
-	transform {
-		current {
-			query {
-				outputStage
-				stages {
-					... StageQuery
-				}
-			}
-		}
+	actionRules {
+		... MonitorV2ActionRule
 	}
-	typedef {
-		label
-		def {
-			anykey
-			fields {
-				name
-				type {
-					rep
-					nullable
-				}
-				isEnum
-				isSearchable
-				isHidden
-				isConst
-				isMetric
-			}
-		}
+	mutes {
+		... MonitorV2MuteRule
 	}
-	sourceTable {
-		schema
-		partitions {
-			name
-		}
-		sourceUpdateTableName
-		isInsertOnly
-		batchSeqField
-		validFromField
-		fields {
-			name
-			sqlType
+}
+fragment MonitorV2Definition on MonitorV2Definition {
+	inputQuery {
+		outputStage
+		stages {
+			... StageQuery
 		}
 	}
-	correlationTagMappings {
-		tag
-		path {
-			column
-			path
+	rules {
+		... MonitorV2Rule
+	}
+	lookbackTime
+	dataStabilizationDelay
+	groupings {
+		... MonitorV2Column
+	}
+	scheduling {
+		... MonitorV2Scheduling
+	}
+}
+fragment MonitorV2ActionRule on MonitorV2ActionRule {
+	actionID
+	levels
+	sendEndNotifications
+	sendRemindersInterval
+}
+fragment MonitorV2MuteRule on MonitorV2MuteRule {
+	id
+	workspaceId
+	name
+	description
+	monitorID
+	schedule {
+		type
+		oneTime {
+			startTime
+			endTime
 		}
 	}
+	validFrom
+	validTo
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+fragment MonitorV2Rule on MonitorV2Rule {
+	level
+	count {
+		... MonitorV2CountRule
+	}
+	threshold {
+		... MonitorV2ThresholdRule
+	}
+	promote {
+		... MonitorV2PromoteRule
+	}
+}
+fragment MonitorV2Column on MonitorV2Column {
+	linkColumn {
+		... MonitorV2LinkColumn
+	}
+	columnPath {
+		... MonitorV2ColumnPath
+	}
+}
+fragment MonitorV2Scheduling on MonitorV2Scheduling {
+	interval {
+		... MonitorV2IntervalSchedule
+	}
+	transform {
+		... MonitorV2TransformSchedule
+	}
+}
+fragment MonitorV2CountRule on MonitorV2CountRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	valueColumnName
+	aggregation
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
+	compareColumns {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
+	name
+	meta {
+		... MonitorV2LinkColumnMeta
+	}
+}
+fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
+	name
+	path
+}
+fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
+	interval
+	randomize
+}
+fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
+	freshnessGoal
+}
+fragment MonitorV2Comparison on MonitorV2Comparison {
+	compareFn
+	compareValue {
+		... PrimitiveValue
+	}
+}
+fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
+	column {
+		... MonitorV2Column
+	}
+	compareValues {
+		... MonitorV2Comparison
+	}
 }
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
+fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
+	srcFields {
+		... MonitorV2ColumnPath
 	}
+	dstFields
+	targetDataset
+}
+fragment PrimitiveValue on PrimitiveValue {
+	bool
+	float64
+	int64
+	string
+	timestamp
+	duration
 }
 `
 
-func lookupDataset(
+func lookupMonitorV2(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	name string,
-) (*lookupDatasetResponse, error) {
+	workspaceId *string,
+	folderId *string,
+	nameExact *string,
+	nameSubstring *string,
+) (*lookupMonitorV2Response, error) {
 	req := &graphql.Request{
-		OpName: "lookupDataset",
-		Query:  lookupDataset_Operation,
-		Variables: &__lookupDatasetInput{
-			WorkspaceId: workspaceId,
-			Name:        name,
+		OpName: "lookupMonitorV2",
+		Query:  lookupMonitorV2_Operation,
+		Variables: &__lookupMonitorV2Input{
+			WorkspaceId:   workspaceId,
+			FolderId:      folderId,
+			NameExact:     nameExact,
+			NameSubstring: nameSubstring,
 		},
 	}
 	var err error
 
-	var data lookupDatasetResponse
+	var data lookupMonitorV2Response
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17575,42 +25090,48 @@ func lookupDataset(
 	return &data, err
 }
 
-// The query or mutation executed by lookupDatastream.
-const lookupDatastream_Operation = `
-query lookupDatastream ($workspaceId: ObjectId!, $name: String!) {
-	datastream: workspace(id: $workspaceId) {
-		datastream(name: $name) {
-			... Datastream
+// The query or mutation executed by lookupSnowflakeOutboundShare.
+const lookupSnowflakeOutboundShare_Operation = `
+query lookupSnowflakeOutboundShare ($name: String!, $workspaceId: ObjectId!) {
+	shares: searchSnowflakeOutboundShare(nameExact: $name, workspaceId: $workspaceId) {
+		results {
+			... SnowflakeOutboundShare
 		}
 	}
 }
-fragment Datastream on Datastream {
+fragment SnowflakeOutboundShare on SnowflakeOutboundShare {
 	id
 	name
-	iconUrl
 	description
 	workspaceId
-	datasetId
+	shareName
+	accounts {
+		... SnowflakeAccount
+	}
+}
+fragment SnowflakeAccount on SnowflakeAccount {
+	organization
+	account
 }
 `
 
-func lookupDatastream(
+func lookupSnowflakeOutboundShare(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
 	name string,
-) (*lookupDatastreamResponse, error) {
+	workspaceId string,
+) (*lookupSnowflakeOutboundShareResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupDatastream",
-		Query:  lookupDatastream_Operation,
-		Variables: &__lookupDatastreamInput{
-			WorkspaceId: workspaceId,
+		OpName: "lookupSnowflakeOutboundShare",
+		Query:  lookupSnowflakeOutboundShare_Operation,
+		Variables: &__lookupSnowflakeOutboundShareInput{
 			Name:        name,
+			WorkspaceId: workspaceId,
 		},
 	}
 	var err error
 
-	var data lookupDatastreamResponse
+	var data lookupSnowflakeOutboundShareResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17622,41 +25143,34 @@ func lookupDatastream(
 	return &data, err
 }
 
-// The query or mutation executed by lookupFolder.
-const lookupFolder_Operation = `
-query lookupFolder ($workspaceId: ObjectId!, $name: String!) {
-	folder: workspace(id: $workspaceId) {
-		folder(name: $name) {
-			... Folder
-		}
+// The query or mutation executed by lookupWorkspace.
+const lookupWorkspace_Operation = `
+query lookupWorkspace ($name: String!) {
+	workspace(label: $name) {
+		... Workspace
 	}
 }
-fragment Folder on Folder {
+fragment Workspace on Project {
 	id
-	name
-	iconUrl
-	description
-	workspaceId
+	label
 }
 `
 
-func lookupFolder(
+func lookupWorkspace(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
 	name string,
-) (*lookupFolderResponse, error) {
+) (*lookupWorkspaceResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupFolder",
-		Query:  lookupFolder_Operation,
-		Variables: &__lookupFolderInput{
-			WorkspaceId: workspaceId,
-			Name:        name,
+		OpName: "lookupWorkspace",
+		Query:  lookupWorkspace_Operation,
+		Variables: &__lookupWorkspaceInput{
+			Name: name,
 		},
 	}
 	var err error
 
-	var data lookupFolderResponse
+	var data lookupWorkspaceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17668,160 +25182,96 @@ func lookupFolder(
 	return &data, err
 }
 
-// The query or mutation executed by lookupModuleVersions.
-const lookupModuleVersions_Operation = `
-query lookupModuleVersions ($id: String!) {
-	moduleVersions(id: $id) {
-		... ModuleVersion
+// The query or mutation executed by removeCorrelationTag.
+const removeCorrelationTag_Operation = `
+mutation removeCorrelationTag ($datasetId: ObjectId!, $path: LinkFieldInput!, $tag: String!) {
+	resultStatus: removeCorrelationTag(datasetId: $datasetId, path: $path, tag: $tag) {
+		... ResultStatus
 	}
 }
-fragment ModuleVersion on ModuleVersion {
-	version
+fragment ResultStatus on ResultStatus {
+	success
+	errorMessage
+	detailedInfo
 }
 `
 
-func lookupModuleVersions(
+func removeCorrelationTag(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*lookupModuleVersionsResponse, error) {
+	datasetId string,
+	path LinkFieldInput,
+	tag string,
+) (*removeCorrelationTagResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupModuleVersions",
-		Query:  lookupModuleVersions_Operation,
-		Variables: &__lookupModuleVersionsInput{
-			Id: id,
+		OpName: "removeCorrelationTag",
+		Query:  removeCorrelationTag_Operation,
+		Variables: &__removeCorrelationTagInput{
+			DatasetId: datasetId,
+			Path:      path,
+			Tag:       tag,
 		},
 	}
-	var err error
-
-	var data lookupModuleVersionsResponse
-	resp := &graphql.Response{Data: &data}
-
-	err = client.MakeRequest(
-		ctx,
-		req,
-		resp,
-	)
-
-	return &data, err
-}
-
-// The query or mutation executed by lookupMonitor.
-const lookupMonitor_Operation = `
-query lookupMonitor ($workspaceId: ObjectId!, $name: String!) {
-	monitor: workspace(id: $workspaceId) {
-		monitor(name: $name) {
-			... Monitor
-		}
-	}
-}
-fragment Monitor on Monitor {
-	workspaceId
-	id
-	name
-	description
-	comment
-	iconUrl
-	isTemplate
-	disabled
-	freshnessGoal
-	useDefaultFreshness
-	source
-	definition
-	managedById
-	query {
-		outputStage
-		stages {
-			... StageQuery
-		}
-	}
-	rule {
-		__typename
-		sourceColumn
-		groupByGroups {
-			groupName
-			columns
-		}
-		... on MonitorRuleCount {
-			compareFunction
-			compareValues
-			lookbackTime
-		}
-		... on MonitorRuleChange {
-			changeType
-			compareFunction
-			compareValues
-			aggregateFunction
-			lookbackTime
-			baselineTime
-		}
-		... on MonitorRuleFacet {
-			facetFunction
-			facetValues
-			timeFunction
-			timeValue
-			lookbackTime
-		}
-		... on MonitorRuleThreshold {
-			compareFunction
-			compareValues
-			lookbackTime
-			thresholdAggFunction
-		}
-		... on MonitorRulePromote {
-			kindField
-			descriptionField
-			primaryKey
-		}
-		... on MonitorRuleLog {
-			compareFunction
-			compareValues
-			lookbackTime
-			expressionSummary
-			logStageId
-			sourceLogDatasetId
-		}
-	}
-	notificationSpec {
-		merge
-		importance
-		notifyOnReminder
-		reminderFrequency
-		notifyOnClose
+	var err error
+
+	var data removeCorrelationTagResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by removeIncidentDashboards.
+const removeIncidentDashboards_Operation = `
+mutation removeIncidentDashboards ($id: ObjectId!, $dashboards: [ObjectId!]!) {
+	incident: removeIncidentDashboards(i: $id, ds: $dashboards) {
+		... Incident
 	}
 }
-fragment StageQuery on StageQuery {
+fragment Incident on Incident {
 	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
+	}
+	slackChannels {
+		connectionID
+		slackchannelID
 	}
+	worksheets
+	dashboards
 }
 `
 
-func lookupMonitor(
+func removeIncidentDashboards(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	name string,
-) (*lookupMonitorResponse, error) {
+	id string,
+	dashboards []string,
+) (*removeIncidentDashboardsResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupMonitor",
-		Query:  lookupMonitor_Operation,
-		Variables: &__lookupMonitorInput{
-			WorkspaceId: workspaceId,
-			Name:        name,
+		OpName: "removeIncidentDashboards",
+		Query:  removeIncidentDashboards_Operation,
+		Variables: &__removeIncidentDashboardsInput{
+			Id:         id,
+			Dashboards: dashboards,
 		},
 	}
 	var err error
 
-	var data lookupMonitorResponse
+	var data removeIncidentDashboardsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -17833,195 +25283,235 @@ func lookupMonitor(
 	return &data, err
 }
 
-// The query or mutation executed by lookupMonitorV2.
-const lookupMonitorV2_Operation = `
-query lookupMonitorV2 ($workspaceId: ObjectId, $folderId: ObjectId, $nameExact: String, $nameSubstring: String) {
-	monitorV2s: searchMonitorV2(workspaceId: $workspaceId, folderId: $folderId, nameExact: $nameExact, nameSubstring: $nameSubstring) {
-		... MonitorV2SearchResult
-	}
-}
-fragment MonitorV2SearchResult on MonitorV2SearchResult {
-	results {
-		... MonitorV2
+// The query or mutation executed by removeIncidentSlackChannels.
+const removeIncidentSlackChannels_Operation = `
+mutation removeIncidentSlackChannels ($id: ObjectId!, $channels: [IncidentSlackchannelInput!]!) {
+	incident: removeIncidentSlackchannels(i: $id, cs: $channels) {
+		... Incident
 	}
 }
-fragment MonitorV2 on MonitorV2 {
+fragment Incident on Incident {
 	id
 	workspaceId
-	createdBy
-	createdDate
+	folderId
 	name
 	iconUrl
 	description
-	managedById
-	folderId
-	rollupStatus
-	ruleKind
-	definition {
-		... MonitorV2Definition
-	}
-	actionRules {
-		... MonitorV2ActionRule
-	}
-}
-fragment MonitorV2Definition on MonitorV2Definition {
-	inputQuery {
-		outputStage
-		stages {
-			... StageQuery
-		}
-	}
-	rules {
-		... MonitorV2Rule
-	}
-	lookbackTime
-	dataStabilizationDelay
-	groupings {
-		... MonitorV2Column
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
 	}
-	scheduling {
-		... MonitorV2Scheduling
+	slackChannels {
+		connectionID
+		slackchannelID
 	}
+	worksheets
+	dashboards
 }
-fragment MonitorV2ActionRule on MonitorV2ActionRule {
-	actionID
-	levels
-	sendEndNotifications
-	sendRemindersInterval
-}
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
+`
+
+func removeIncidentSlackChannels(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	channels []IncidentSlackchannelInput,
+) (*removeIncidentSlackChannelsResponse, error) {
+	req := &graphql.Request{
+		OpName: "removeIncidentSlackChannels",
+		Query:  removeIncidentSlackChannels_Operation,
+		Variables: &__removeIncidentSlackChannelsInput{
+			Id:       id,
+			Channels: channels,
+		},
 	}
+	var err error
+
+	var data removeIncidentSlackChannelsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
-fragment MonitorV2Rule on MonitorV2Rule {
-	level
-	count {
-		... MonitorV2CountRule
-	}
-	threshold {
-		... MonitorV2ThresholdRule
-	}
-	promote {
-		... MonitorV2PromoteRule
+
+// The query or mutation executed by removeIncidentUsers.
+const removeIncidentUsers_Operation = `
+mutation removeIncidentUsers ($id: ObjectId!, $users: [UserId!]!) {
+	incident: removeIncidentUsers(i: $id, us: $users) {
+		... Incident
 	}
 }
-fragment MonitorV2Column on MonitorV2Column {
-	linkColumn {
-		... MonitorV2LinkColumn
+fragment Incident on Incident {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
 	}
-	columnPath {
-		... MonitorV2ColumnPath
+	slackChannels {
+		connectionID
+		slackchannelID
 	}
+	worksheets
+	dashboards
 }
-fragment MonitorV2Scheduling on MonitorV2Scheduling {
-	interval {
-		... MonitorV2IntervalSchedule
-	}
-	transform {
-		... MonitorV2TransformSchedule
+`
+
+func removeIncidentUsers(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	users []types.UserIdScalar,
+) (*removeIncidentUsersResponse, error) {
+	req := &graphql.Request{
+		OpName: "removeIncidentUsers",
+		Query:  removeIncidentUsers_Operation,
+		Variables: &__removeIncidentUsersInput{
+			Id:    id,
+			Users: users,
+		},
 	}
+	var err error
+
+	var data removeIncidentUsersResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
-fragment MonitorV2CountRule on MonitorV2CountRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	compareGroups {
-		... MonitorV2ColumnComparison
+
+// The query or mutation executed by removeIncidentWorksheets.
+const removeIncidentWorksheets_Operation = `
+mutation removeIncidentWorksheets ($id: ObjectId!, $worksheets: [ObjectId!]!) {
+	incident: removeIncidentWorksheets(i: $id, ws: $worksheets) {
+		... Incident
 	}
 }
-fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	valueColumnName
-	aggregation
-	compareGroups {
-		... MonitorV2ColumnComparison
+fragment Incident on Incident {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
 	}
-}
-fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
-	compareColumns {
-		... MonitorV2ColumnComparison
+	slackChannels {
+		connectionID
+		slackchannelID
 	}
+	worksheets
+	dashboards
 }
-fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
-	name
-	meta {
-		... MonitorV2LinkColumnMeta
+`
+
+func removeIncidentWorksheets(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	worksheets []string,
+) (*removeIncidentWorksheetsResponse, error) {
+	req := &graphql.Request{
+		OpName: "removeIncidentWorksheets",
+		Query:  removeIncidentWorksheets_Operation,
+		Variables: &__removeIncidentWorksheetsInput{
+			Id:         id,
+			Worksheets: worksheets,
+		},
 	}
+	var err error
+
+	var data removeIncidentWorksheetsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
-fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
-	name
-	path
-}
-fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
-	interval
-	randomize
-}
-fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
-	freshnessGoal
-}
-fragment MonitorV2Comparison on MonitorV2Comparison {
-	compareFn
-	compareValue {
-		... PrimitiveValue
+
+// The query or mutation executed by renderMonitorV2Template.
+const renderMonitorV2Template_Operation = `
+query renderMonitorV2Template ($templateDict: JsonObject!, $actionInput: MonitorV2ActionInput!) {
+	renderedTemplate: monitorV2RenderTemplate(templateDict: $templateDict, actionInput: $actionInput) {
+		... MonitorV2RenderedTemplate
 	}
 }
-fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
-	column {
-		... MonitorV2Column
+fragment MonitorV2RenderedTemplate on RenderedTemplate {
+	email {
+		action {
+			... MonitorV2EmailAction
+		}
 	}
-	compareValues {
-		... MonitorV2Comparison
+	webhook {
+		action {
+			... MonitorV2WebhookAction
+		}
 	}
 }
-fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
-	srcFields {
-		... MonitorV2ColumnPath
+fragment MonitorV2EmailAction on MonitorV2EmailAction {
+	users
+	addresses
+	subject
+	body
+	fragments
+}
+fragment MonitorV2WebhookAction on MonitorV2WebhookAction {
+	headers {
+		... MonitorV2WebhookHeader
 	}
-	dstFields
-	targetDataset
+	body
+	fragments
+	url
+	method
 }
-fragment PrimitiveValue on PrimitiveValue {
-	bool
-	float64
-	int64
-	string
-	timestamp
-	duration
+fragment MonitorV2WebhookHeader on MonitorV2WebhookHeader {
+	header
+	value
 }
 `
 
-func lookupMonitorV2(
+func renderMonitorV2Template(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId *string,
-	folderId *string,
-	nameExact *string,
-	nameSubstring *string,
-) (*lookupMonitorV2Response, error) {
+	templateDict types.JsonObject,
+	actionInput MonitorV2ActionInput,
+) (*renderMonitorV2TemplateResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupMonitorV2",
-		Query:  lookupMonitorV2_Operation,
-		Variables: &__lookupMonitorV2Input{
-			WorkspaceId:   workspaceId,
-			FolderId:      folderId,
-			NameExact:     nameExact,
-			NameSubstring: nameSubstring,
+		OpName: "renderMonitorV2Template",
+		Query:  renderMonitorV2Template_Operation,
+		Variables: &__renderMonitorV2TemplateInput{
+			TemplateDict: templateDict,
+			ActionInput:  actionInput,
 		},
 	}
 	var err error
 
-	var data lookupMonitorV2Response
+	var data renderMonitorV2TemplateResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18033,48 +25523,105 @@ func lookupMonitorV2(
 	return &data, err
 }
 
-// The query or mutation executed by lookupSnowflakeOutboundShare.
-const lookupSnowflakeOutboundShare_Operation = `
-query lookupSnowflakeOutboundShare ($name: String!, $workspaceId: ObjectId!) {
-	shares: searchSnowflakeOutboundShare(nameExact: $name, workspaceId: $workspaceId) {
-		results {
-			... SnowflakeOutboundShare
-		}
+// The query or mutation executed by saveDashboard.
+const saveDashboard_Operation = `
+mutation saveDashboard ($dashboardInput: DashboardInput!) {
+	dashboard: saveDashboard(dash: $dashboardInput) {
+		... Dashboard
 	}
 }
-fragment SnowflakeOutboundShare on SnowflakeOutboundShare {
+fragment Dashboard on Dashboard {
 	id
 	name
-	description
+	iconUrl
 	workspaceId
-	shareName
-	accounts {
-		... SnowflakeAccount
+	managedById
+	folderId
+	layout
+	stages {
+		id
+		input {
+			inputName
+			inputRole
+			datasetId
+			datasetPath
+			stageId
+		}
+		params
+		layout
+		pipeline
+	}
+	parameters {
+		id
+		name
+		defaultValue {
+			... valueFields
+		}
+		valueKind {
+			type
+			keyForDatasetId
+			arrayItemType {
+				type
+				keyForDatasetId
+			}
+		}
+	}
+	parameterValues {
+		id
+		value {
+			... valueFields
+		}
 	}
 }
-fragment SnowflakeAccount on SnowflakeAccount {
-	organization
-	account
+fragment valueFields on Value {
+	bool
+	float64
+	int64
+	string
+	array {
+		value {
+			... primitiveValueFields
+		}
+	}
+	link {
+		datasetId
+		primaryKeyValue {
+			name
+			value {
+				... primitiveValueFields
+			}
+		}
+		storedLabel
+	}
+	datasetref {
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+fragment primitiveValueFields on PrimitiveValue {
+	bool
+	float64
+	int64
+	string
 }
 `
 
-func lookupSnowflakeOutboundShare(
+func saveDashboard(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-	workspaceId string,
-) (*lookupSnowflakeOutboundShareResponse, error) {
+	dashboardInput DashboardInput,
+) (*saveDashboardResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupSnowflakeOutboundShare",
-		Query:  lookupSnowflakeOutboundShare_Operation,
-		Variables: &__lookupSnowflakeOutboundShareInput{
-			Name:        name,
-			WorkspaceId: workspaceId,
+		OpName: "saveDashboard",
+		Query:  saveDashboard_Operation,
+		Variables: &__saveDashboardInput{
+			DashboardInput: dashboardInput,
 		},
 	}
 	var err error
 
-	var data lookupSnowflakeOutboundShareResponse
+	var data saveDashboardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18086,34 +25633,122 @@ func lookupSnowflakeOutboundShare(
 	return &data, err
 }
 
-// The query or mutation executed by lookupWorkspace.
-const lookupWorkspace_Operation = `
-query lookupWorkspace ($name: String!) {
-	workspace(label: $name) {
-		... Workspace
+// The query or mutation executed by saveDataset.
+const saveDataset_Operation = `
+mutation saveDataset ($workspaceId: ObjectId!, $dataset: DatasetInput!, $query: MultiStageQueryInput!, $dep: DependencyHandlingInput) {
+	dataset: saveDataset(workspaceId: $workspaceId, dataset: $dataset, query: $query, dependencyHandling: $dep) {
+		dataset {
+			... Dataset
+		}
 	}
 }
-fragment Workspace on Project {
+fragment Dataset on Dataset {
+	workspaceId
 	id
-	label
+	name
+	freshnessDesired
+	description
+	iconUrl
+	accelerationDisabled
+	version
+	updatedDate
+	pathCost
+	source
+	managedById
+	onDemandMaterializationLength
+	dataTableViewState
+	foreignKeys {
+		label
+		targetDataset
+		srcFields
+		dstFields
+	}
+	transform {
+		current {
+			query {
+				outputStage
+				stages {
+					... StageQuery
+				}
+			}
+		}
+	}
+	typedef {
+		label
+		def {
+			anykey
+			fields {
+				name
+				type {
+					rep
+					nullable
+				}
+				isEnum
+				isSearchable
+				isHidden
+				isConst
+				isMetric
+			}
+		}
+	}
+	sourceTable {
+		schema
+		partitions {
+			name
+		}
+		sourceUpdateTableName
+		isInsertOnly
+		batchSeqField
+		validFromField
+		fields {
+			name
+			sqlType
+		}
+	}
+	correlationTagMappings {
+		tag
+		path {
+			column
+			path
+		}
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
 }
 `
 
-func lookupWorkspace(
+func saveDataset(
 	ctx context.Context,
 	client graphql.Client,
-	name string,
-) (*lookupWorkspaceResponse, error) {
+	workspaceId string,
+	dataset DatasetInput,
+	query MultiStageQueryInput,
+	dep *DependencyHandlingInput,
+) (*saveDatasetResponse, error) {
 	req := &graphql.Request{
-		OpName: "lookupWorkspace",
-		Query:  lookupWorkspace_Operation,
-		Variables: &__lookupWorkspaceInput{
-			Name: name,
+		OpName: "saveDataset",
+		Query:  saveDataset_Operation,
+		Variables: &__saveDatasetInput{
+			WorkspaceId: workspaceId,
+			Dataset:     dataset,
+			Query:       query,
+			Dep:         dep,
 		},
 	}
 	var err error
 
-	var data lookupWorkspaceResponse
+	var data saveDatasetResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18125,39 +25760,34 @@ func lookupWorkspace(
 	return &data, err
 }
 
-// The query or mutation executed by removeCorrelationTag.
-const removeCorrelationTag_Operation = `
-mutation removeCorrelationTag ($datasetId: ObjectId!, $path: LinkFieldInput!, $tag: String!) {
-	resultStatus: removeCorrelationTag(datasetId: $datasetId, path: $path, tag: $tag) {
-		... ResultStatus
+// The query or mutation executed by saveFolderSettings.
+const saveFolderSettings_Operation = `
+mutation saveFolderSettings ($workspaceId: ObjectId!, $id: ObjectId!, $input: LayeredFolderInput!) {
+	records: saveFolderSettings(workspaceId: $workspaceId, oid: $id, input: $input) {
+		id
 	}
 }
-fragment ResultStatus on ResultStatus {
-	success
-	errorMessage
-	detailedInfo
-}
 `
 
-func removeCorrelationTag(
+func saveFolderSettings(
 	ctx context.Context,
 	client graphql.Client,
-	datasetId string,
-	path LinkFieldInput,
-	tag string,
-) (*removeCorrelationTagResponse, error) {
+	workspaceId string,
+	id string,
+	input LayeredFolderInput,
+) (*saveFolderSettingsResponse, error) {
 	req := &graphql.Request{
-		OpName: "removeCorrelationTag",
-		Query:  removeCorrelationTag_Operation,
-		Variables: &__removeCorrelationTagInput{
-			DatasetId: datasetId,
-			Path:      path,
-			Tag:       tag,
+		OpName: "saveFolderSettings",
+		Query:  saveFolderSettings_Operation,
+		Variables: &__saveFolderSettingsInput{
+			WorkspaceId: workspaceId,
+			Id:          id,
+			Input:       input,
 		},
 	}
 	var err error
 
-	var data removeCorrelationTagResponse
+	var data saveFolderSettingsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18169,105 +25799,205 @@ func removeCorrelationTag(
 	return &data, err
 }
 
-// The query or mutation executed by saveDashboard.
-const saveDashboard_Operation = `
-mutation saveDashboard ($dashboardInput: DashboardInput!) {
-	dashboard: saveDashboard(dash: $dashboardInput) {
-		... Dashboard
+// The query or mutation executed by saveMonitorV2Relations.
+const saveMonitorV2Relations_Operation = `
+mutation saveMonitorV2Relations ($monitorId: ObjectId!, $actionRelations: [ActionRelationInput!]) {
+	monitorV2: saveMonitorV2Relations(monitorId: $monitorId, actionRelations: $actionRelations) {
+		... MonitorV2
 	}
 }
-fragment Dashboard on Dashboard {
+fragment MonitorV2 on MonitorV2 {
 	id
+	workspaceId
+	createdBy
+	createdDate
 	name
 	iconUrl
-	workspaceId
+	description
 	managedById
 	folderId
-	layout
-	stages {
-		id
-		input {
-			inputName
-			inputRole
-			datasetId
-			datasetPath
-			stageId
-		}
-		params
-		layout
-		pipeline
+	rollupStatus
+	ruleKind
+	definition {
+		... MonitorV2Definition
 	}
-	parameters {
-		id
-		name
-		defaultValue {
-			... valueFields
-		}
-		valueKind {
-			type
-			keyForDatasetId
-			arrayItemType {
-				type
-				keyForDatasetId
-			}
-		}
+	actionRules {
+		... MonitorV2ActionRule
 	}
-	parameterValues {
-		id
-		value {
-			... valueFields
-		}
+	mutes {
+		... MonitorV2MuteRule
 	}
 }
-fragment valueFields on Value {
-	bool
-	float64
-	int64
-	string
-	array {
-		value {
-			... primitiveValueFields
+fragment MonitorV2Definition on MonitorV2Definition {
+	inputQuery {
+		outputStage
+		stages {
+			... StageQuery
 		}
 	}
-	link {
-		datasetId
-		primaryKeyValue {
-			name
-			value {
-				... primitiveValueFields
-			}
+	rules {
+		... MonitorV2Rule
+	}
+	lookbackTime
+	dataStabilizationDelay
+	groupings {
+		... MonitorV2Column
+	}
+	scheduling {
+		... MonitorV2Scheduling
+	}
+}
+fragment MonitorV2ActionRule on MonitorV2ActionRule {
+	actionID
+	levels
+	sendEndNotifications
+	sendRemindersInterval
+}
+fragment MonitorV2MuteRule on MonitorV2MuteRule {
+	id
+	workspaceId
+	name
+	description
+	monitorID
+	schedule {
+		type
+		oneTime {
+			startTime
+			endTime
 		}
-		storedLabel
 	}
-	datasetref {
+	validFrom
+	validTo
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
 		datasetId
 		datasetPath
 		stageId
 	}
 }
-fragment primitiveValueFields on PrimitiveValue {
+fragment MonitorV2Rule on MonitorV2Rule {
+	level
+	count {
+		... MonitorV2CountRule
+	}
+	threshold {
+		... MonitorV2ThresholdRule
+	}
+	promote {
+		... MonitorV2PromoteRule
+	}
+}
+fragment MonitorV2Column on MonitorV2Column {
+	linkColumn {
+		... MonitorV2LinkColumn
+	}
+	columnPath {
+		... MonitorV2ColumnPath
+	}
+}
+fragment MonitorV2Scheduling on MonitorV2Scheduling {
+	interval {
+		... MonitorV2IntervalSchedule
+	}
+	transform {
+		... MonitorV2TransformSchedule
+	}
+}
+fragment MonitorV2CountRule on MonitorV2CountRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
+	compareValues {
+		... MonitorV2Comparison
+	}
+	valueColumnName
+	aggregation
+	compareGroups {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
+	compareColumns {
+		... MonitorV2ColumnComparison
+	}
+}
+fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
+	name
+	meta {
+		... MonitorV2LinkColumnMeta
+	}
+}
+fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
+	name
+	path
+}
+fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
+	interval
+	randomize
+}
+fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
+	freshnessGoal
+}
+fragment MonitorV2Comparison on MonitorV2Comparison {
+	compareFn
+	compareValue {
+		... PrimitiveValue
+	}
+}
+fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
+	column {
+		... MonitorV2Column
+	}
+	compareValues {
+		... MonitorV2Comparison
+	}
+}
+fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
+	srcFields {
+		... MonitorV2ColumnPath
+	}
+	dstFields
+	targetDataset
+}
+fragment PrimitiveValue on PrimitiveValue {
 	bool
 	float64
 	int64
 	string
+	timestamp
+	duration
 }
 `
 
-func saveDashboard(
+func saveMonitorV2Relations(
 	ctx context.Context,
 	client graphql.Client,
-	dashboardInput DashboardInput,
-) (*saveDashboardResponse, error) {
+	monitorId string,
+	actionRelations []ActionRelationInput,
+) (*saveMonitorV2RelationsResponse, error) {
 	req := &graphql.Request{
-		OpName: "saveDashboard",
-		Query:  saveDashboard_Operation,
-		Variables: &__saveDashboardInput{
-			DashboardInput: dashboardInput,
+		OpName: "saveMonitorV2Relations",
+		Query:  saveMonitorV2Relations_Operation,
+		Variables: &__saveMonitorV2RelationsInput{
+			MonitorId:       monitorId,
+			ActionRelations: actionRelations,
 		},
 	}
 	var err error
 
-	var data saveDashboardResponse
+	var data saveMonitorV2RelationsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18279,10 +26009,10 @@ func saveDashboard(
 	return &data, err
 }
 
-// The query or mutation executed by saveDataset.
-const saveDataset_Operation = `
-mutation saveDataset ($workspaceId: ObjectId!, $dataset: DatasetInput!, $query: MultiStageQueryInput!, $dep: DependencyHandlingInput) {
-	dataset: saveDataset(workspaceId: $workspaceId, dataset: $dataset, query: $query, dependencyHandling: $dep) {
+// The query or mutation executed by saveSourceDataset.
+const saveSourceDataset_Operation = `
+mutation saveSourceDataset ($workspaceId: ObjectId!, $datasetDefinition: DatasetDefinitionInput!, $sourceTable: SourceTableDefinitionInput!, $dep: DependencyHandlingInput) {
+	dataset: saveSourceDataset(workspaceId: $workspaceId, datasetDefinition: $datasetDefinition, sourceTable: $sourceTable, dependencyHandling: $dep) {
 		dataset {
 			... Dataset
 		}
@@ -18374,27 +26104,349 @@ fragment StageQuery on StageQuery {
 }
 `
 
-func saveDataset(
+func saveSourceDataset(
 	ctx context.Context,
 	client graphql.Client,
 	workspaceId string,
-	dataset DatasetInput,
-	query MultiStageQueryInput,
+	datasetDefinition DatasetDefinitionInput,
+	sourceTable SourceTableDefinitionInput,
 	dep *DependencyHandlingInput,
-) (*saveDatasetResponse, error) {
+) (*saveSourceDatasetResponse, error) {
 	req := &graphql.Request{
-		OpName: "saveDataset",
-		Query:  saveDataset_Operation,
-		Variables: &__saveDatasetInput{
-			WorkspaceId: workspaceId,
-			Dataset:     dataset,
-			Query:       query,
-			Dep:         dep,
+		OpName: "saveSourceDataset",
+		Query:  saveSourceDataset_Operation,
+		Variables: &__saveSourceDatasetInput{
+			WorkspaceId:       workspaceId,
+			DatasetDefinition: datasetDefinition,
+			SourceTable:       sourceTable,
+			Dep:               dep,
+		},
+	}
+	var err error
+
+	var data saveSourceDatasetResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by saveWorksheet.
+const saveWorksheet_Operation = `
+mutation saveWorksheet ($worksheetInput: WorksheetInput!) {
+	worksheet: saveWorksheet(wks: $worksheetInput) {
+		... Worksheet
+	}
+}
+fragment Worksheet on Worksheet {
+	id
+	label
+	icon
+	managedById
+	workspaceId
+	stages {
+		... StageQuery
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
+}
+`
+
+func saveWorksheet(
+	ctx context.Context,
+	client graphql.Client,
+	worksheetInput WorksheetInput,
+) (*saveWorksheetResponse, error) {
+	req := &graphql.Request{
+		OpName: "saveWorksheet",
+		Query:  saveWorksheet_Operation,
+		Variables: &__saveWorksheetInput{
+			WorksheetInput: worksheetInput,
+		},
+	}
+	var err error
+
+	var data saveWorksheetResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by searchApiTokens.
+const searchApiTokens_Operation = `
+query searchApiTokens ($user: UserId) {
+	apiTokens: searchAuthtokens(kinds: [Api], user: $user) {
+		... ApiToken
+	}
+}
+fragment ApiToken on Authtoken {
+	id
+	name
+	description
+	disabled
+	expiration
+	extensionSeconds
+	kind
+	user
+	createdDate
+	updatedDate
+}
+`
+
+func searchApiTokens(
+	ctx context.Context,
+	client graphql.Client,
+	user *types.UserIdScalar,
+) (*searchApiTokensResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchApiTokens",
+		Query:  searchApiTokens_Operation,
+		Variables: &__searchApiTokensInput{
+			User: user,
+		},
+	}
+	var err error
+
+	var data searchApiTokensResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by searchDashboards.
+const searchDashboards_Operation = `
+query searchDashboards ($terms: DWSearchInput!) {
+	dashboardSearch(terms: $terms) {
+		dashboards {
+			dashboard {
+				id
+				name
+				description
+				workspaceId
+				folderId
+				managedById
+			}
+		}
+	}
+}
+`
+
+func searchDashboards(
+	ctx context.Context,
+	client graphql.Client,
+	terms DWSearchInput,
+) (*searchDashboardsResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchDashboards",
+		Query:  searchDashboards_Operation,
+		Variables: &__searchDashboardsInput{
+			Terms: terms,
+		},
+	}
+	var err error
+
+	var data searchDashboardsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by searchDataConnection.
+const searchDataConnection_Operation = `
+query searchDataConnection ($workspaceId: ObjectId, $folderId: ObjectId, $nameExact: String, $nameSubstring: String) {
+	dataConnections: searchDataConnection(workspaceId: $workspaceId, folderId: $folderId, nameExact: $nameExact, nameSubstring: $nameSubstring) {
+		results {
+			... DataConnection
+		}
+	}
+}
+fragment DataConnection on DataConnection {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	moduleID
+	version
+	variables {
+		... DataVariable
+	}
+	outputs {
+		name
+		target
+	}
+}
+fragment DataVariable on DataVariable {
+	name
+	value
+	sensitive
+}
+`
+
+func searchDataConnection(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId *string,
+	folderId *string,
+	nameExact *string,
+	nameSubstring *string,
+) (*searchDataConnectionResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchDataConnection",
+		Query:  searchDataConnection_Operation,
+		Variables: &__searchDataConnectionInput{
+			WorkspaceId:   workspaceId,
+			FolderId:      folderId,
+			NameExact:     nameExact,
+			NameSubstring: nameSubstring,
+		},
+	}
+	var err error
+
+	var data searchDataConnectionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by searchDatasetOutboundShare.
+const searchDatasetOutboundShare_Operation = `
+query searchDatasetOutboundShare ($workspaceId: ObjectId, $folderId: ObjectId, $nameExact: String, $nameSubstring: String) {
+	datasetOutboundShares: searchDatasetOutboundShare(workspaceId: $workspaceId, folderId: $folderId, nameExact: $nameExact, nameSubstring: $nameSubstring) {
+		results {
+			... DatasetOutboundShare
+		}
+	}
+}
+fragment DatasetOutboundShare on DatasetOutboundShare {
+	id
+	name
+	description
+	workspaceId
+	folderId
+	datasetID
+	outboundShareID
+	schemaName
+	viewName
+	freshnessGoal
+	status {
+		state
+		error
+	}
+}
+`
+
+func searchDatasetOutboundShare(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId *string,
+	folderId *string,
+	nameExact *string,
+	nameSubstring *string,
+) (*searchDatasetOutboundShareResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchDatasetOutboundShare",
+		Query:  searchDatasetOutboundShare_Operation,
+		Variables: &__searchDatasetOutboundShareInput{
+			WorkspaceId:   workspaceId,
+			FolderId:      folderId,
+			NameExact:     nameExact,
+			NameSubstring: nameSubstring,
+		},
+	}
+	var err error
+
+	var data searchDatasetOutboundShareResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by searchDatasets.
+const searchDatasets_Operation = `
+query searchDatasets ($workspaceId: ObjectId!, $labelMatches: [String!], $correlationTagMatches: [String!], $searchMode: SearchMode) {
+	datasetMatches: datasetSearch(projects: [$workspaceId], labelMatches: $labelMatches, correlationTagMatches: $correlationTagMatches, searchMode: $searchMode) {
+		dataset {
+			id
+			name
+			description
+			workspaceId
+			folderId
+			managedById
+			source
+		}
+	}
+}
+`
+
+func searchDatasets(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	labelMatches []string,
+	correlationTagMatches []string,
+	searchMode *SearchMode,
+) (*searchDatasetsResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchDatasets",
+		Query:  searchDatasets_Operation,
+		Variables: &__searchDatasetsInput{
+			WorkspaceId:           workspaceId,
+			LabelMatches:          labelMatches,
+			CorrelationTagMatches: correlationTagMatches,
+			SearchMode:            searchMode,
 		},
 	}
 	var err error
 
-	var data saveDatasetResponse
+	var data searchDatasetsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18406,186 +26458,127 @@ func saveDataset(
 	return &data, err
 }
 
-// The query or mutation executed by saveMonitorV2Relations.
-const saveMonitorV2Relations_Operation = `
-mutation saveMonitorV2Relations ($monitorId: ObjectId!, $actionRelations: [ActionRelationInput!]) {
-	monitorV2: saveMonitorV2Relations(monitorId: $monitorId, actionRelations: $actionRelations) {
-		... MonitorV2
+// The query or mutation executed by searchDatasource.
+const searchDatasource_Operation = `
+query searchDatasource ($workspaceId: ObjectId, $folderId: ObjectId, $nameExact: String, $nameSubstring: String) {
+	datasources: searchDatasource(workspaceId: $workspaceId, folderId: $folderId, nameExact: $nameExact, nameSubstring: $nameSubstring) {
+		results {
+			... Datasource
+		}
 	}
 }
-fragment MonitorV2 on MonitorV2 {
+fragment Datasource on Datasource {
 	id
 	workspaceId
-	createdBy
-	createdDate
+	folderId
 	name
 	iconUrl
 	description
-	managedById
-	folderId
-	rollupStatus
-	ruleKind
-	definition {
-		... MonitorV2Definition
-	}
-	actionRules {
-		... MonitorV2ActionRule
-	}
-}
-fragment MonitorV2Definition on MonitorV2Definition {
-	inputQuery {
-		outputStage
-		stages {
-			... StageQuery
-		}
-	}
-	rules {
-		... MonitorV2Rule
-	}
-	lookbackTime
-	dataStabilizationDelay
-	groupings {
-		... MonitorV2Column
-	}
-	scheduling {
-		... MonitorV2Scheduling
-	}
-}
-fragment MonitorV2ActionRule on MonitorV2ActionRule {
-	actionID
-	levels
-	sendEndNotifications
-	sendRemindersInterval
-}
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
-	}
-}
-fragment MonitorV2Rule on MonitorV2Rule {
-	level
-	count {
-		... MonitorV2CountRule
-	}
-	threshold {
-		... MonitorV2ThresholdRule
-	}
-	promote {
-		... MonitorV2PromoteRule
-	}
-}
-fragment MonitorV2Column on MonitorV2Column {
-	linkColumn {
-		... MonitorV2LinkColumn
-	}
-	columnPath {
-		... MonitorV2ColumnPath
-	}
-}
-fragment MonitorV2Scheduling on MonitorV2Scheduling {
-	interval {
-		... MonitorV2IntervalSchedule
-	}
-	transform {
-		... MonitorV2TransformSchedule
+	dataConnectionID
+	datastreamID
+	datastreamTokenID
+	type
+	status {
+		state
 	}
-}
-fragment MonitorV2CountRule on MonitorV2CountRule {
-	compareValues {
-		... MonitorV2Comparison
+	variables {
+		... DataVariable
 	}
-	compareGroups {
-		... MonitorV2ColumnComparison
+	clientStackAttributes {
+		... DataVariable
 	}
 }
-fragment MonitorV2ThresholdRule on MonitorV2ThresholdRule {
-	compareValues {
-		... MonitorV2Comparison
-	}
-	valueColumnName
-	aggregation
-	compareGroups {
-		... MonitorV2ColumnComparison
-	}
+fragment DataVariable on DataVariable {
+	name
+	value
+	sensitive
 }
-fragment MonitorV2PromoteRule on MonitorV2PromoteRule {
-	compareColumns {
-		... MonitorV2ColumnComparison
+`
+
+func searchDatasource(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId *string,
+	folderId *string,
+	nameExact *string,
+	nameSubstring *string,
+) (*searchDatasourceResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchDatasource",
+		Query:  searchDatasource_Operation,
+		Variables: &__searchDatasourceInput{
+			WorkspaceId:   workspaceId,
+			FolderId:      folderId,
+			NameExact:     nameExact,
+			NameSubstring: nameSubstring,
+		},
 	}
+	var err error
+
+	var data searchDatasourceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
-fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
-	name
-	meta {
-		... MonitorV2LinkColumnMeta
+
+// The query or mutation executed by searchIncident.
+const searchIncident_Operation = `
+query searchIncident ($workspaceId: ObjectId, $folderId: ObjectId, $nameExact: String, $nameSubstring: String) {
+	incidents: searchIncident(workspaceId: $workspaceId, folderId: $folderId, nameExact: $nameExact, nameSubstring: $nameSubstring) {
+		results {
+			... Incident
+		}
 	}
 }
-fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
+fragment Incident on Incident {
+	id
+	workspaceId
+	folderId
 	name
-	path
-}
-fragment MonitorV2IntervalSchedule on MonitorV2IntervalSchedule {
-	interval
-	randomize
-}
-fragment MonitorV2TransformSchedule on MonitorV2TransformSchedule {
-	freshnessGoal
-}
-fragment MonitorV2Comparison on MonitorV2Comparison {
-	compareFn
-	compareValue {
-		... PrimitiveValue
-	}
-}
-fragment MonitorV2ColumnComparison on MonitorV2ColumnComparison {
-	column {
-		... MonitorV2Column
-	}
-	compareValues {
-		... MonitorV2Comparison
+	iconUrl
+	description
+	status
+	inactiveTime
+	closedTime
+	users {
+		userId
 	}
-}
-fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
-	srcFields {
-		... MonitorV2ColumnPath
+	slackChannels {
+		connectionID
+		slackchannelID
 	}
-	dstFields
-	targetDataset
-}
-fragment PrimitiveValue on PrimitiveValue {
-	bool
-	float64
-	int64
-	string
-	timestamp
-	duration
+	worksheets
+	dashboards
 }
 `
 
-func saveMonitorV2Relations(
+func searchIncident(
 	ctx context.Context,
 	client graphql.Client,
-	monitorId string,
-	actionRelations []ActionRelationInput,
-) (*saveMonitorV2RelationsResponse, error) {
+	workspaceId *string,
+	folderId *string,
+	nameExact *string,
+	nameSubstring *string,
+) (*searchIncidentResponse, error) {
 	req := &graphql.Request{
-		OpName: "saveMonitorV2Relations",
-		Query:  saveMonitorV2Relations_Operation,
-		Variables: &__saveMonitorV2RelationsInput{
-			MonitorId:       monitorId,
-			ActionRelations: actionRelations,
+		OpName: "searchIncident",
+		Query:  searchIncident_Operation,
+		Variables: &__searchIncidentInput{
+			WorkspaceId:   workspaceId,
+			FolderId:      folderId,
+			NameExact:     nameExact,
+			NameSubstring: nameSubstring,
 		},
 	}
 	var err error
 
-	var data saveMonitorV2RelationsResponse
+	var data searchIncidentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18597,83 +26590,81 @@ func saveMonitorV2Relations(
 	return &data, err
 }
 
-// The query or mutation executed by saveSourceDataset.
-const saveSourceDataset_Operation = `
-mutation saveSourceDataset ($workspaceId: ObjectId!, $datasetDefinition: DatasetDefinitionInput!, $sourceTable: SourceTableDefinitionInput!, $dep: DependencyHandlingInput) {
-	dataset: saveSourceDataset(workspaceId: $workspaceId, datasetDefinition: $datasetDefinition, sourceTable: $sourceTable, dependencyHandling: $dep) {
-		dataset {
-			... Dataset
+// The query or mutation executed by searchInvestigationNotebook.
+const searchInvestigationNotebook_Operation = `
+query searchInvestigationNotebook ($workspaceId: ObjectId, $folderId: ObjectId, $nameExact: String, $nameSubstring: String) {
+	investigationNotebooks: searchInvestigationNotebook(workspaceId: $workspaceId, folderId: $folderId, nameExact: $nameExact, nameSubstring: $nameSubstring) {
+		results {
+			... InvestigationNotebook
 		}
 	}
 }
-fragment Dataset on Dataset {
-	workspaceId
+fragment InvestigationNotebook on InvestigationNotebook {
 	id
+	workspaceId
+	folderId
 	name
-	freshnessDesired
-	description
 	iconUrl
-	accelerationDisabled
-	version
-	updatedDate
-	pathCost
-	source
-	managedById
-	onDemandMaterializationLength
-	dataTableViewState
-	foreignKeys {
-		label
-		targetDataset
-		srcFields
-		dstFields
+	description
+	incidentID
+	runbook {
+		url
 	}
-	transform {
-		current {
-			query {
-				outputStage
-				stages {
-					... StageQuery
-				}
-			}
-		}
+	blocks {
+		... NotebookBlock
 	}
-	typedef {
-		label
-		def {
-			anykey
-			fields {
-				name
-				type {
-					rep
-					nullable
+}
+fragment NotebookBlock on NotebookBlock {
+	type
+	id
+	properties {
+		markdown {
+			text
+		}
+		query {
+			description
+			query {
+				outputStage
+				stages {
+					... StageQuery
 				}
-				isEnum
-				isSearchable
-				isHidden
-				isConst
-				isMetric
 			}
 		}
-	}
-	sourceTable {
-		schema
-		partitions {
-			name
+		image {
+			base64
+			description
 		}
-		sourceUpdateTableName
-		isInsertOnly
-		batchSeqField
-		validFromField
-		fields {
+		raiseIncident {
+			preview {
+				text
+			}
+			summary
+			severity
+			slack {
+				channelName
+			}
+			teams
+			incidentOwner
+			confirmation
+		}
+		ping {
+			preview {
+				text
+			}
+			user
+			confirmation
+		}
+		ticket {
+			preview {
+				text
+			}
 			name
-			sqlType
+			description
+			priority
+			confirmation
 		}
-	}
-	correlationTagMappings {
-		tag
-		path {
-			column
-			path
+		o11yPlaceholder {
+			text
 		}
 	}
 }
@@ -18692,27 +26683,27 @@ fragment StageQuery on StageQuery {
 }
 `
 
-func saveSourceDataset(
+func searchInvestigationNotebook(
 	ctx context.Context,
 	client graphql.Client,
-	workspaceId string,
-	datasetDefinition DatasetDefinitionInput,
-	sourceTable SourceTableDefinitionInput,
-	dep *DependencyHandlingInput,
-) (*saveSourceDatasetResponse, error) {
+	workspaceId *string,
+	folderId *string,
+	nameExact *string,
+	nameSubstring *string,
+) (*searchInvestigationNotebookResponse, error) {
 	req := &graphql.Request{
-		OpName: "saveSourceDataset",
-		Query:  saveSourceDataset_Operation,
-		Variables: &__saveSourceDatasetInput{
-			WorkspaceId:       workspaceId,
-			DatasetDefinition: datasetDefinition,
-			SourceTable:       sourceTable,
-			Dep:               dep,
+		OpName: "searchInvestigationNotebook",
+		Query:  searchInvestigationNotebook_Operation,
+		Variables: &__searchInvestigationNotebookInput{
+			WorkspaceId:   workspaceId,
+			FolderId:      folderId,
+			NameExact:     nameExact,
+			NameSubstring: nameSubstring,
 		},
 	}
 	var err error
 
-	var data saveSourceDatasetResponse
+	var data searchInvestigationNotebookResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18724,53 +26715,46 @@ func saveSourceDataset(
 	return &data, err
 }
 
-// The query or mutation executed by saveWorksheet.
-const saveWorksheet_Operation = `
-mutation saveWorksheet ($worksheetInput: WorksheetInput!) {
-	worksheet: saveWorksheet(wks: $worksheetInput) {
-		... Worksheet
-	}
-}
-fragment Worksheet on Worksheet {
-	id
-	label
-	icon
-	managedById
-	workspaceId
-	stages {
-		... StageQuery
-	}
-}
-fragment StageQuery on StageQuery {
-	id
-	pipeline
-	params
-	layout
-	input {
-		inputName
-		inputRole
-		datasetId
-		datasetPath
-		stageId
+// The query or mutation executed by searchMetrics.
+const searchMetrics_Operation = `
+query searchMetrics ($workspaceId: ObjectId!, $match: String!, $correlationTagMatches: [String!]) {
+	metricSearch(workspaces: [$workspaceId], match: $match, correlationTagMatches: $correlationTagMatches) {
+		matches {
+			metric {
+				name
+				description
+			}
+			datasetId
+		}
+		datasets {
+			id
+			name
+			workspaceId
+			folderId
+		}
 	}
 }
 `
 
-func saveWorksheet(
+func searchMetrics(
 	ctx context.Context,
 	client graphql.Client,
-	worksheetInput WorksheetInput,
-) (*saveWorksheetResponse, error) {
+	workspaceId string,
+	match string,
+	correlationTagMatches []string,
+) (*searchMetricsResponse, error) {
 	req := &graphql.Request{
-		OpName: "saveWorksheet",
-		Query:  saveWorksheet_Operation,
-		Variables: &__saveWorksheetInput{
-			WorksheetInput: worksheetInput,
+		OpName: "searchMetrics",
+		Query:  searchMetrics_Operation,
+		Variables: &__searchMetricsInput{
+			WorkspaceId:           workspaceId,
+			Match:                 match,
+			CorrelationTagMatches: correlationTagMatches,
 		},
 	}
 	var err error
 
-	var data saveWorksheetResponse
+	var data searchMetricsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -18846,6 +26830,79 @@ func searchMonitorActions(
 	return &data, err
 }
 
+// The query or mutation executed by searchMonitorMuteRule.
+const searchMonitorMuteRule_Operation = `
+query searchMonitorMuteRule ($workspaceId: ObjectId, $folderId: ObjectId, $nameExact: String, $nameSubstring: String) {
+	monitorMuteRules: searchMonitorMuteRule(workspaceId: $workspaceId, folderId: $folderId, nameExact: $nameExact, nameSubstring: $nameSubstring) {
+		results {
+			... MonitorMuteRule
+		}
+	}
+}
+fragment MonitorMuteRule on MonitorMuteRule {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	startDate
+	duration
+	filter {
+		__typename
+		filterType
+		... on MonitorMuteRuleFilterPerColumn {
+			columnID
+			values
+		}
+		... on MonitorMuteRuleFilterPerResource {
+			resourceIds {
+				... MonitorMuteRuleResourceId
+			}
+		}
+	}
+}
+fragment MonitorMuteRuleResourceId on ResourceId {
+	datasetId
+	primaryKeyValue {
+		name
+		value
+	}
+}
+`
+
+func searchMonitorMuteRule(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId *string,
+	folderId *string,
+	nameExact *string,
+	nameSubstring *string,
+) (*searchMonitorMuteRuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchMonitorMuteRule",
+		Query:  searchMonitorMuteRule_Operation,
+		Variables: &__searchMonitorMuteRuleInput{
+			WorkspaceId:   workspaceId,
+			FolderId:      folderId,
+			NameExact:     nameExact,
+			NameSubstring: nameSubstring,
+		},
+	}
+	var err error
+
+	var data searchMonitorMuteRuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by searchMonitorV2Action.
 const searchMonitorV2Action_Operation = `
 query searchMonitorV2Action ($workspaceId: ObjectId, $folderId: ObjectId, $nameExact: String, $nameSubstring: String) {
@@ -18897,18 +26954,165 @@ fragment MonitorV2WebhookHeader on MonitorV2WebhookHeader {
 }
 `
 
-func searchMonitorV2Action(
+func searchMonitorV2Action(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId *string,
+	folderId *string,
+	nameExact *string,
+	nameSubstring *string,
+) (*searchMonitorV2ActionResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchMonitorV2Action",
+		Query:  searchMonitorV2Action_Operation,
+		Variables: &__searchMonitorV2ActionInput{
+			WorkspaceId:   workspaceId,
+			FolderId:      folderId,
+			NameExact:     nameExact,
+			NameSubstring: nameSubstring,
+		},
+	}
+	var err error
+
+	var data searchMonitorV2ActionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by searchMonitorV2Alarms.
+const searchMonitorV2Alarms_Operation = `
+query searchMonitorV2Alarms ($workspaceId: ObjectId!, $monitorIds: [ObjectId!], $minTime: Time, $maxTime: Time, $levels: [MonitorV2AlarmLevel!], $active: Boolean) {
+	alarms: searchMonitorV2Alarms(workspaceId: $workspaceId, monitorIds: $monitorIds, minTime: $minTime, maxTime: $maxTime, levels: $levels, active: $active) {
+		results {
+			... MonitorV2Alarm
+		}
+	}
+}
+fragment MonitorV2Alarm on MonitorV2Alarm {
+	id
+	start
+	end
+	capturedValues {
+		... MonitorV2CapturedValue
+	}
+	isActive
+	level
+	groupingHash
+	monitorVersion
+	monitor {
+		id
+		name
+	}
+}
+fragment MonitorV2CapturedValue on MonitorV2CapturedValue {
+	types
+	column {
+		... MonitorV2Column
+	}
+	value
+}
+fragment MonitorV2Column on MonitorV2Column {
+	linkColumn {
+		... MonitorV2LinkColumn
+	}
+	columnPath {
+		... MonitorV2ColumnPath
+	}
+}
+fragment MonitorV2LinkColumn on MonitorV2LinkColumn {
+	name
+	meta {
+		... MonitorV2LinkColumnMeta
+	}
+}
+fragment MonitorV2ColumnPath on MonitorV2ColumnPath {
+	name
+	path
+}
+fragment MonitorV2LinkColumnMeta on MonitorV2LinkColumnMeta {
+	srcFields {
+		... MonitorV2ColumnPath
+	}
+	dstFields
+	targetDataset
+}
+`
+
+func searchMonitorV2Alarms(
+	ctx context.Context,
+	client graphql.Client,
+	workspaceId string,
+	monitorIds []string,
+	minTime *types.TimeScalar,
+	maxTime *types.TimeScalar,
+	levels []MonitorV2AlarmLevel,
+	active *bool,
+) (*searchMonitorV2AlarmsResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchMonitorV2Alarms",
+		Query:  searchMonitorV2Alarms_Operation,
+		Variables: &__searchMonitorV2AlarmsInput{
+			WorkspaceId: workspaceId,
+			MonitorIds:  monitorIds,
+			MinTime:     minTime,
+			MaxTime:     maxTime,
+			Levels:      levels,
+			Active:      active,
+		},
+	}
+	var err error
+
+	var data searchMonitorV2AlarmsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by searchReferenceTable.
+const searchReferenceTable_Operation = `
+query searchReferenceTable ($workspaceId: ObjectId, $folderId: ObjectId, $nameExact: String, $nameSubstring: String) {
+	referenceTables: searchReferenceTable(workspaceId: $workspaceId, folderId: $folderId, nameExact: $nameExact, nameSubstring: $nameSubstring) {
+		results {
+			... ReferenceTable
+		}
+	}
+}
+fragment ReferenceTable on ReferenceTable {
+	id
+	workspaceId
+	name
+	iconUrl
+	description
+	datasetID
+	managedById
+}
+`
+
+func searchReferenceTable(
 	ctx context.Context,
 	client graphql.Client,
 	workspaceId *string,
 	folderId *string,
 	nameExact *string,
 	nameSubstring *string,
-) (*searchMonitorV2ActionResponse, error) {
+) (*searchReferenceTableResponse, error) {
 	req := &graphql.Request{
-		OpName: "searchMonitorV2Action",
-		Query:  searchMonitorV2Action_Operation,
-		Variables: &__searchMonitorV2ActionInput{
+		OpName: "searchReferenceTable",
+		Query:  searchReferenceTable_Operation,
+		Variables: &__searchReferenceTableInput{
 			WorkspaceId:   workspaceId,
 			FolderId:      folderId,
 			NameExact:     nameExact,
@@ -18917,7 +27121,49 @@ func searchMonitorV2Action(
 	}
 	var err error
 
-	var data searchMonitorV2ActionResponse
+	var data searchReferenceTableResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by searchWorksheets.
+const searchWorksheets_Operation = `
+query searchWorksheets ($terms: DWSearchInput!) {
+	worksheetSearch(terms: $terms) {
+		worksheets {
+			worksheet {
+				id
+				name
+				workspaceId
+				folderId
+			}
+		}
+	}
+}
+`
+
+func searchWorksheets(
+	ctx context.Context,
+	client graphql.Client,
+	terms DWSearchInput,
+) (*searchWorksheetsResponse, error) {
+	req := &graphql.Request{
+		OpName: "searchWorksheets",
+		Query:  searchWorksheets_Operation,
+		Variables: &__searchWorksheetsInput{
+			Terms: terms,
+		},
+	}
+	var err error
+
+	var data searchWorksheetsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -19131,6 +27377,55 @@ func unsetRbacDefaultGroup(
 	return &data, err
 }
 
+// The query or mutation executed by updateApiToken.
+const updateApiToken_Operation = `
+mutation updateApiToken ($id: String!, $input: AuthtokenInput!) {
+	apiToken: updateAuthtoken(id: $id, input: $input) {
+		... ApiToken
+	}
+}
+fragment ApiToken on Authtoken {
+	id
+	name
+	description
+	disabled
+	expiration
+	extensionSeconds
+	kind
+	user
+	createdDate
+	updatedDate
+}
+`
+
+func updateApiToken(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	input AuthtokenInput,
+) (*updateApiTokenResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateApiToken",
+		Query:  updateApiToken_Operation,
+		Variables: &__updateApiTokenInput{
+			Id:    id,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data updateApiTokenResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by updateApp.
 const updateApp_Operation = `
 mutation updateApp ($id: ObjectId!, $config: AppInput!) {
@@ -19439,6 +27734,65 @@ func updateDashboardLink(
 	return &data, err
 }
 
+// The query or mutation executed by updateDataConnection.
+const updateDataConnection_Operation = `
+mutation updateDataConnection ($id: ObjectId!, $input: DataConnectionInput!) {
+	dataConnection: updateDataConnection(id: $id, input: $input) {
+		... DataConnection
+	}
+}
+fragment DataConnection on DataConnection {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	moduleID
+	version
+	variables {
+		... DataVariable
+	}
+	outputs {
+		name
+		target
+	}
+}
+fragment DataVariable on DataVariable {
+	name
+	value
+	sensitive
+}
+`
+
+func updateDataConnection(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	input DataConnectionInput,
+) (*updateDataConnectionResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateDataConnection",
+		Query:  updateDataConnection_Operation,
+		Variables: &__updateDataConnectionInput{
+			Id:    id,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data updateDataConnectionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by updateDatasetOutboundShare.
 const updateDatasetOutboundShare_Operation = `
 mutation updateDatasetOutboundShare ($id: ObjectId!, $input: DatasetOutboundShareInput!) {
@@ -19492,6 +27846,69 @@ func updateDatasetOutboundShare(
 	return &data, err
 }
 
+// The query or mutation executed by updateDatasource.
+const updateDatasource_Operation = `
+mutation updateDatasource ($id: ObjectId!, $input: DatasourceInput!) {
+	datasource: updateDatasource(id: $id, input: $input) {
+		... Datasource
+	}
+}
+fragment Datasource on Datasource {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	dataConnectionID
+	datastreamID
+	datastreamTokenID
+	type
+	status {
+		state
+	}
+	variables {
+		... DataVariable
+	}
+	clientStackAttributes {
+		... DataVariable
+	}
+}
+fragment DataVariable on DataVariable {
+	name
+	value
+	sensitive
+}
+`
+
+func updateDatasource(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	input DatasourceInput,
+) (*updateDatasourceResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateDatasource",
+		Query:  updateDatasource_Operation,
+		Variables: &__updateDatasourceInput{
+			Id:    id,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data updateDatasourceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by updateDatastream.
 const updateDatastream_Operation = `
 mutation updateDatastream ($id: ObjectId!, $datastream: DatastreamInput!) {
@@ -19627,7 +28044,119 @@ func updateDeferredForeignKey(
 	}
 	var err error
 
-	var data updateDeferredForeignKeyResponse
+	var data updateDeferredForeignKeyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by updateFiledrop.
+const updateFiledrop_Operation = `
+mutation updateFiledrop ($id: ObjectId!, $input: FiledropInput!) {
+	filedrop: updateFiledrop(id: $id, input: $input) {
+		... Filedrop
+	}
+}
+fragment Filedrop on Filedrop {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	status
+	datastreamID
+	config {
+		provider {
+			__typename
+			... on FiledropProviderAwsConfig {
+				region
+				roleArn
+			}
+		}
+	}
+	endpoint {
+		__typename
+		... on FiledropS3Endpoint {
+			arn
+			bucket
+			prefix
+		}
+	}
+}
+`
+
+func updateFiledrop(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	input FiledropInput,
+) (*updateFiledropResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateFiledrop",
+		Query:  updateFiledrop_Operation,
+		Variables: &__updateFiledropInput{
+			Id:    id,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data updateFiledropResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by updateFolder.
+const updateFolder_Operation = `
+mutation updateFolder ($id: ObjectId!, $config: FolderInput!) {
+	folder: updateFolder(id: $id, folder: $config) {
+		... Folder
+	}
+}
+fragment Folder on Folder {
+	id
+	name
+	iconUrl
+	description
+	workspaceId
+	effectiveSettings {
+		scanner {
+			powerLevel
+		}
+	}
+}
+`
+
+func updateFolder(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	config FolderInput,
+) (*updateFolderResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateFolder",
+		Query:  updateFolder_Operation,
+		Variables: &__updateFolderInput{
+			Id:     id,
+			Config: config,
+		},
+	}
+	var err error
+
+	var data updateFolderResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -19639,58 +28168,52 @@ func updateDeferredForeignKey(
 	return &data, err
 }
 
-// The query or mutation executed by updateFiledrop.
-const updateFiledrop_Operation = `
-mutation updateFiledrop ($id: ObjectId!, $input: FiledropInput!) {
-	filedrop: updateFiledrop(id: $id, input: $input) {
-		... Filedrop
+// The query or mutation executed by updateIncident.
+const updateIncident_Operation = `
+mutation updateIncident ($id: ObjectId!, $input: IncidentInput!) {
+	incident: updateIncident(id: $id, input: $input) {
+		... Incident
 	}
 }
-fragment Filedrop on Filedrop {
+fragment Incident on Incident {
 	id
+	workspaceId
+	folderId
 	name
 	iconUrl
 	description
-	workspaceId
 	status
-	datastreamID
-	config {
-		provider {
-			__typename
-			... on FiledropProviderAwsConfig {
-				region
-				roleArn
-			}
-		}
+	inactiveTime
+	closedTime
+	users {
+		userId
 	}
-	endpoint {
-		__typename
-		... on FiledropS3Endpoint {
-			arn
-			bucket
-			prefix
-		}
+	slackChannels {
+		connectionID
+		slackchannelID
 	}
+	worksheets
+	dashboards
 }
 `
 
-func updateFiledrop(
+func updateIncident(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-	input FiledropInput,
-) (*updateFiledropResponse, error) {
+	input IncidentInput,
+) (*updateIncidentResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateFiledrop",
-		Query:  updateFiledrop_Operation,
-		Variables: &__updateFiledropInput{
+		OpName: "updateIncident",
+		Query:  updateIncident_Operation,
+		Variables: &__updateIncidentInput{
 			Id:    id,
 			Input: input,
 		},
 	}
 	var err error
 
-	var data updateFiledropResponse
+	var data updateIncidentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -19702,39 +28225,114 @@ func updateFiledrop(
 	return &data, err
 }
 
-// The query or mutation executed by updateFolder.
-const updateFolder_Operation = `
-mutation updateFolder ($id: ObjectId!, $config: FolderInput!) {
-	folder: updateFolder(id: $id, folder: $config) {
-		... Folder
+// The query or mutation executed by updateInvestigationNotebook.
+const updateInvestigationNotebook_Operation = `
+mutation updateInvestigationNotebook ($id: ObjectId!, $input: InvestigationNotebookInput!) {
+	investigationNotebook: updateInvestigationNotebook(id: $id, input: $input) {
+		... InvestigationNotebook
 	}
 }
-fragment Folder on Folder {
+fragment InvestigationNotebook on InvestigationNotebook {
 	id
+	workspaceId
+	folderId
 	name
 	iconUrl
 	description
-	workspaceId
+	incidentID
+	runbook {
+		url
+	}
+	blocks {
+		... NotebookBlock
+	}
+}
+fragment NotebookBlock on NotebookBlock {
+	type
+	id
+	properties {
+		markdown {
+			text
+		}
+		query {
+			description
+			query {
+				outputStage
+				stages {
+					... StageQuery
+				}
+			}
+		}
+		image {
+			base64
+			description
+		}
+		raiseIncident {
+			preview {
+				text
+			}
+			summary
+			severity
+			slack {
+				channelName
+			}
+			teams
+			incidentOwner
+			confirmation
+		}
+		ping {
+			preview {
+				text
+			}
+			user
+			confirmation
+		}
+		ticket {
+			preview {
+				text
+			}
+			name
+			description
+			priority
+			confirmation
+		}
+		o11yPlaceholder {
+			text
+		}
+	}
+}
+fragment StageQuery on StageQuery {
+	id
+	pipeline
+	params
+	layout
+	input {
+		inputName
+		inputRole
+		datasetId
+		datasetPath
+		stageId
+	}
 }
 `
 
-func updateFolder(
+func updateInvestigationNotebook(
 	ctx context.Context,
 	client graphql.Client,
 	id string,
-	config FolderInput,
-) (*updateFolderResponse, error) {
+	input InvestigationNotebookInput,
+) (*updateInvestigationNotebookResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateFolder",
-		Query:  updateFolder_Operation,
-		Variables: &__updateFolderInput{
-			Id:     id,
-			Config: config,
+		OpName: "updateInvestigationNotebook",
+		Query:  updateInvestigationNotebook_Operation,
+		Variables: &__updateInvestigationNotebookInput{
+			Id:    id,
+			Input: input,
 		},
 	}
 	var err error
 
-	var data updateFolderResponse
+	var data updateInvestigationNotebookResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -20060,6 +28658,142 @@ func updateMonitorActionAttachment(
 	return &data, err
 }
 
+// The query or mutation executed by updateMonitorMuteRule.
+const updateMonitorMuteRule_Operation = `
+mutation updateMonitorMuteRule ($id: ObjectId!, $input: MonitorMuteRuleInput!) {
+	monitorMuteRule: updateMonitorMuteRule(id: $id, input: $input) {
+		... MonitorMuteRule
+	}
+}
+fragment MonitorMuteRule on MonitorMuteRule {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	startDate
+	duration
+	filter {
+		__typename
+		filterType
+		... on MonitorMuteRuleFilterPerColumn {
+			columnID
+			values
+		}
+		... on MonitorMuteRuleFilterPerResource {
+			resourceIds {
+				... MonitorMuteRuleResourceId
+			}
+		}
+	}
+}
+fragment MonitorMuteRuleResourceId on ResourceId {
+	datasetId
+	primaryKeyValue {
+		name
+		value
+	}
+}
+`
+
+func updateMonitorMuteRule(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	input MonitorMuteRuleInput,
+) (*updateMonitorMuteRuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateMonitorMuteRule",
+		Query:  updateMonitorMuteRule_Operation,
+		Variables: &__updateMonitorMuteRuleInput{
+			Id:    id,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data updateMonitorMuteRuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by updateMonitorMuteRuleForMonitors.
+const updateMonitorMuteRuleForMonitors_Operation = `
+mutation updateMonitorMuteRuleForMonitors ($id: ObjectId!, $input: MonitorMuteRuleInput!, $monitorIds: [ObjectId!]!) {
+	monitorMuteRule: updateMonitorMuteRuleForMonitors(id: $id, input: $input, monitorIds: $monitorIds) {
+		... MonitorMuteRule
+	}
+}
+fragment MonitorMuteRule on MonitorMuteRule {
+	id
+	workspaceId
+	folderId
+	name
+	iconUrl
+	description
+	startDate
+	duration
+	filter {
+		__typename
+		filterType
+		... on MonitorMuteRuleFilterPerColumn {
+			columnID
+			values
+		}
+		... on MonitorMuteRuleFilterPerResource {
+			resourceIds {
+				... MonitorMuteRuleResourceId
+			}
+		}
+	}
+}
+fragment MonitorMuteRuleResourceId on ResourceId {
+	datasetId
+	primaryKeyValue {
+		name
+		value
+	}
+}
+`
+
+func updateMonitorMuteRuleForMonitors(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	input MonitorMuteRuleInput,
+	monitorIds []string,
+) (*updateMonitorMuteRuleForMonitorsResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateMonitorMuteRuleForMonitors",
+		Query:  updateMonitorMuteRuleForMonitors_Operation,
+		Variables: &__updateMonitorMuteRuleForMonitorsInput{
+			Id:         id,
+			Input:      input,
+			MonitorIds: monitorIds,
+		},
+	}
+	var err error
+
+	var data updateMonitorMuteRuleForMonitorsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by updateMonitorV2.
 const updateMonitorV2_Operation = `
 mutation updateMonitorV2 ($id: ObjectId!, $input: MonitorV2Input!) {
@@ -20085,6 +28819,9 @@ fragment MonitorV2 on MonitorV2 {
 	actionRules {
 		... MonitorV2ActionRule
 	}
+	mutes {
+		... MonitorV2MuteRule
+	}
 }
 fragment MonitorV2Definition on MonitorV2Definition {
 	inputQuery {
@@ -20111,6 +28848,22 @@ fragment MonitorV2ActionRule on MonitorV2ActionRule {
 	sendEndNotifications
 	sendRemindersInterval
 }
+fragment MonitorV2MuteRule on MonitorV2MuteRule {
+	id
+	workspaceId
+	name
+	description
+	monitorID
+	schedule {
+		type
+		oneTime {
+			startTime
+			endTime
+		}
+	}
+	validFrom
+	validTo
+}
 fragment StageQuery on StageQuery {
 	id
 	pipeline
@@ -20325,6 +29078,59 @@ func updateMonitorV2Action(
 	return &data, err
 }
 
+// The query or mutation executed by updateMonitorV2MuteRule.
+const updateMonitorV2MuteRule_Operation = `
+mutation updateMonitorV2MuteRule ($id: ObjectId!, $input: MonitorV2MuteRuleInput!) {
+	monitorV2MuteRule: updateMonitorV2MuteRule(id: $id, input: $input) {
+		... MonitorV2MuteRule
+	}
+}
+fragment MonitorV2MuteRule on MonitorV2MuteRule {
+	id
+	workspaceId
+	name
+	description
+	monitorID
+	schedule {
+		type
+		oneTime {
+			startTime
+			endTime
+		}
+	}
+	validFrom
+	validTo
+}
+`
+
+func updateMonitorV2MuteRule(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	input MonitorV2MuteRuleInput,
+) (*updateMonitorV2MuteRuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateMonitorV2MuteRule",
+		Query:  updateMonitorV2MuteRule_Operation,
+		Variables: &__updateMonitorV2MuteRuleInput{
+			Id:    id,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data updateMonitorV2MuteRuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by updatePoller.
 const updatePoller_Operation = `
 mutation updatePoller ($id: ObjectId!, $poller: PollerInput!) {
@@ -20397,6 +29203,10 @@ fragment Poller on Poller {
 			includeGroups
 			excludeGroups
 		}
+		... on PollerConfluentCloudConfig {
+			key
+			secret
+		}
 		... on PollerCloudWatchMetricsConfig {
 			period
 			delay
@@ -20669,6 +29479,52 @@ func updateRbacStatement(
 	return &data, err
 }
 
+// The query or mutation executed by updateReferenceTable.
+const updateReferenceTable_Operation = `
+mutation updateReferenceTable ($id: ObjectId!, $input: ReferenceTableInput!) {
+	referenceTable: updateReferenceTable(id: $id, input: $input) {
+		... ReferenceTable
+	}
+}
+fragment ReferenceTable on ReferenceTable {
+	id
+	workspaceId
+	name
+	iconUrl
+	description
+	datasetID
+	managedById
+}
+`
+
+func updateReferenceTable(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	input ReferenceTableInput,
+) (*updateReferenceTableResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateReferenceTable",
+		Query:  updateReferenceTable_Operation,
+		Variables: &__updateReferenceTableInput{
+			Id:    id,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data updateReferenceTableResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by updateSnowflakeOutboundShare.
 const updateSnowflakeOutboundShare_Operation = `
 mutation updateSnowflakeOutboundShare ($id: ObjectId!, $input: SnowflakeOutboundShareInput!) {
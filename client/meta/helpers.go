@@ -153,6 +153,18 @@ var AllMonitorV2HttpTypes = []MonitorV2HttpType{
 	MonitorV2HttpTypePut,
 }
 
+var AllMonitorV2AlertTypes = []MonitorV2AlertType{
+	MonitorV2AlertTypeEnded,
+	MonitorV2AlertTypeNew,
+	MonitorV2AlertTypeReminder,
+}
+
+var AllNotebookActionConfirmations = []NotebookActionConfirmation{
+	NotebookActionConfirmationNo,
+	NotebookActionConfirmationPending,
+	NotebookActionConfirmationYes,
+}
+
 const (
 	ErrNotFound = "NOT_FOUND"
 )
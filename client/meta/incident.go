@@ -0,0 +1,114 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	oid "github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+var AllIncidentStatuses = []IncidentStatus{
+	IncidentStatusActive,
+	IncidentStatusClosed,
+	IncidentStatusInactive,
+}
+
+type incidentResponse interface {
+	GetIncident() Incident
+}
+
+func incidentOrError(i incidentResponse, err error) (*Incident, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := i.GetIncident()
+	return &result, nil
+}
+
+func (client *Client) CreateIncident(ctx context.Context, workspaceId string, input *IncidentInput) (*Incident, error) {
+	resp, err := createIncident(ctx, client.Gql, workspaceId, *input)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) GetIncident(ctx context.Context, id string) (*Incident, error) {
+	resp, err := getIncident(ctx, client.Gql, id)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) UpdateIncident(ctx context.Context, id string, input *IncidentInput) (*Incident, error) {
+	resp, err := updateIncident(ctx, client.Gql, id, *input)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) DeleteIncident(ctx context.Context, id string) error {
+	resp, err := deleteIncident(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) LookupIncident(ctx context.Context, workspaceId string, name string) (*Incident, error) {
+	resp, err := searchIncident(ctx, client.Gql, &workspaceId, nil, &name, nil)
+	if err != nil {
+		return nil, err
+	}
+	results := resp.Incidents.Results
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected exactly one incident named %q, found %d", name, len(results))
+	}
+	return &results[0], nil
+}
+
+func (client *Client) GetIncidentsForStatus(ctx context.Context, status IncidentStatus, startingAt *types.TimeScalar, endingAt *types.TimeScalar) ([]Incident, error) {
+	resp, err := getIncidentsForStatus(ctx, client.Gql, status, startingAt, endingAt)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Incidents, nil
+}
+
+func (client *Client) AddIncidentUsers(ctx context.Context, id string, users []types.UserIdScalar) (*Incident, error) {
+	resp, err := addIncidentUsers(ctx, client.Gql, id, users)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) RemoveIncidentUsers(ctx context.Context, id string, users []types.UserIdScalar) (*Incident, error) {
+	resp, err := removeIncidentUsers(ctx, client.Gql, id, users)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) AddIncidentSlackChannels(ctx context.Context, id string, channels []IncidentSlackchannelInput) (*Incident, error) {
+	resp, err := addIncidentSlackChannels(ctx, client.Gql, id, channels)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) RemoveIncidentSlackChannels(ctx context.Context, id string, channels []IncidentSlackchannelInput) (*Incident, error) {
+	resp, err := removeIncidentSlackChannels(ctx, client.Gql, id, channels)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) AddIncidentWorksheets(ctx context.Context, id string, worksheets []string) (*Incident, error) {
+	resp, err := addIncidentWorksheets(ctx, client.Gql, id, worksheets)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) RemoveIncidentWorksheets(ctx context.Context, id string, worksheets []string) (*Incident, error) {
+	resp, err := removeIncidentWorksheets(ctx, client.Gql, id, worksheets)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) AddIncidentDashboards(ctx context.Context, id string, dashboards []string) (*Incident, error) {
+	resp, err := addIncidentDashboards(ctx, client.Gql, id, dashboards)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) RemoveIncidentDashboards(ctx context.Context, id string, dashboards []string) (*Incident, error) {
+	resp, err := removeIncidentDashboards(ctx, client.Gql, id, dashboards)
+	return incidentOrError(resp, err)
+}
+
+func (i *Incident) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   i.Id,
+		Type: oid.TypeIncident,
+	}
+}
@@ -43,6 +43,14 @@ func (client *Client) LookupMonitor(ctx context.Context, workspaceId, name strin
 	return monitorOrError(resp.Monitor, err)
 }
 
+func (client *Client) ListMonitors(ctx context.Context, workspaceId string) ([]Monitor, error) {
+	resp, err := listMonitors(ctx, client.Gql, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Monitors, nil
+}
+
 func (m *Monitor) Oid() *oid.OID {
 	return &oid.OID{
 		Id:   m.Id,
@@ -0,0 +1,71 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	oid "github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type monitorMuteRuleResponse interface {
+	GetMonitorMuteRule() MonitorMuteRule
+}
+
+func monitorMuteRuleOrError(m monitorMuteRuleResponse, err error) (*MonitorMuteRule, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := m.GetMonitorMuteRule()
+	return &result, nil
+}
+
+// CreateMonitorMuteRule creates a mute rule. If any monitors are provided,
+// the rule is attached to them.
+func (client *Client) CreateMonitorMuteRule(ctx context.Context, input *MonitorMuteRuleInput, monitorIds []string) (*MonitorMuteRule, error) {
+	if len(monitorIds) > 0 {
+		resp, err := createMonitorMuteRuleForMonitors(ctx, client.Gql, *input, monitorIds)
+		return monitorMuteRuleOrError(resp, err)
+	}
+	resp, err := createMonitorMuteRule(ctx, client.Gql, *input)
+	return monitorMuteRuleOrError(resp, err)
+}
+
+func (client *Client) GetMonitorMuteRule(ctx context.Context, id string) (*MonitorMuteRule, error) {
+	resp, err := getMonitorMuteRule(ctx, client.Gql, id)
+	return monitorMuteRuleOrError(resp, err)
+}
+
+// UpdateMonitorMuteRule updates a mute rule. If monitorIds is not nil, the
+// rule's monitor attachments are replaced with the provided set.
+func (client *Client) UpdateMonitorMuteRule(ctx context.Context, id string, input *MonitorMuteRuleInput, monitorIds []string) (*MonitorMuteRule, error) {
+	if monitorIds != nil {
+		resp, err := updateMonitorMuteRuleForMonitors(ctx, client.Gql, id, *input, monitorIds)
+		return monitorMuteRuleOrError(resp, err)
+	}
+	resp, err := updateMonitorMuteRule(ctx, client.Gql, id, *input)
+	return monitorMuteRuleOrError(resp, err)
+}
+
+func (client *Client) DeleteMonitorMuteRule(ctx context.Context, id string) error {
+	resp, err := deleteMonitorMuteRule(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) LookupMonitorMuteRule(ctx context.Context, workspaceId string, name string) (*MonitorMuteRule, error) {
+	resp, err := searchMonitorMuteRule(ctx, client.Gql, &workspaceId, nil, &name, nil)
+	if err != nil {
+		return nil, err
+	}
+	results := resp.MonitorMuteRules.Results
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected exactly one mute rule named %q, found %d", name, len(results))
+	}
+	return &results[0], nil
+}
+
+func (m *MonitorMuteRule) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   m.Id,
+		Type: oid.TypeMonitorMuteRule,
+	}
+}
@@ -3,6 +3,7 @@ package meta
 import (
 	"context"
 
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	oid "github.com/observeinc/terraform-provider-observe/client/oid"
 )
 
@@ -51,6 +52,22 @@ func (client *Client) LookupMonitorV2(ctx context.Context, workspaceId *string,
 	return &resp.MonitorV2s.Results[0], nil
 }
 
+func (client *Client) ListMonitorV2(ctx context.Context, workspaceId *string) ([]MonitorV2, error) {
+	resp, err := lookupMonitorV2(ctx, client.Gql, workspaceId, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.MonitorV2s.Results, nil
+}
+
+func (client *Client) SearchMonitorV2Alarms(ctx context.Context, workspaceId string, monitorIds []string, minTime *types.TimeScalar, maxTime *types.TimeScalar, levels []MonitorV2AlarmLevel, active *bool) ([]MonitorV2Alarm, error) {
+	resp, err := searchMonitorV2Alarms(ctx, client.Gql, workspaceId, monitorIds, minTime, maxTime, levels, active)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Alarms.Results, nil
+}
+
 func (m *MonitorV2) Oid() *oid.OID {
 	return &oid.OID{
 		Id:   m.Id,
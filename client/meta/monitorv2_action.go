@@ -3,6 +3,7 @@ package meta
 import (
 	"context"
 
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	oid "github.com/observeinc/terraform-provider-observe/client/oid"
 )
 
@@ -46,6 +47,30 @@ func (client *Client) SearchMonitorV2Action(ctx context.Context, workspaceId *st
 	return &resp.MonitorV2Actions.Results[0], nil
 }
 
+func (client *Client) ListMonitorV2Actions(ctx context.Context, workspaceId *string) ([]MonitorV2Action, error) {
+	resp, err := searchMonitorV2Action(ctx, client.Gql, workspaceId, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.MonitorV2Actions.Results, nil
+}
+
+func (client *Client) GetMonitorV2TemplateDictionary(ctx context.Context, alertType *MonitorV2AlertType, monitorInput *MonitorV2Input, alarmInput *MonitorV2AlarmInput) (types.JsonObject, error) {
+	resp, err := getMonitorV2TemplateDictionary(ctx, client.Gql, alertType, *monitorInput, *alarmInput)
+	if err != nil {
+		return "", err
+	}
+	return resp.TemplateDictionary.Dictionary, nil
+}
+
+func (client *Client) RenderMonitorV2Template(ctx context.Context, templateDict types.JsonObject, actionInput *MonitorV2ActionInput) (*MonitorV2RenderedTemplate, error) {
+	resp, err := renderMonitorV2Template(ctx, client.Gql, templateDict, *actionInput)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.RenderedTemplate, nil
+}
+
 func (m *MonitorV2Action) Oid() *oid.OID {
 	return &oid.OID{
 		Id:   m.Id,
@@ -0,0 +1,59 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	oid "github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type investigationNotebookResponse interface {
+	GetInvestigationNotebook() InvestigationNotebook
+}
+
+func investigationNotebookOrError(n investigationNotebookResponse, err error) (*InvestigationNotebook, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := n.GetInvestigationNotebook()
+	return &result, nil
+}
+
+func (client *Client) CreateInvestigationNotebook(ctx context.Context, workspaceId string, input *InvestigationNotebookInput) (*InvestigationNotebook, error) {
+	resp, err := createInvestigationNotebook(ctx, client.Gql, workspaceId, *input)
+	return investigationNotebookOrError(resp, err)
+}
+
+func (client *Client) GetInvestigationNotebook(ctx context.Context, id string) (*InvestigationNotebook, error) {
+	resp, err := getInvestigationNotebook(ctx, client.Gql, id)
+	return investigationNotebookOrError(resp, err)
+}
+
+func (client *Client) UpdateInvestigationNotebook(ctx context.Context, id string, input *InvestigationNotebookInput) (*InvestigationNotebook, error) {
+	resp, err := updateInvestigationNotebook(ctx, client.Gql, id, *input)
+	return investigationNotebookOrError(resp, err)
+}
+
+func (client *Client) DeleteInvestigationNotebook(ctx context.Context, id string) error {
+	resp, err := deleteInvestigationNotebook(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) LookupInvestigationNotebook(ctx context.Context, workspaceId string, name string) (*InvestigationNotebook, error) {
+	resp, err := searchInvestigationNotebook(ctx, client.Gql, &workspaceId, nil, &name, nil)
+	if err != nil {
+		return nil, err
+	}
+	results := resp.InvestigationNotebooks.Results
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected exactly one notebook named %q, found %d", name, len(results))
+	}
+	return &results[0], nil
+}
+
+func (n *InvestigationNotebook) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   n.Id,
+		Type: oid.TypeInvestigationNotebook,
+	}
+}
@@ -0,0 +1,116 @@
+package meta
+
+import (
+	"context"
+)
+
+// SearchedObject is an object returned by one of the object search queries
+type SearchedObject struct {
+	Id          string
+	Name        string
+	Description *string
+	WorkspaceId string
+	FolderId    string
+	ManagedById *string
+	// Source is only set for datasets
+	Source *string
+}
+
+// SearchedMetric is a metric returned by SearchMetrics, along with the
+// dataset it belongs to
+type SearchedMetric struct {
+	Name        string
+	Description string
+	DatasetId   string
+	WorkspaceId string
+	FolderId    string
+}
+
+// SearchDatasets returns datasets in a workspace whose name matches any of
+// the provided terms, and which are tagged with all provided correlation tags.
+// If no terms are provided, all datasets are returned.
+func (client *Client) SearchDatasets(ctx context.Context, workspaceId string, labelMatches []string, correlationTags []string) ([]SearchedObject, error) {
+	mode := SearchModeExclusivemode
+	resp, err := searchDatasets(ctx, client.Gql, workspaceId, labelMatches, correlationTags, &mode)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SearchedObject, 0, len(resp.DatasetMatches))
+	for _, m := range resp.DatasetMatches {
+		result = append(result, SearchedObject{
+			Id:          m.Dataset.Id,
+			Name:        m.Dataset.Name,
+			Description: m.Dataset.Description,
+			WorkspaceId: m.Dataset.WorkspaceId,
+			FolderId:    m.Dataset.FolderId,
+			ManagedById: m.Dataset.ManagedById,
+			Source:      m.Dataset.Source,
+		})
+	}
+	return result, nil
+}
+
+// SearchMetrics returns metrics in a workspace whose name, label or
+// description contains match, ignoring case. If correlationTags is set, only
+// metrics tagged with them are returned.
+func (client *Client) SearchMetrics(ctx context.Context, workspaceId string, match string, correlationTags []string) ([]SearchedMetric, error) {
+	resp, err := searchMetrics(ctx, client.Gql, workspaceId, match, correlationTags)
+	if err != nil {
+		return nil, err
+	}
+	datasets := make(map[string]searchMetricsMetricSearchMetricSearchResultDatasetsDataset, len(resp.MetricSearch.Datasets))
+	for _, d := range resp.MetricSearch.Datasets {
+		datasets[d.Id] = d
+	}
+	result := make([]SearchedMetric, 0, len(resp.MetricSearch.Matches))
+	for _, m := range resp.MetricSearch.Matches {
+		if m.DatasetId == nil {
+			continue
+		}
+		d := datasets[*m.DatasetId]
+		result = append(result, SearchedMetric{
+			Name:        m.Metric.Name,
+			Description: m.Metric.Description,
+			DatasetId:   *m.DatasetId,
+			WorkspaceId: d.WorkspaceId,
+			FolderId:    d.FolderId,
+		})
+	}
+	return result, nil
+}
+
+func (client *Client) SearchDashboards(ctx context.Context, terms DWSearchInput) ([]SearchedObject, error) {
+	resp, err := searchDashboards(ctx, client.Gql, terms)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SearchedObject, 0, len(resp.DashboardSearch.Dashboards))
+	for _, m := range resp.DashboardSearch.Dashboards {
+		result = append(result, SearchedObject{
+			Id:          m.Dashboard.Id,
+			Name:        m.Dashboard.Name,
+			Description: m.Dashboard.Description,
+			WorkspaceId: m.Dashboard.WorkspaceId,
+			FolderId:    m.Dashboard.FolderId,
+			ManagedById: m.Dashboard.ManagedById,
+		})
+	}
+	return result, nil
+}
+
+func (client *Client) SearchWorksheets(ctx context.Context, terms DWSearchInput) ([]SearchedObject, error) {
+	resp, err := searchWorksheets(ctx, client.Gql, terms)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SearchedObject, 0, len(resp.WorksheetSearch.Worksheets))
+	for _, m := range resp.WorksheetSearch.Worksheets {
+		result = append(result, SearchedObject{
+			Id:          m.Worksheet.Id,
+			Name:        m.Worksheet.Name,
+			WorkspaceId: m.Worksheet.WorkspaceId,
+			FolderId:    m.Worksheet.FolderId,
+		})
+	}
+	return result, nil
+}
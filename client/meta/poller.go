@@ -38,6 +38,14 @@ func (client *Client) DeletePoller(ctx context.Context, id string) error {
 	return resultStatusError(resp, err)
 }
 
+func (client *Client) ListPollers(ctx context.Context, workspaceId string) ([]Poller, error) {
+	resp, err := listPollers(ctx, client.Gql, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Pollers, nil
+}
+
 func (p *Poller) Oid() *oid.OID {
 	return &oid.OID{
 		Id:   p.Id,
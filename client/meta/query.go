@@ -12,3 +12,17 @@ func (client *Client) DatasetQueryOutput(ctx context.Context, query []*StageInpu
 	}
 	return resp.TaskResult, nil
 }
+
+// CheckQueries compiles a query without running it, returning the parsed
+// pipeline for each stage
+func (client *Client) CheckQueries(ctx context.Context, query *MultiStageQueryInput) ([]ParsedPipeline, error) {
+	resp, err := checkQueries(ctx, client.Gql, *query)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ParsedPipeline, 0, len(resp.CompilationResults))
+	for _, r := range resp.CompilationResults {
+		result = append(result, r.ParsedPipeline)
+	}
+	return result, nil
+}
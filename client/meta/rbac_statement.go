@@ -38,6 +38,14 @@ func (client *Client) DeleteRbacStatement(ctx context.Context, id string) error
 	return resultStatusError(resp, err)
 }
 
+func (client *Client) ListRbacStatements(ctx context.Context) ([]RbacStatement, error) {
+	resp, err := listRbacStatements(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	return resp.RbacStatements, nil
+}
+
 func (r *RbacStatement) Oid() *oid.OID {
 	rbacStatementOid := oid.RbacStatementOid(r.Id)
 	return &rbacStatementOid
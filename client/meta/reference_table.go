@@ -0,0 +1,71 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	oid "github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type referenceTableResponse interface {
+	GetReferenceTable() ReferenceTable
+}
+
+func referenceTableOrError(r referenceTableResponse, err error) (*ReferenceTable, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := r.GetReferenceTable()
+	return &result, nil
+}
+
+// uploads returns the files to attach to a reference table request
+func (input *ReferenceTableInput) uploads() map[string]*types.UploadScalar {
+	return map[string]*types.UploadScalar{
+		"variables.input.upload": input.Upload,
+	}
+}
+
+func (client *Client) CreateReferenceTable(ctx context.Context, workspaceId string, input *ReferenceTableInput) (*ReferenceTable, error) {
+	resp, err := createReferenceTable(ctx, client.withUploads(input.uploads()), workspaceId, *input)
+	return referenceTableOrError(resp, err)
+}
+
+func (client *Client) GetReferenceTable(ctx context.Context, id string) (*ReferenceTable, error) {
+	resp, err := getReferenceTable(ctx, client.Gql, id)
+	return referenceTableOrError(resp, err)
+}
+
+func (client *Client) UpdateReferenceTable(ctx context.Context, id string, input *ReferenceTableInput) (*ReferenceTable, error) {
+	if input.Upload == nil {
+		resp, err := updateReferenceTable(ctx, client.Gql, id, *input)
+		return referenceTableOrError(resp, err)
+	}
+	resp, err := updateReferenceTable(ctx, client.withUploads(input.uploads()), id, *input)
+	return referenceTableOrError(resp, err)
+}
+
+func (client *Client) DeleteReferenceTable(ctx context.Context, id string) error {
+	resp, err := deleteReferenceTable(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) LookupReferenceTable(ctx context.Context, workspaceId string, name string) (*ReferenceTable, error) {
+	resp, err := searchReferenceTable(ctx, client.Gql, &workspaceId, nil, &name, nil)
+	if err != nil {
+		return nil, err
+	}
+	results := resp.ReferenceTables.Results
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected exactly one reference table named %q, found %d", name, len(results))
+	}
+	return &results[0], nil
+}
+
+func (r *ReferenceTable) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   r.Id,
+		Type: oid.TypeReferenceTable,
+	}
+}
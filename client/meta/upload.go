@@ -0,0 +1,108 @@
+package meta
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+// uploadClient sends GraphQL requests as multipart forms, following
+// https://github.com/jaydenseric/graphql-multipart-request-spec.
+// Uploads are keyed by their path within the request, e.g. "variables.input.upload".
+type uploadClient struct {
+	*Client
+	uploads map[string]*types.UploadScalar
+}
+
+var _ graphql.Client = &uploadClient{}
+
+// withUploads returns a GraphQL client which attaches the provided files to the request
+func (client *Client) withUploads(uploads map[string]*types.UploadScalar) graphql.Client {
+	return &uploadClient{Client: client, uploads: uploads}
+}
+
+func (c *uploadClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	operations, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("error encoding operations: %w", err)
+	}
+	if err := w.WriteField("operations", string(operations)); err != nil {
+		return err
+	}
+
+	var (
+		pathMap = make(map[string][]string)
+		parts   = make(map[string]*types.UploadScalar)
+		i       = 0
+	)
+	for path, upload := range c.uploads {
+		if upload == nil {
+			continue
+		}
+		key := strconv.Itoa(i)
+		pathMap[key] = []string{path}
+		parts[key] = upload
+		i++
+	}
+
+	m, err := json.Marshal(pathMap)
+	if err != nil {
+		return fmt.Errorf("error encoding map: %w", err)
+	}
+	if err := w.WriteField("map", string(m)); err != nil {
+		return err
+	}
+
+	for key, upload := range parts {
+		part, err := w.CreateFormFile(key, upload.Filename)
+		if err != nil {
+			return err
+		}
+		if _, err := part.Write(upload.Content); err != nil {
+			return err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, &body)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", w.FormDataContentType())
+
+	httpResp, err := c.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		respBody, err := io.ReadAll(httpResp.Body)
+		if err != nil {
+			respBody = []byte(fmt.Sprintf("<unreadable: %v>", err))
+		}
+		return fmt.Errorf("returned error %v: %s", httpResp.Status, respBody)
+	}
+
+	if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return resp.Errors
+	}
+	return nil
+}
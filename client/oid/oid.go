@@ -19,6 +19,7 @@ var (
 type Type string
 
 const (
+	TypeApiToken                Type = "apitoken"
 	TypeApp                     Type = "app"
 	TypeAppDataSource           Type = "appdatasource"
 	TypeBoard                   Type = "board"
@@ -28,21 +29,28 @@ const (
 	TypeChannelAction           Type = "channelaction"
 	TypeCustomer                Type = "customer"
 	TypeDashboard               Type = "dashboard"
+	TypeDataConnection          Type = "dataconnection"
 	TypeDataset                 Type = "dataset"
+	TypeDatasource              Type = "datasource"
 	TypeDatastream              Type = "datastream"
 	TypeDatastreamToken         Type = "datastreamtoken"
 	TypeFiledrop                Type = "filedrop"
 	TypeFolder                  Type = "folder"
+	TypeIncident                Type = "incident"
+	TypeInvestigationNotebook   Type = "investigationnotebook"
 	TypeLayeredSettingRecord    Type = "layeredsettingrecord"
 	TypeLink                    Type = "link"
 	TypeMonitor                 Type = "monitor"
+	TypeMonitorMuteRule         Type = "monitormuterule"
 	TypeMonitorV2               Type = "monitorv2"
 	TypeMonitorV2Action         Type = "monitorv2action"
 	TypeMonitorV2Destination    Type = "monitorv2destination"
+	TypeMonitorV2MuteRule       Type = "monitorv2muterule"
 	TypeMonitorAction           Type = "monitoraction"
 	TypeMonitorActionAttachment Type = "monitoractionattachment"
 	TypePoller                  Type = "poller"
 	TypePreferredPath           Type = "preferredpath"
+	TypeReferenceTable          Type = "referencetable"
 	TypeUser                    Type = "user"
 	TypeWorksheet               Type = "worksheet"
 	TypeWorkspace               Type = "workspace"
@@ -55,6 +63,7 @@ const (
 
 func (t Type) IsValid() bool {
 	switch t {
+	case TypeApiToken:
 	case TypeApp:
 	case TypeAppDataSource:
 	case TypeBoard:
@@ -64,20 +73,27 @@ func (t Type) IsValid() bool {
 	case TypeChannelAction:
 	case TypeCustomer:
 	case TypeDashboard:
+	case TypeDataConnection:
 	case TypeDataset:
+	case TypeDatasource:
 	case TypeDatastream:
 	case TypeDatastreamToken:
 	case TypeFolder:
+	case TypeIncident:
+	case TypeInvestigationNotebook:
 	case TypeLayeredSettingRecord:
 	case TypeLink:
 	case TypeMonitor:
 	case TypeMonitorAction:
 	case TypeMonitorActionAttachment:
+	case TypeMonitorMuteRule:
 	case TypeMonitorV2:
 	case TypeMonitorV2Action:
 	case TypeMonitorV2Destination:
+	case TypeMonitorV2MuteRule:
 	case TypePoller:
 	case TypePreferredPath:
+	case TypeReferenceTable:
 	case TypeUser:
 	case TypeWorksheet:
 	case TypeWorkspace:
@@ -198,10 +214,18 @@ func DashboardOid(id string) OID {
 	return OID{Id: id, Type: TypeDashboard}
 }
 
+func DataConnectionOid(id string) OID {
+	return OID{Id: id, Type: TypeDataConnection}
+}
+
 func DatasetOid(id string) OID {
 	return OID{Id: id, Type: TypeDataset}
 }
 
+func DatasourceOid(id string) OID {
+	return OID{Id: id, Type: TypeDatasource}
+}
+
 func DatastreamOid(id string) OID {
 	return OID{Id: id, Type: TypeDatastream}
 }
@@ -214,6 +238,14 @@ func FolderOid(id string, wsid string) OID {
 	return OID{Id: wsid, Type: TypeFolder, Version: &id}
 }
 
+func InvestigationNotebookOid(id string) OID {
+	return OID{Id: id, Type: TypeInvestigationNotebook}
+}
+
+func IncidentOid(id string) OID {
+	return OID{Id: id, Type: TypeIncident}
+}
+
 func LayeredSettingRecordOid(id string) OID {
 	return OID{Id: id, Type: TypeLayeredSettingRecord}
 }
@@ -230,6 +262,10 @@ func MonitorActionOid(id string) OID {
 	return OID{Id: id, Type: TypeMonitorAction}
 }
 
+func MonitorMuteRuleOid(id string) OID {
+	return OID{Id: id, Type: TypeMonitorMuteRule}
+}
+
 func MonitorV2Oid(id string) OID {
 	return OID{Id: id, Type: TypeMonitorV2}
 }
@@ -238,6 +274,10 @@ func MonitorV2ActionOid(id string) OID {
 	return OID{Id: id, Type: TypeMonitorV2Action}
 }
 
+func MonitorV2MuteRuleOid(id string) OID {
+	return OID{Id: id, Type: TypeMonitorV2MuteRule}
+}
+
 func PollerOid(id string) OID {
 	return OID{Id: id, Type: TypePoller}
 }
@@ -246,6 +286,10 @@ func PreferredPathOid(id string) OID {
 	return OID{Id: id, Type: TypePreferredPath}
 }
 
+func ReferenceTableOid(id string) OID {
+	return OID{Id: id, Type: TypeReferenceTable}
+}
+
 func UserOid(uid types.UserIdScalar) OID {
 	return OID{Id: uid.String(), Type: TypeUser}
 }
@@ -0,0 +1,5 @@
+data "observe_api_tokens" "all" {}
+
+locals {
+  expired_tokens = [for t in data.observe_api_tokens.all.api_tokens : t.name if t.expired]
+}
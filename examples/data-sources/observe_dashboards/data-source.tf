@@ -0,0 +1,8 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dashboards" "team" {
+  workspace   = data.observe_workspace.default.oid
+  name_prefix = "Team A - "
+}
@@ -0,0 +1,8 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_data_connection" "example" {
+  workspace = data.observe_workspace.default.oid
+  name      = "OpenWeather"
+}
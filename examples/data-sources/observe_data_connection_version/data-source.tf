@@ -0,0 +1,16 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_data_connection_version" "host" {
+  workspace          = data.observe_workspace.default.oid
+  module_id          = "observeinc/host/observe"
+  version_constraint = ">= 1, < 2"
+}
+
+resource "observe_data_connection" "host" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Hosts"
+  module_id = "observeinc/host/observe"
+  version   = data.observe_data_connection_version.host.version
+}
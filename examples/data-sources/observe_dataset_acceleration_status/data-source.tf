@@ -0,0 +1,17 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "requests" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Service Requests"
+}
+
+data "observe_dataset_acceleration_status" "requests" {
+  dataset = data.observe_dataset.requests.oid
+
+  wait_until_fresh {
+    max_staleness = "5m"
+    timeout       = "30m"
+  }
+}
@@ -0,0 +1,20 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "requests" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Service Requests"
+}
+
+data "observe_dataset_health" "requests" {
+  dataset   = data.observe_dataset.requests.oid
+  up_levels = 1
+
+  lifecycle {
+    postcondition {
+      condition     = self.healthy
+      error_message = "Service Requests is unhealthy: ${jsonencode([for f in self.findings : f.message if f.severity == "error"])}"
+    }
+  }
+}
@@ -0,0 +1,22 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "requests" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Service Requests"
+}
+
+data "observe_dataset_lineage" "requests" {
+  dataset = data.observe_dataset.requests.oid
+  depth   = 3
+}
+
+resource "terraform_data" "retire_requests" {
+  lifecycle {
+    precondition {
+      condition     = length(data.observe_dataset_lineage.requests.downstream_datasets) == 0
+      error_message = "Service Requests is still consumed by other datasets."
+    }
+  }
+}
@@ -0,0 +1,12 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_datasets" "managed" {
+  workspace = data.observe_workspace.default.oid
+  source    = "terraform/foo"
+}
+
+output "managed_datasets" {
+  value = [for d in data.observe_datasets.managed.datasets : d.oid]
+}
@@ -0,0 +1,8 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_incident" "game_day" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Game day: region failover"
+}
@@ -0,0 +1,8 @@
+data "observe_incidents" "active" {
+  status      = "active"
+  starting_at = "2024-01-01T00:00:00Z"
+}
+
+output "active_incidents" {
+  value = [for i in data.observe_incidents.active.incidents : i.name]
+}
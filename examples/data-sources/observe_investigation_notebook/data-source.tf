@@ -0,0 +1,9 @@
+data "observe_investigation_notebook" "db_failover" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Database failover"
+}
+
+resource "observe_monitor_v2" "example" {
+  description = "See runbook: ${data.observe_investigation_notebook.db_failover.oid}"
+  # ...
+}
@@ -0,0 +1,22 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_monitor_v2" "errors" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Service Errors"
+}
+
+data "observe_monitor_v2_action_preview" "errors" {
+  monitor_v2 = data.observe_monitor_v2.errors.oid
+  level      = "critical"
+
+  email {
+    subject = "{{monitor.name}} triggered"
+    body    = "{{monitor.name}} is alerting"
+  }
+
+  assert {
+    golden_file = "${path.module}/testdata/errors_email.json"
+  }
+}
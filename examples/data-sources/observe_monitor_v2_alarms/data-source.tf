@@ -0,0 +1,22 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_monitor_v2" "errors" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Service Errors"
+}
+
+data "observe_monitor_v2_alarms" "critical" {
+  workspace   = data.observe_workspace.default.oid
+  monitors    = [data.observe_monitor_v2.errors.oid]
+  levels      = ["critical"]
+  active_only = true
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.alarms) == 0
+      error_message = "Critical alarms are firing: ${jsonencode([for a in self.alarms : a.groupings])}"
+    }
+  }
+}
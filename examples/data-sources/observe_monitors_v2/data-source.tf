@@ -0,0 +1,13 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_folder" "team" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Team A"
+}
+
+data "observe_monitors_v2" "team" {
+  workspace = data.observe_workspace.default.oid
+  folder    = data.observe_folder.team.oid
+}
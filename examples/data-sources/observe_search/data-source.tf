@@ -0,0 +1,13 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_search" "kubernetes" {
+  kind       = "dataset"
+  workspace  = data.observe_workspace.default.oid
+  name_regex = "^Kubernetes Explorer/"
+}
+
+output "kubernetes_datasets" {
+  value = { for r in data.observe_search.kubernetes.results : r.name => r.oid }
+}
@@ -0,0 +1,19 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "requests" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Service Requests"
+}
+
+resource "observe_acceleration_job" "backfill" {
+  datasets = [data.observe_dataset.requests.oid]
+  start    = "2024-01-01T00:00:00Z"
+  end      = "2024-01-08T00:00:00Z"
+  context  = "backfill after pipeline fix"
+
+  timeouts {
+    create = "2h"
+  }
+}
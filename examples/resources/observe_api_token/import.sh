@@ -0,0 +1 @@
+terraform import observe_api_token.example 4b9f3a5c-88c2-4a5b-9a1e-0f1d2c3b4a59
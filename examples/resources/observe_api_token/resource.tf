@@ -0,0 +1,11 @@
+resource "observe_api_token" "ci" {
+  name         = "CI pipeline"
+  description  = "Used by CI to apply terraform changes"
+  lifetime     = "720h"
+  rotate_after = "168h"
+}
+
+output "ci_token" {
+  value     = observe_api_token.ci.secret
+  sensitive = true
+}
@@ -0,0 +1 @@
+terraform import observe_data_connection.example 1414010
@@ -0,0 +1,14 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+resource "observe_data_connection" "example" {
+  workspace = data.observe_workspace.default.oid
+  name      = "OpenWeather"
+  module_id = "observeinc/openweather/observe"
+  version   = "0.2.1"
+
+  variables = {
+    api_key = "..." # https://openweathermap.org/appid
+  }
+}
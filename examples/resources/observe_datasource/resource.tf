@@ -0,0 +1,25 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+resource "observe_datastream" "example" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Hosts"
+}
+
+resource "observe_data_connection" "example" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Hosts"
+  module_id = "observeinc/host/observe"
+}
+
+resource "observe_datasource" "example" {
+  workspace       = data.observe_workspace.default.oid
+  name            = "Production hosts"
+  data_connection = observe_data_connection.example.oid
+  datastream      = observe_datastream.example.oid
+
+  client_stack_attributes = {
+    os = "linux"
+  }
+}
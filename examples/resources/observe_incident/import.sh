@@ -0,0 +1 @@
+terraform import observe_incident.example 1414010
@@ -0,0 +1,22 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dashboard" "overview" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Service Overview"
+}
+
+data "observe_user" "oncall" {
+  email = "oncall@example.com"
+}
+
+resource "observe_incident" "game_day" {
+  workspace   = data.observe_workspace.default.oid
+  name        = "Game day: region failover"
+  description = "Scheduled failover exercise"
+  status      = "inactive"
+
+  users      = [data.observe_user.oncall.oid]
+  dashboards = [data.observe_dashboard.overview.oid]
+}
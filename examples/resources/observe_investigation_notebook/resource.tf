@@ -0,0 +1,34 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+resource "observe_investigation_notebook" "db_failover" {
+  workspace   = data.observe_workspace.default.oid
+  name        = "Database failover"
+  runbook_url = "https://example.com/runbooks/database-failover"
+
+  block {
+    markdown {
+      text = <<-EOF
+        # Database failover
+        Check replication lag before promoting the replica.
+      EOF
+    }
+  }
+
+  block {
+    ping {
+      preview = "Page the database on-call"
+      user    = "dba-oncall"
+    }
+  }
+
+  block {
+    raise_incident {
+      summary       = "Primary database unavailable"
+      severity      = "SEV1"
+      slack_channel = "#incidents"
+      teams         = ["dba", "sre"]
+    }
+  }
+}
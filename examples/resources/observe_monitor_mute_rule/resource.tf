@@ -0,0 +1,16 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+resource "observe_monitor_mute_rule" "example" {
+  workspace   = data.observe_workspace.default.oid
+  name        = "Weekend maintenance"
+  description = "Mute alerts for hosts under maintenance"
+  start_date  = "2030-01-04T00:00:00Z"
+  duration    = "48h"
+
+  filter_per_column {
+    column = "host"
+    values = ["db-1", "db-2"]
+  }
+}
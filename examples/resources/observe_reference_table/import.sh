@@ -0,0 +1,3 @@
+# reference tables can be imported by ID or by OID
+terraform import observe_reference_table.example 1414010
+terraform import observe_reference_table.example o:::referencetable:1414010
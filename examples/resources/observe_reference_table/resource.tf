@@ -0,0 +1,22 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+resource "observe_reference_table" "service_owners" {
+  workspace   = data.observe_workspace.default.oid
+  name        = "Service Owners"
+  description = "Maps services to the team that owns them"
+  source_file = "${path.module}/service_owners.csv"
+  checksum    = filemd5("${path.module}/service_owners.csv")
+  primary_key = ["service"]
+
+  schema {
+    name = "service"
+    type = "string"
+  }
+
+  schema {
+    name = "owner"
+    type = "string"
+  }
+}
@@ -1,6 +1,11 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/observeinc/terraform-provider-observe/observe"
 )
@@ -11,6 +16,38 @@ import (
 // This makes this required field optional, since a default is set.
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: observe.Provider})
 }
+
+// export writes terraform configuration for every object in a workspace.
+// The provider is configured through its usual environment variables.
+func export(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s export [-dir path] [-workspace name]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Export all objects in the workspace as terraform configuration, including")
+		fmt.Fprintln(flags.Output(), "import blocks for each resource. The provider is configured through")
+		fmt.Fprintln(flags.Output(), "environment variables, e.g. OBSERVE_CUSTOMER and OBSERVE_API_TOKEN.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	dir := flags.String("dir", ".", "directory to write configuration files to")
+	workspace := flags.String("workspace", "", "name of the workspace to export, required if there is more than one")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		flags.Usage()
+		return fmt.Errorf("unexpected arguments: %v", flags.Args())
+	}
+	return observe.ExportWorkspace(context.Background(), *dir, *workspace)
+}
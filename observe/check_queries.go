@@ -0,0 +1,257 @@
+package observe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+// checkQueriesEnabled returns whether pipelines should be compiled at plan time
+func checkQueriesEnabled(meta interface{}) (*observe.Client, bool) {
+	client, ok := meta.(*observe.Client)
+	if !ok || client == nil {
+		return nil, false
+	}
+	if v, ok := client.Flags[flagCheckQueries]; ok {
+		return client, v
+	}
+	return client, true
+}
+
+// queryDiffKnown returns true if all attributes needed to build a query from
+// inputs and stages are known at plan time
+func queryDiffKnown(d *schema.ResourceDiff) bool {
+	if !d.NewValueKnown("inputs") || !d.NewValueKnown("stage") {
+		return false
+	}
+	for _, v := range d.Get("inputs").(map[string]interface{}) {
+		if _, err := oid.NewOID(v.(string)); err != nil {
+			return false
+		}
+	}
+	for i := range d.Get("stage").([]interface{}) {
+		for _, key := range []string{"alias", "input", "pipeline"} {
+			if !d.NewValueKnown(fmt.Sprintf("stage.%d.%s", i, key)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// customizeDiffCheckQuery compiles the pipelines declared in the stage blocks
+// of a resource, and fails the plan if any of them contain errors
+func customizeDiffCheckQuery(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := checkQueriesEnabled(meta)
+	if !ok {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChange("inputs") && !d.HasChange("stage") {
+		return nil
+	}
+
+	if !queryDiffKnown(d) {
+		return nil
+	}
+
+	query, diags := newQuery(d)
+	if diags.HasError() {
+		// leave reporting malformed queries to apply
+		return nil
+	}
+
+	result, err := client.CheckQueries(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to check query: %w", err)
+	}
+
+	for i, parsed := range result {
+		if i >= len(query.Stages) {
+			break
+		}
+		path, name := queryStagePath(i)
+		if err := pipelineError(path, name, parsed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryStagePath returns the path of the pipeline in the i-th stage block
+func queryStagePath(i int) (cty.Path, string) {
+	return cty.GetAttrPath("stage").IndexInt(i).GetAttr("pipeline"), fmt.Sprintf("stage.%d.pipeline", i)
+}
+
+// customizeDiffCheckDashboard compiles the stages of a dashboard, and fails
+// the plan if any of them contain errors
+func customizeDiffCheckDashboard(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := checkQueriesEnabled(meta)
+	if !ok {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges("stages", "parameters", "panel", "parameter") {
+		return nil
+	}
+
+	for _, key := range []string{"stages", "parameters", "panel", "parameter"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	query, stagePath := newDashboardCheckQuery(d)
+	if query == nil {
+		// leave reporting malformed stages and parameters to apply
+		return nil
+	}
+
+	result, err := client.CheckQueries(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to check query: %w", err)
+	}
+
+	for i, parsed := range result {
+		if i >= len(query.Stages) {
+			break
+		}
+		path, name := stagePath(i)
+		if err := pipelineError(path, name, parsed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newDashboardCheckQuery builds a query from the stages and parameters of a
+// dashboard, along with a function returning the path of each stage. The
+// query is nil if it cannot be built.
+func newDashboardCheckQuery(d queryData) (*gql.MultiStageQueryInput, func(i int) (cty.Path, string)) {
+	var (
+		query gql.MultiStageQueryInput
+		err   error
+	)
+	if v, ok := d.GetOk("stages"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &query.Stages); err != nil {
+			return nil, nil
+		}
+	} else if query.Stages, err = newDashboardPanels(d); err != nil {
+		return nil, nil
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &query.Parameters); err != nil {
+			return nil, nil
+		}
+	} else if query.Parameters, err = newDashboardParameters(d); err != nil {
+		return nil, nil
+	}
+
+	if len(query.Stages) == 0 {
+		return nil, nil
+	}
+
+	for _, stage := range query.Stages {
+		if stage.Id == nil {
+			return nil, nil
+		}
+	}
+	query.OutputStage = *query.Stages[len(query.Stages)-1].Id
+
+	_, hasPanel := d.GetOk("panel")
+	return &query, func(i int) (cty.Path, string) {
+		if hasPanel {
+			return cty.GetAttrPath("panel").IndexInt(i).GetAttr("pipeline"), fmt.Sprintf("panel.%d.pipeline", i)
+		}
+		return cty.GetAttrPath("stages"), fmt.Sprintf("stages[%d]", i)
+	}
+}
+
+// checkQueryWarnings compiles the pipelines declared in the stage blocks of a
+// resource being applied, and returns any compiler warnings. CustomizeDiff can
+// only fail a plan, so warnings are surfaced on apply instead.
+func checkQueryWarnings(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, ok := checkQueriesEnabled(meta)
+	if !ok {
+		return nil
+	}
+	query, diags := newQuery(data)
+	if diags.HasError() {
+		return nil
+	}
+	return compileQueryWarnings(ctx, client, query, queryStagePath)
+}
+
+// checkDashboardWarnings compiles the stages of a dashboard being applied, and
+// returns any compiler warnings
+func checkDashboardWarnings(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, ok := checkQueriesEnabled(meta)
+	if !ok {
+		return nil
+	}
+	query, stagePath := newDashboardCheckQuery(data)
+	if query == nil {
+		return nil
+	}
+	return compileQueryWarnings(ctx, client, query, stagePath)
+}
+
+func compileQueryWarnings(ctx context.Context, client *observe.Client, query *gql.MultiStageQueryInput, stagePath func(i int) (cty.Path, string)) (diags diag.Diagnostics) {
+	result, err := client.CheckQueries(ctx, query)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "failed to check query",
+			Detail:   err.Error(),
+		}}
+	}
+
+	for i, parsed := range result {
+		if i >= len(query.Stages) {
+			break
+		}
+		path, name := stagePath(i)
+		diags = append(diags, pipelineWarnings(path, name, parsed)...)
+	}
+	return diags
+}
+
+// pipelineWarnings returns a warning pinned to path for each compiler warning
+// in a compiled pipeline
+func pipelineWarnings(path cty.Path, name string, parsed gql.ParsedPipeline) (diags diag.Diagnostics) {
+	for _, w := range parsed.Warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("%s compiled with warnings", name),
+			Detail:        formatPipelineSymbol(w.Symbol),
+			AttributePath: path,
+		})
+	}
+	return diags
+}
+
+// pipelineError returns an error pinned to path if compilation failed
+func pipelineError(path cty.Path, name string, parsed gql.ParsedPipeline) error {
+	if len(parsed.Errors) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, 0, len(parsed.Errors))
+	for _, e := range parsed.Errors {
+		msgs = append(msgs, formatPipelineSymbol(e))
+	}
+	return path.NewErrorf("%s failed to compile:\n%s", name, strings.Join(msgs, "\n"))
+}
+
+func formatPipelineSymbol(s gql.PipelineSymbol) string {
+	return fmt.Sprintf("%d:%d: %s", s.Span.Start.Row, s.Span.Start.Col, s.Comment)
+}
@@ -0,0 +1,38 @@
+package observe
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+func TestPipelineDiagnostics(t *testing.T) {
+	path, name := queryStagePath(1)
+	parsed := gql.ParsedPipeline{
+		Warnings: []gql.ParsedPipelineWarningsPipelineWarning{
+			{Symbol: gql.PipelineSymbol{Comment: "deprecated verb"}},
+		},
+	}
+
+	diags := pipelineWarnings(path, name, parsed)
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %v", diags)
+	}
+	if diags[0].Severity != diag.Warning {
+		t.Errorf("expected warning, got %v", diags[0].Severity)
+	}
+	if expected := cty.GetAttrPath("stage").IndexInt(1).GetAttr("pipeline"); !diags[0].AttributePath.Equals(expected) {
+		t.Errorf("expected path %v, got %v", expected, diags[0].AttributePath)
+	}
+	if err := pipelineError(path, name, parsed); err != nil {
+		t.Errorf("expected no error for warnings, got %s", err)
+	}
+
+	parsed.Errors = []gql.PipelineSymbol{{Comment: "unknown verb"}}
+	if err := pipelineError(path, name, parsed); err == nil {
+		t.Error("expected error")
+	}
+}
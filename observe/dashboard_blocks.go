@@ -0,0 +1,687 @@
+package observe
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+const (
+	schemaDashboardPanelDescription             = "Dashboard stage, as an alternative to `stages`. On import, stages are read back as panels if they can be expressed as such."
+	schemaDashboardPanelIdDescription           = "Stage ID, referenced by cards and by inputs of other panels."
+	schemaDashboardPanelLabelDescription        = "Label shown for the stage."
+	schemaDashboardPanelPipelineDescription     = "OPAL pipeline for the stage."
+	schemaDashboardPanelInputDescription        = "Inputs for the stage. Exactly one of `dataset` or `stage` must be set."
+	schemaDashboardPanelInputNameDescription    = "Input name, which can be referenced in the pipeline as `@name`."
+	schemaDashboardPanelInputDatasetDescription = "OID of the input dataset."
+	schemaDashboardPanelInputStageDescription   = "ID of the panel used as input."
+	schemaDashboardSectionDescription           = "Dashboard section, as an alternative to `layout`."
+	schemaDashboardSectionTitleDescription      = "Section title."
+	schemaDashboardSectionCollapsedDescription  = "Whether the section is collapsed by default."
+	schemaDashboardCardDescription              = "Card displaying the output of a panel in the section grid."
+	schemaDashboardCardPanelDescription         = "ID of the panel displayed by the card."
+	schemaDashboardCardXDescription             = "Horizontal position of the card in the grid."
+	schemaDashboardCardYDescription             = "Vertical position of the card in the grid."
+	schemaDashboardCardWidthDescription         = "Width of the card in grid columns."
+	schemaDashboardCardHeightDescription        = "Height of the card in grid rows."
+	schemaDashboardParameterDescription         = "Dashboard parameter, as an alternative to `parameters`."
+	schemaDashboardParameterIdDescription       = "Parameter ID, which can be referenced in pipelines as `$id`."
+	schemaDashboardParameterNameDescription     = "Parameter name. Defaults to `id`."
+	schemaDashboardParameterTypeDescription     = "Parameter value type. One of `BOOL`, `FLOAT64`, `INT64` or `STRING`."
+	schemaDashboardParameterDefaultDescription  = "Default value of the parameter, encoded as a string."
+
+	dashboardCardDefaultWidth  = 6
+	dashboardCardDefaultHeight = 6
+)
+
+var dashboardParameterTypes = []string{
+	string(gql.ValueTypeBool),
+	string(gql.ValueTypeFloat64),
+	string(gql.ValueTypeInt64),
+	string(gql.ValueTypeString),
+}
+
+func dashboardPanelSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"stages"},
+		Description:   schemaDashboardPanelDescription,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: schemaDashboardPanelIdDescription,
+				},
+				"label": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: schemaDashboardPanelLabelDescription,
+				},
+				"pipeline": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: diffSuppressPipeline,
+					Description:      schemaDashboardPanelPipelineDescription,
+				},
+				"input": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Description: schemaDashboardPanelInputDescription,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: schemaDashboardPanelInputNameDescription,
+							},
+							"dataset": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: validateOID(oid.TypeDataset),
+								DiffSuppressFunc: diffSuppressOIDVersion,
+								Description:      schemaDashboardPanelInputDatasetDescription,
+							},
+							"stage": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: schemaDashboardPanelInputStageDescription,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dashboardSectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"layout"},
+		Description:   schemaDashboardSectionDescription,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"title": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: schemaDashboardSectionTitleDescription,
+				},
+				"collapsed": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: schemaDashboardSectionCollapsedDescription,
+				},
+				"card": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: schemaDashboardCardDescription,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"panel": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: schemaDashboardCardPanelDescription,
+							},
+							"x": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Default:     0,
+								Description: schemaDashboardCardXDescription,
+							},
+							"y": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Default:     0,
+								Description: schemaDashboardCardYDescription,
+							},
+							"width": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Default:     dashboardCardDefaultWidth,
+								Description: schemaDashboardCardWidthDescription,
+							},
+							"height": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Default:     dashboardCardDefaultHeight,
+								Description: schemaDashboardCardHeightDescription,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dashboardParameterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"parameters"},
+		Description:   schemaDashboardParameterDescription,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: schemaDashboardParameterIdDescription,
+				},
+				"name": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: diffSuppressDashboardParameterName,
+					Description:      schemaDashboardParameterNameDescription,
+				},
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validateStringInSlice(dashboardParameterTypes, false),
+					Description:      schemaDashboardParameterTypeDescription,
+				},
+				"default": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: diffSuppressDashboardParameterDefault,
+					Description:      schemaDashboardParameterDefaultDescription,
+				},
+			},
+		},
+	}
+}
+
+// newDashboardPanels converts panel blocks into dashboard stages
+func newDashboardPanels(data queryData) (stages []gql.StageQueryInput, err error) {
+	for i := range data.Get("panel").([]interface{}) {
+		prefix := fmt.Sprintf("panel.%d.", i)
+
+		id := data.Get(prefix + "id").(string)
+		stage := gql.StageQueryInput{
+			Id:       &id,
+			Pipeline: data.Get(prefix + "pipeline").(string),
+		}
+
+		if v, ok := data.GetOk(prefix + "label"); ok {
+			stage.Layout = dashboardPanelLayout(v.(string))
+		}
+
+		for j := range data.Get(prefix + "input").([]interface{}) {
+			inputPrefix := fmt.Sprintf("%sinput.%d.", prefix, j)
+			input := gql.InputDefinitionInput{
+				InputName: data.Get(inputPrefix + "name").(string),
+				InputRole: inputRolePtr(gql.InputRoleData),
+			}
+
+			dataset, hasDataset := data.GetOk(inputPrefix + "dataset")
+			stageId, hasStage := data.GetOk(inputPrefix + "stage")
+			switch {
+			case hasDataset == hasStage:
+				return nil, fmt.Errorf("%sinput.%d: exactly one of dataset or stage must be set", prefix, j)
+			case hasDataset:
+				datasetId, err := oid.NewOID(dataset.(string))
+				if err != nil {
+					return nil, fmt.Errorf("%sinput.%d: %w", prefix, j, err)
+				}
+				input.DatasetId = &datasetId.Id
+			default:
+				s := stageId.(string)
+				input.StageId = &s
+			}
+			stage.Input = append(stage.Input, input)
+		}
+		stages = append(stages, stage)
+	}
+	return stages, nil
+}
+
+func dashboardPanelLayout(label string) *types.JsonObject {
+	layout, _ := json.Marshal(map[string]interface{}{
+		"type":  "table",
+		"label": label,
+	})
+	return types.JsonObject(layout).Ptr()
+}
+
+func inputRolePtr(r gql.InputRole) *gql.InputRole {
+	return &r
+}
+
+// dashboardPanelsFromStages converts dashboard stages back into panel blocks.
+// representable is false if any stage uses features which panels cannot
+// express, in which case the panels are a best effort conversion.
+func dashboardPanelsFromStages(stages []gql.DashboardStagesStageQuery) (panels []interface{}, representable bool) {
+	representable = len(stages) > 0
+	panels = make([]interface{}, 0, len(stages))
+	for _, stage := range stages {
+		panel := map[string]interface{}{
+			"pipeline": stage.Pipeline,
+		}
+		if stage.Id != nil {
+			panel["id"] = *stage.Id
+		} else {
+			representable = false
+		}
+
+		if stage.Params != nil && *stage.Params != "" && *stage.Params != types.JsonObject("null") {
+			representable = false
+		}
+		if stage.Layout != nil {
+			label, ok := dashboardPanelLabel(*stage.Layout)
+			panel["label"] = label
+			representable = representable && ok
+		}
+
+		inputs := make([]interface{}, 0, len(stage.Input))
+		for _, in := range stage.Input {
+			input := map[string]interface{}{
+				"name": in.InputName,
+			}
+			switch {
+			case in.DatasetId != nil && in.StageId == nil:
+				input["dataset"] = oid.DatasetOid(*in.DatasetId).String()
+			case in.StageId != nil && in.DatasetId == nil:
+				input["stage"] = *in.StageId
+			default:
+				representable = false
+			}
+			if in.DatasetPath != nil || (in.InputRole != "" && in.InputRole != gql.InputRoleData) {
+				representable = false
+			}
+			inputs = append(inputs, input)
+		}
+		if len(inputs) == 0 {
+			representable = false
+		}
+		panel["input"] = inputs
+		panels = append(panels, panel)
+	}
+	return panels, representable
+}
+
+// dashboardPanelLabel extracts the label from a stage layout. ok is false if
+// the layout is not one generated by dashboardPanelLayout.
+func dashboardPanelLabel(layout types.JsonObject) (label string, ok bool) {
+	var v map[string]interface{}
+	if err := json.Unmarshal([]byte(layout), &v); err != nil || v == nil {
+		return "", layout == "" || layout == "null"
+	}
+	label, _ = v["label"].(string)
+	for key, value := range v {
+		switch {
+		case key == "label":
+		case key == "type" && value == "table":
+		default:
+			return label, false
+		}
+	}
+	return label, true
+}
+
+// newDashboardSections converts section blocks into a dashboard grid layout
+func newDashboardSections(data queryData) *types.JsonObject {
+	sections := make([]interface{}, 0)
+	for i := range data.Get("section").([]interface{}) {
+		prefix := fmt.Sprintf("section.%d.", i)
+
+		items := make([]interface{}, 0)
+		for j := range data.Get(prefix + "card").([]interface{}) {
+			cardPrefix := fmt.Sprintf("%scard.%d.", prefix, j)
+			cardId := fmt.Sprintf("card-%d-%d", i, j)
+			items = append(items, map[string]interface{}{
+				"card": map[string]interface{}{
+					"cardType": "stage",
+					"id":       cardId,
+					"stageId":  data.Get(cardPrefix + "panel").(string),
+				},
+				"layout": map[string]interface{}{
+					"i":      cardId,
+					"x":      data.Get(cardPrefix + "x").(int),
+					"y":      data.Get(cardPrefix + "y").(int),
+					"w":      data.Get(cardPrefix + "width").(int),
+					"h":      data.Get(cardPrefix + "height").(int),
+					"moved":  false,
+					"static": false,
+				},
+			})
+		}
+
+		sections = append(sections, map[string]interface{}{
+			"card": map[string]interface{}{
+				"cardType": "section",
+				"id":       fmt.Sprintf("section-%d", i),
+				"title":    data.Get(prefix + "title").(string),
+				"closed":   data.Get(prefix + "collapsed").(bool),
+			},
+			"items": items,
+		})
+	}
+
+	layout, _ := json.Marshal(map[string]interface{}{
+		"gridLayout": map[string]interface{}{
+			"sections": sections,
+		},
+	})
+	return types.JsonObject(layout).Ptr()
+}
+
+// dashboardGridLayout is the subset of a dashboard layout described by
+// section blocks
+type dashboardGridLayout struct {
+	GridLayout *struct {
+		Sections []struct {
+			Card struct {
+				CardType string `json:"cardType"`
+				Title    string `json:"title"`
+				Closed   bool   `json:"closed"`
+			} `json:"card"`
+			Items []struct {
+				Card struct {
+					CardType string `json:"cardType"`
+					StageId  string `json:"stageId"`
+				} `json:"card"`
+				Layout struct {
+					X int `json:"x"`
+					Y int `json:"y"`
+					W int `json:"w"`
+					H int `json:"h"`
+				} `json:"layout"`
+			} `json:"items"`
+		} `json:"sections"`
+	} `json:"gridLayout"`
+}
+
+// dashboardSectionsFromLayout converts a dashboard grid layout back into
+// section blocks. representable is false if the layout contains anything
+// besides sections of stage cards, in which case those are omitted.
+func dashboardSectionsFromLayout(layout *types.JsonObject) (sections []interface{}, representable bool) {
+	sections = make([]interface{}, 0)
+	if layout == nil {
+		return sections, false
+	}
+
+	var keys map[string]json.RawMessage
+	var v dashboardGridLayout
+	if err := json.Unmarshal([]byte(*layout), &keys); err != nil {
+		return sections, false
+	}
+	if err := json.Unmarshal([]byte(*layout), &v); err != nil || v.GridLayout == nil {
+		return sections, false
+	}
+
+	representable = len(v.GridLayout.Sections) > 0
+	for key := range keys {
+		// autoPack only affects how the UI places new cards
+		if key != "gridLayout" && key != "autoPack" {
+			representable = false
+		}
+	}
+
+	for _, section := range v.GridLayout.Sections {
+		if section.Card.CardType != "section" {
+			representable = false
+		}
+		cards := make([]interface{}, 0, len(section.Items))
+		for _, item := range section.Items {
+			if item.Card.CardType != "stage" || item.Card.StageId == "" {
+				representable = false
+				continue
+			}
+			cards = append(cards, map[string]interface{}{
+				"panel":  item.Card.StageId,
+				"x":      item.Layout.X,
+				"y":      item.Layout.Y,
+				"width":  item.Layout.W,
+				"height": item.Layout.H,
+			})
+		}
+		sections = append(sections, map[string]interface{}{
+			"title":     section.Card.Title,
+			"collapsed": section.Card.Closed,
+			"card":      cards,
+		})
+	}
+	return sections, representable
+}
+
+// newDashboardParameters converts parameter blocks into dashboard parameters
+func newDashboardParameters(data queryData) (params []gql.ParameterSpecInput, err error) {
+	for i := range data.Get("parameter").([]interface{}) {
+		prefix := fmt.Sprintf("parameter.%d.", i)
+
+		param := gql.ParameterSpecInput{
+			Id:   data.Get(prefix + "id").(string),
+			Name: data.Get(prefix + "name").(string),
+			ValueKind: gql.ValueTypeSpecInput{
+				Type: gql.ValueType(data.Get(prefix + "type").(string)),
+			},
+		}
+		if param.Name == "" {
+			param.Name = param.Id
+		}
+
+		if v, ok := data.GetOk(prefix + "default"); ok {
+			param.DefaultValue, err = newParameterValue(param.ValueKind.Type, v.(string))
+			if err != nil {
+				return nil, fmt.Errorf("%sdefault: %w", prefix, err)
+			}
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+func newParameterValue(t gql.ValueType, s string) (*types.Value, error) {
+	switch t {
+	case gql.ValueTypeBool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, err
+		}
+		return types.MustNewValue(v), nil
+	case gql.ValueTypeFloat64:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, err
+		}
+		return types.MustNewValue(v), nil
+	case gql.ValueTypeInt64:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return types.MustNewValue(v), nil
+	case gql.ValueTypeString:
+		return types.MustNewValue(s), nil
+	}
+	return nil, fmt.Errorf("unsupported parameter type %q", t)
+}
+
+// dashboardParametersFromSpecs converts dashboard parameters back into
+// parameter blocks. representable is false if any parameter has a type which
+// parameter blocks cannot express, in which case it is omitted.
+func dashboardParametersFromSpecs(specs []gql.DashboardParametersParameterSpec) (params []interface{}, representable bool) {
+	representable = len(specs) > 0
+	params = make([]interface{}, 0, len(specs))
+	for _, spec := range specs {
+		kind := spec.ValueKind
+		if kind.ArrayItemType != nil || kind.KeyForDatasetId != nil {
+			representable = false
+			continue
+		}
+		param := map[string]interface{}{
+			"id":   spec.Id,
+			"name": spec.Name,
+			"type": string(kind.Type),
+		}
+		if spec.DefaultValue != nil {
+			s, ok := parameterValueString(kind.Type, spec.DefaultValue)
+			if !ok {
+				representable = false
+				continue
+			}
+			param["default"] = s
+		}
+		params = append(params, param)
+	}
+	return params, representable
+}
+
+// parameterValueString is the inverse of newParameterValue
+func parameterValueString(t gql.ValueType, v *types.Value) (string, bool) {
+	switch {
+	case t == gql.ValueTypeBool && v.Bool != nil:
+		return strconv.FormatBool(*v.Bool), true
+	case t == gql.ValueTypeFloat64 && v.Float64 != nil:
+		return strconv.FormatFloat(float64(*v.Float64), 'f', -1, 64), true
+	case t == gql.ValueTypeInt64 && v.Int64 != nil:
+		return strconv.FormatInt(int64(*v.Int64), 10), true
+	case t == gql.ValueTypeString && v.String != nil:
+		return *v.String, true
+	}
+	return "", false
+}
+
+// diffSuppressDashboardParameterName suppresses diffs between an unset
+// parameter name and the parameter ID it defaults to
+func diffSuppressDashboardParameterName(k, prv, nxt string, d *schema.ResourceData) bool {
+	id := d.Get(strings.TrimSuffix(k, "name") + "id").(string)
+	if prv == "" {
+		prv = id
+	}
+	if nxt == "" {
+		nxt = id
+	}
+	return prv == nxt
+}
+
+// diffSuppressDashboardParameterDefault suppresses diffs between equivalent
+// encodings of a parameter default, e.g. "1.0" and "1"
+func diffSuppressDashboardParameterDefault(k, prv, nxt string, d *schema.ResourceData) bool {
+	t := gql.ValueType(d.Get(strings.TrimSuffix(k, "default") + "type").(string))
+	o, err := newParameterValue(t, prv)
+	if err != nil {
+		return false
+	}
+	n, err := newParameterValue(t, nxt)
+	if err != nil {
+		return false
+	}
+	prvValue, _ := parameterValueString(t, o)
+	nxtValue, _ := parameterValueString(t, n)
+	return prvValue == nxtValue
+}
+
+// dashboardLayoutStageRefs returns the stage IDs referenced by cards in a
+// dashboard grid layout
+func dashboardLayoutStageRefs(layout string) ([]string, error) {
+	var v struct {
+		GridLayout *struct {
+			Sections []struct {
+				Items []struct {
+					Card struct {
+						CardType string `json:"cardType"`
+						StageId  string `json:"stageId"`
+					} `json:"card"`
+				} `json:"items"`
+			} `json:"sections"`
+		} `json:"gridLayout"`
+	}
+	if err := json.Unmarshal([]byte(layout), &v); err != nil {
+		return nil, err
+	}
+	if v.GridLayout == nil {
+		return nil, nil
+	}
+
+	var refs []string
+	for _, section := range v.GridLayout.Sections {
+		for _, item := range section.Items {
+			if item.Card.CardType == "stage" && item.Card.StageId != "" {
+				refs = append(refs, item.Card.StageId)
+			}
+		}
+	}
+	return refs, nil
+}
+
+// customizeDiffValidateDashboardLayout verifies that every card in the
+// dashboard layout references an existing stage
+func customizeDiffValidateDashboardLayout(d *schema.ResourceDiff) error {
+	stageIds := make(map[string]bool)
+	if _, ok := d.GetOk("panel"); ok {
+		for i := range d.Get("panel").([]interface{}) {
+			key := fmt.Sprintf("panel.%d.id", i)
+			if !d.NewValueKnown(key) {
+				return nil
+			}
+			stageIds[d.Get(key).(string)] = true
+		}
+		for i := range d.Get("panel").([]interface{}) {
+			for j := range d.Get(fmt.Sprintf("panel.%d.input", i)).([]interface{}) {
+				key := fmt.Sprintf("panel.%d.input.%d.stage", i, j)
+				if !d.NewValueKnown(key) {
+					continue
+				}
+				if ref := d.Get(key).(string); ref != "" && !stageIds[ref] {
+					path := cty.GetAttrPath("panel").IndexInt(i).GetAttr("input").IndexInt(j).GetAttr("stage")
+					return path.NewErrorf("input references unknown panel %q", ref)
+				}
+			}
+		}
+	} else {
+		if !d.NewValueKnown("stages") {
+			return nil
+		}
+		var stages []gql.StageQueryInput
+		if err := json.Unmarshal([]byte(d.Get("stages").(string)), &stages); err != nil {
+			return nil
+		}
+		for _, stage := range stages {
+			if stage.Id != nil {
+				stageIds[*stage.Id] = true
+			}
+		}
+	}
+
+	if _, ok := d.GetOk("section"); ok {
+		for i := range d.Get("section").([]interface{}) {
+			for j := range d.Get(fmt.Sprintf("section.%d.card", i)).([]interface{}) {
+				key := fmt.Sprintf("section.%d.card.%d.panel", i, j)
+				if !d.NewValueKnown(key) {
+					continue
+				}
+				if ref := d.Get(key).(string); !stageIds[ref] {
+					path := cty.GetAttrPath("section").IndexInt(i).GetAttr("card").IndexInt(j).GetAttr("panel")
+					return path.NewErrorf("card references unknown panel %q", ref)
+				}
+			}
+		}
+		return nil
+	}
+
+	if v, ok := d.GetOk("layout"); ok && d.NewValueKnown("layout") {
+		refs, err := dashboardLayoutStageRefs(v.(string))
+		if err != nil {
+			return nil
+		}
+		for _, ref := range refs {
+			if !stageIds[ref] {
+				return cty.GetAttrPath("layout").NewErrorf("card references unknown stage %q", ref)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package observe
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+func TestDashboardBlocks(t *testing.T) {
+	data := schema.TestResourceDataRaw(t, resourceDashboard().Schema, map[string]interface{}{
+		"workspace": "o:::workspace:1",
+		"name":      "test",
+		"panel": []interface{}{
+			map[string]interface{}{
+				"id":       "stage-a",
+				"label":    "Logs",
+				"pipeline": "filter true",
+				"input": []interface{}{
+					map[string]interface{}{"name": "logs", "dataset": "o:::dataset:42"},
+				},
+			},
+			map[string]interface{}{
+				"id": "stage-b",
+				"input": []interface{}{
+					map[string]interface{}{"name": "a", "stage": "stage-a"},
+				},
+			},
+		},
+		"section": []interface{}{
+			map[string]interface{}{
+				"title": "Overview",
+				"card": []interface{}{
+					map[string]interface{}{"panel": "stage-b", "x": 6},
+				},
+			},
+		},
+		"parameter": []interface{}{
+			map[string]interface{}{"id": "limit", "type": "INT64", "default": "10"},
+		},
+	})
+
+	input, diags := newDashboardConfig(data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	stages, err := json.Marshal(input.Stages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedStages := `[{"id":"stage-a","input":[{"inputName":"logs","inputRole":"Data","datasetId":"42","datasetPath":null,"parameterId":null}],"pipeline":"filter true","layout":{"label":"Logs","type":"table"},"parameters":null,"parameterValues":null},` +
+		`{"id":"stage-b","input":[{"inputName":"a","inputRole":"Data","datasetId":null,"datasetPath":null,"stageId":"stage-a","parameterId":null}],"pipeline":"","layout":null,"parameters":null,"parameterValues":null}]`
+	if s := string(stages); s != expectedStages {
+		t.Errorf("unexpected stages: %s", cmp.Diff(expectedStages, s))
+	}
+
+	refs, err := dashboardLayoutStageRefs(input.Layout.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"stage-b"}, refs); diff != "" {
+		t.Errorf("unexpected layout references: %s", diff)
+	}
+
+	parameters, err := json.Marshal(input.Parameters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedParameters := `[{"id":"limit","name":"limit","defaultValue":{"int64":"10"},"valueKind":{"type":"INT64","arrayItemType":null,"keyForDatasetId":null}}]`
+	if s := string(parameters); s != expectedParameters {
+		t.Errorf("unexpected parameters: %s", cmp.Diff(expectedParameters, s))
+	}
+}
+
+func TestDashboardBlocksReadBack(t *testing.T) {
+	s := resourceDashboard().Schema
+	data := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"workspace": "o:::workspace:1",
+		"name":      "test",
+		"panel": []interface{}{
+			map[string]interface{}{
+				"id":       "stage-a",
+				"label":    "Logs",
+				"pipeline": "filter true",
+				"input": []interface{}{
+					map[string]interface{}{"name": "logs", "dataset": "o:::dataset:42"},
+				},
+			},
+			map[string]interface{}{
+				"id": "stage-b",
+				"input": []interface{}{
+					map[string]interface{}{"name": "a", "stage": "stage-a"},
+				},
+			},
+		},
+		"section": []interface{}{
+			map[string]interface{}{
+				"title":     "Overview",
+				"collapsed": true,
+				"card": []interface{}{
+					map[string]interface{}{"panel": "stage-a", "width": 12},
+					map[string]interface{}{"panel": "stage-b", "y": 6, "height": 3},
+				},
+			},
+		},
+		"parameter": []interface{}{
+			map[string]interface{}{"id": "limit", "type": "INT64", "default": "10"},
+			map[string]interface{}{"id": "ratio", "name": "Ratio", "type": "FLOAT64", "default": "0.5"},
+		},
+	})
+
+	input, diags := newDashboardConfig(data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// round trip through the types returned by the API
+	var stages []gql.DashboardStagesStageQuery
+	var parameters []gql.DashboardParametersParameterSpec
+	if b, err := json.Marshal(input.Stages); err != nil {
+		t.Fatal(err)
+	} else if err := json.Unmarshal(b, &stages); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := json.Marshal(input.Parameters); err != nil {
+		t.Fatal(err)
+	} else if err := json.Unmarshal(b, &parameters); err != nil {
+		t.Fatal(err)
+	}
+
+	got := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+
+	panels, ok := dashboardPanelsFromStages(stages)
+	if !ok {
+		t.Error("expected stages to be representable as panels")
+	}
+	if err := got.Set("panel", panels); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(data.Get("panel"), got.Get("panel")); diff != "" {
+		t.Errorf("unexpected panels: %s", diff)
+	}
+
+	sections, ok := dashboardSectionsFromLayout(input.Layout)
+	if !ok {
+		t.Error("expected layout to be representable as sections")
+	}
+	if err := got.Set("section", sections); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(data.Get("section"), got.Get("section")); diff != "" {
+		t.Errorf("unexpected sections: %s", diff)
+	}
+
+	params, ok := dashboardParametersFromSpecs(parameters)
+	if !ok {
+		t.Error("expected parameters to be representable as parameter blocks")
+	}
+	expectedParams := []interface{}{
+		map[string]interface{}{"id": "limit", "name": "limit", "type": "INT64", "default": "10"},
+		map[string]interface{}{"id": "ratio", "name": "Ratio", "type": "FLOAT64", "default": "0.5"},
+	}
+	if diff := cmp.Diff(expectedParams, params); diff != "" {
+		t.Errorf("unexpected parameters: %s", diff)
+	}
+}
+
+func TestDashboardBlocksUnrepresentable(t *testing.T) {
+	stageId := "stage-a"
+	layout := types.JsonObject(`{"type": "table", "label": "Logs", "steps": []}`)
+	_, ok := dashboardPanelsFromStages([]gql.DashboardStagesStageQuery{{
+		Id:     &stageId,
+		Layout: &layout,
+		Input:  []gql.DashboardStagesStageQueryInputInputDefinition{{InputName: "a", StageId: &stageId}},
+	}})
+	if ok {
+		t.Error("expected stage with steps to not be representable as a panel")
+	}
+
+	for _, layout := range []string{
+		`{}`,
+		`{"gridLayout": {"sections": [{"card": {"cardType": "section"}, "items": [{"card": {"cardType": "text"}}]}]}}`,
+		`{"stageListLayout": {}, "gridLayout": {"sections": [{"card": {"cardType": "section"}, "items": []}]}}`,
+	} {
+		if _, ok := dashboardSectionsFromLayout(types.JsonObject(layout).Ptr()); ok {
+			t.Errorf("expected layout %s to not be representable as sections", layout)
+		}
+	}
+}
+
+// stripDashboardLayoutIds removes generated card and section IDs from a
+// decoded dashboard layout
+func stripDashboardLayoutIds(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		delete(v, "id")
+		delete(v, "i")
+		for _, elem := range v {
+			stripDashboardLayoutIds(elem)
+		}
+	case []interface{}:
+		for _, elem := range v {
+			stripDashboardLayoutIds(elem)
+		}
+	}
+}
+
+func TestDashboardSectionsLayoutFixture(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/dashboard_layout.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sections, ok := dashboardSectionsFromLayout(types.JsonObject(fixture).Ptr())
+	if !ok {
+		t.Fatal("expected layout to be representable as sections")
+	}
+
+	data := schema.TestResourceDataRaw(t, resourceDashboard().Schema, map[string]interface{}{
+		"workspace": "o:::workspace:1",
+		"name":      "test",
+		"section":   sections,
+	})
+
+	var expected, got struct {
+		GridLayout interface{} `json:"gridLayout"`
+	}
+	if err := json.Unmarshal(fixture, &expected); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(*newDashboardSections(data)), &got); err != nil {
+		t.Fatal(err)
+	}
+	stripDashboardLayoutIds(expected.GridLayout)
+	stripDashboardLayoutIds(got.GridLayout)
+
+	if diff := cmp.Diff(expected.GridLayout, got.GridLayout); diff != "" {
+		t.Errorf("generated layout does not match fixture: %s", diff)
+	}
+}
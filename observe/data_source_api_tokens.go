@@ -0,0 +1,139 @@
+package observe
+
+import (
+	"context"
+	"hash/crc32"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceApiTokens() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("api_tokens", "description"),
+
+		ReadContext: dataSourceApiTokensRead,
+
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeUser),
+				Description:      descriptions.Get("api_tokens", "schema", "user"),
+			},
+			// computed values
+			"api_tokens": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("api_tokens", "schema", "api_tokens"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "id"),
+						},
+						"oid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "oid"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("api_token", "schema", "name"),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("api_token", "schema", "description"),
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("api_token", "schema", "disabled"),
+						},
+						"user": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("api_token", "schema", "user"),
+						},
+						"expiration": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("api_token", "schema", "expiration"),
+						},
+						"expired": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("api_tokens", "schema", "expired"),
+						},
+						"created_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("api_token", "schema", "created_date"),
+						},
+						"updated_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("api_tokens", "schema", "updated_date"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApiTokensRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client = meta.(*observe.Client)
+		user   = data.Get("user").(string)
+	)
+
+	var owningUser *types.UserIdScalar
+	if user != "" {
+		userId, _ := oid.NewOID(user)
+		owningUser = oid.OidToUserId(*userId)
+	}
+
+	tokens, err := client.SearchApiTokens(ctx, owningUser)
+	if err != nil {
+		return diag.Errorf("failed to search api tokens: %s", err.Error())
+	}
+
+	now := time.Now()
+	apiTokens := make([]interface{}, 0, len(tokens))
+	for _, token := range tokens {
+		t := map[string]interface{}{
+			"id":           token.Id,
+			"oid":          token.Oid().String(),
+			"name":         token.Name,
+			"disabled":     token.Disabled,
+			"expiration":   token.Expiration.String(),
+			"expired":      now.After(time.Time(token.Expiration)),
+			"created_date": token.CreatedDate.String(),
+			"updated_date": token.UpdatedDate.String(),
+		}
+		if token.Description != nil {
+			t["description"] = *token.Description
+		}
+		if token.User != nil {
+			t["user"] = oid.UserOid(*token.User).String()
+		}
+		apiTokens = append(apiTokens, t)
+	}
+
+	if err := data.Set("api_tokens", apiTokens); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte("api_tokens/"+user))), 10))
+	return diags
+}
@@ -0,0 +1,79 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDashboards() *schema.Resource {
+	s := listFilterSchema("dashboards")
+	s["workspace"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+		Description:      descriptions.Get("common", "schema", "workspace"),
+	}
+	// computed values
+	s["dashboards"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: descriptions.Get("dashboards", "schema", "dashboards"),
+		Elem: &schema.Resource{
+			Schema: listItemSchema("dashboards"),
+		},
+	}
+
+	return &schema.Resource{
+		Description: descriptions.Get("dashboards", "description"),
+
+		ReadContext: dataSourceDashboardsRead,
+
+		Schema: s,
+	}
+}
+
+func dataSourceDashboardsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client       = meta.(*observe.Client)
+		workspaceOid = maybeOID(data.GetOk("workspace"))
+	)
+
+	filters, diags := newListFilters(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	terms := gql.DWSearchInput{
+		WorkspaceId: []string{workspaceOid.Id},
+	}
+	if filters.folderId != "" {
+		terms.FolderId = []string{filters.folderId}
+	}
+
+	result, err := client.SearchDashboards(ctx, terms)
+	if err != nil {
+		return diag.Errorf("failed to list dashboards: %s", err.Error())
+	}
+
+	dashboards := make([]interface{}, 0, len(result))
+	for _, d := range result {
+		if !filters.match(d.Name, d.FolderId, d.ManagedById) {
+			continue
+		}
+		dashboards = append(dashboards, flattenListItem(d.Id, oid.DashboardOid(d.Id), d.Name, d.Description, d.WorkspaceId, d.FolderId, d.ManagedById))
+	}
+	sortListItems(dashboards)
+
+	if err := data.Set("dashboards", dashboards); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(listDataSourceId(workspaceOid.Id, filters.folderId, filters.managedById, filters.namePrefix))
+	return diags
+}
@@ -0,0 +1,54 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceDashboards(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_dashboard" "b" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-b"
+						stages    = "[]"
+					}
+
+					resource "observe_dashboard" "a" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-a"
+						stages    = "[]"
+					}
+
+					data "observe_dashboards" "prefix" {
+						workspace   = data.observe_workspace.default.oid
+						name_prefix = "%[1]s-"
+						depends_on  = [observe_dashboard.a, observe_dashboard.b]
+					}
+
+					data "observe_dashboards" "managed" {
+						workspace     = data.observe_workspace.default.oid
+						name_prefix   = "%[1]s-"
+						managed_by_id = "1"
+						depends_on    = [observe_dashboard.a, observe_dashboard.b]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_dashboards.prefix", "dashboards.#", "2"),
+					resource.TestCheckResourceAttr("data.observe_dashboards.prefix", "dashboards.0.name", randomPrefix+"-a"),
+					resource.TestCheckResourceAttrPair("data.observe_dashboards.prefix", "dashboards.1.oid", "observe_dashboard.b", "oid"),
+					resource.TestCheckResourceAttr("data.observe_dashboards.managed", "dashboards.#", "0"),
+				),
+			},
+		},
+	})
+}
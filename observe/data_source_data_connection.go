@@ -0,0 +1,122 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDataConnection() *schema.Resource {
+	return &schema.Resource{
+		Description: "Fetches data for an existing Observe data connection.",
+
+		ReadContext: dataSourceDataConnectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				RequiredWith:     []string{"name"},
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				ExactlyOneOf: []string{"name", "id"},
+				Optional:     true,
+				Computed:     true,
+				Description:  descriptions.Get("data_connection", "schema", "name"),
+			},
+			"id": {
+				Type:             schema.TypeString,
+				ExactlyOneOf:     []string{"name", "id"},
+				Optional:         true,
+				ValidateDiagFunc: validateID(),
+				Description:      "Data connection ID. Either `name` or `id` must be provided.",
+			},
+			// computed values
+			"folder": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_connection", "schema", "folder"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_connection", "schema", "description"),
+			},
+			"module_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_connection", "schema", "module_id"),
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_connection", "schema", "version"),
+			},
+			"variables": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("data_connection", "schema", "variables"),
+			},
+			"outputs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_connection", "schema", "outputs"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+		},
+	}
+}
+
+func dataSourceDataConnectionRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client     = meta.(*observe.Client)
+		name       = data.Get("name").(string)
+		explicitId = data.Get("id").(string)
+	)
+
+	var d *gql.DataConnection
+	var err error
+
+	if explicitId != "" {
+		d, err = client.GetDataConnection(ctx, explicitId)
+	} else if name != "" {
+		defer func() {
+			// right now SDK does not report where this error happened,
+			// so we need to provide a little extra context
+			for i := range diags {
+				diags[i].Detail = fmt.Sprintf("failed to read data connection %q", name)
+			}
+		}()
+
+		implicitId, _ := oid.NewOID(data.Get("workspace").(string))
+		d, err = client.LookupDataConnection(ctx, implicitId.Id, name)
+	}
+
+	if err != nil {
+		diags = diag.FromErr(err)
+		return
+	}
+	data.SetId(d.Id)
+	return dataConnectionToResourceData(d, data)
+}
@@ -0,0 +1,51 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceDataConnection(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					resource "observe_data_connection" "example" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+						module_id = "observeinc/host/observe"
+					}
+
+					resource "observe_datasource" "example" {
+						workspace       = data.observe_workspace.default.oid
+						name            = "%[1]s"
+						data_connection = observe_data_connection.example.oid
+						datastream      = observe_datastream.test.oid
+					}
+
+					data "observe_data_connection" "by_name" {
+						workspace = data.observe_workspace.default.oid
+						name      = observe_data_connection.example.name
+					}
+
+					data "observe_datasource" "by_id" {
+						id = observe_datasource.example.id
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.observe_data_connection.by_name", "oid", "observe_data_connection.example", "oid"),
+					resource.TestCheckResourceAttrPair("data.observe_data_connection.by_name", "version", "observe_data_connection.example", "version"),
+					resource.TestCheckResourceAttrPair("data.observe_datasource.by_id", "name", "observe_datasource.example", "name"),
+					resource.TestCheckResourceAttrPair("data.observe_datasource.by_id", "data_connection", "observe_data_connection.example", "oid"),
+				),
+			},
+		},
+	})
+}
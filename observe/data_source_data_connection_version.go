@@ -0,0 +1,80 @@
+package observe
+
+import (
+	"context"
+	"hash/crc32"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDataConnectionVersion() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("data_connection_version", "description"),
+		ReadContext: dataSourceDataConnectionVersionRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"module_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("data_connection_version", "schema", "module_id"),
+			},
+			"version_constraint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("data_connection_version", "schema", "version_constraint"),
+			},
+			"include_prerelease": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions.Get("data_connection_version", "schema", "include_prerelease"),
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_connection_version", "schema", "version"),
+			},
+		},
+	}
+}
+
+func dataSourceDataConnectionVersionRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client            = meta.(*observe.Client)
+		moduleId          = data.Get("module_id").(string)
+		versionConstraint = data.Get("version_constraint").(string)
+		includePrerelease = data.Get("include_prerelease").(bool)
+	)
+
+	workspaceId, _ := oid.NewOID(data.Get("workspace").(string))
+	moduleVersions, err := client.LookupDataConnectionModuleVersions(ctx, workspaceId.Id, moduleId)
+	if err != nil {
+		diags = diag.FromErr(err)
+		return
+	}
+
+	version, err := matchVersionString(moduleId, moduleVersions, versionConstraint, includePrerelease)
+	if err != nil {
+		diags = diag.FromErr(err)
+		return
+	}
+	if err := data.Set("version", version); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return
+	}
+
+	// Hash the input fields and set that as the ID
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(workspaceId.Id+"/"+moduleId+"/"+versionConstraint+"/"+strconv.FormatBool(includePrerelease)))), 10))
+
+	return diags
+}
@@ -0,0 +1,244 @@
+package observe
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDatasetAccelerationStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("dataset_acceleration_status", "description"),
+		ReadContext: dataSourceDatasetAccelerationStatusRead,
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				Description:      descriptions.Get("dataset_acceleration_status", "schema", "dataset"),
+			},
+			"wait_until_fresh": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions.Get("dataset_acceleration_status", "schema", "wait_until_fresh", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_staleness": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateTimeDuration,
+							Description:      descriptions.Get("dataset_acceleration_status", "schema", "wait_until_fresh", "max_staleness"),
+						},
+						"timeout": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "10m",
+							ValidateDiagFunc: validatePositiveTimeDuration,
+							Description:      descriptions.Get("dataset_acceleration_status", "schema", "wait_until_fresh", "timeout"),
+						},
+						"interval": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "15s",
+							ValidateDiagFunc: validatePositiveTimeDuration,
+							Description:      descriptions.Get("dataset_acceleration_status", "schema", "wait_until_fresh", "interval"),
+						},
+					},
+				},
+			},
+			// computed values
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset_acceleration_status", "schema", "state"),
+			},
+			"staleness": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset_acceleration_status", "schema", "staleness"),
+			},
+			"target_staleness": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset_acceleration_status", "schema", "target_staleness"),
+			},
+			"configured_target_staleness": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset_acceleration_status", "schema", "configured_target_staleness"),
+			},
+			"freshness_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset_acceleration_status", "schema", "freshness_time"),
+			},
+			"progress": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: descriptions.Get("dataset_acceleration_status", "schema", "progress"),
+			},
+			"running_jobs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("dataset_acceleration_status", "schema", "running_jobs"),
+			},
+			"errors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("dataset_acceleration_status", "schema", "errors", "description"),
+				Elem:        accelerationErrorResource("dataset_acceleration_status", "errors"),
+			},
+		},
+	}
+}
+
+func dataSourceDatasetAccelerationStatusRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client = meta.(*observe.Client)
+		status *gql.DatasetAccelerationStatus
+		poller Poller
+	)
+
+	id, _ := oid.NewOID(data.Get("dataset").(string))
+
+	// if wait_until_fresh is not set, poller runs exactly once
+	exitCond := func() bool { return true }
+
+	if _, ok := data.GetOk("wait_until_fresh"); ok {
+		maxStaleness, _ := time.ParseDuration(data.Get("wait_until_fresh.0.max_staleness").(string))
+		timeout, _ := time.ParseDuration(data.Get("wait_until_fresh.0.timeout").(string))
+		interval, _ := time.ParseDuration(data.Get("wait_until_fresh.0.interval").(string))
+
+		poller.Timeout = &timeout
+		poller.Interval = &interval
+		exitCond = func() bool {
+			return status.StalenessSeconds != nil && secondsToDuration(*status.StalenessSeconds) <= maxStaleness
+		}
+	}
+
+	err := poller.Run(ctx, func(ctx context.Context) error {
+		var err error
+		status, err = client.GetDatasetAccelerationStatus(ctx, id.Id)
+		return err
+	}, exitCond)
+
+	if err != nil {
+		if status == nil || ctx.Err() != nil || !errors.Is(err, context.DeadlineExceeded) {
+			return diag.Errorf("failed to read dataset acceleration status: %s", err.Error())
+		}
+		diags = append(diags, diag.Errorf("timed out waiting for dataset %s to be fresh", id.Id)...)
+	}
+
+	data.SetId(id.Id)
+	return append(diags, datasetAccelerationStatusToResourceData(status, data)...)
+}
+
+func datasetAccelerationStatusToResourceData(s *gql.DatasetAccelerationStatus, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if err := data.Set("state", toSnake(string(s.State))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	for key, v := range map[string]*float64{
+		"staleness":                   s.StalenessSeconds,
+		"target_staleness":            s.TargetStalenessSeconds,
+		"configured_target_staleness": s.ConfiguredTargetStalenessSeconds,
+	} {
+		var value string
+		if v != nil {
+			value = secondsToDuration(*v).String()
+		}
+		if err := data.Set(key, value); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	var freshnessTime string
+	if s.FreshnessTime != nil {
+		freshnessTime = s.FreshnessTime.String()
+	}
+	if err := data.Set("freshness_time", freshnessTime); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("progress", s.Progress); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("running_jobs", s.RunningJobs); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("errors", flattenAccelerationErrors(s.Errors)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// accelerationErrorResource returns the schema of an acceleration error, with
+// descriptions read from the given attribute of a description file
+func accelerationErrorResource(filename string, attribute string) *schema.Resource {
+	description := func(field string) string {
+		return descriptions.Get(filename, "schema", attribute, field)
+	}
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: description("dataset"),
+			},
+			"dataset_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: description("dataset_name"),
+			},
+			"transform_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: description("transform_id"),
+			},
+			"time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: description("time"),
+			},
+			"error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: description("error"),
+			},
+		},
+	}
+}
+
+func flattenAccelerationErrors(errors []gql.DatasetAccelerationInfoErrorsAccelerationError) []interface{} {
+	result := make([]interface{}, 0, len(errors))
+	for _, e := range errors {
+		v := map[string]interface{}{
+			"dataset":      oid.DatasetOid(e.DatasetId).String(),
+			"dataset_name": e.DatasetName,
+			"time":         e.Time.String(),
+			"error":        e.ErrorText,
+		}
+		if e.TransformId != nil {
+			v["transform_id"] = *e.TransformId
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+func secondsToDuration(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second)).Round(time.Second)
+}
@@ -0,0 +1,49 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceDatasetAccelerationStatus(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				resource "observe_dataset" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+
+					inputs = { "test" = observe_datastream.test.dataset }
+
+					stage {
+						pipeline = "filter true"
+					}
+				}
+
+				data "observe_dataset_acceleration_status" "first" {
+					dataset = observe_dataset.first.oid
+
+					wait_until_fresh {
+						max_staleness = "1h"
+						timeout       = "5m"
+						interval      = "5s"
+					}
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.observe_dataset_acceleration_status.first", "state"),
+					resource.TestCheckResourceAttrSet("data.observe_dataset_acceleration_status.first", "staleness"),
+					resource.TestCheckResourceAttr("data.observe_dataset_acceleration_status.first", "errors.#", "0"),
+				),
+			},
+		},
+	})
+}
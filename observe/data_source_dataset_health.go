@@ -0,0 +1,195 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+const (
+	datasetFindingSeverityError   = "error"
+	datasetFindingSeverityWarning = "warning"
+	datasetFindingSeverityComment = "comment"
+)
+
+func dataSourceDatasetHealth() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("dataset_health", "description"),
+		ReadContext: dataSourceDatasetHealthRead,
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				Description:      descriptions.Get("dataset_health", "schema", "dataset"),
+			},
+			"up_levels": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      descriptions.Get("dataset_health", "schema", "up_levels"),
+			},
+			// computed values
+			"healthy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("dataset_health", "schema", "healthy"),
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset_health", "schema", "state"),
+			},
+			"last_materialization_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset_health", "schema", "last_materialization_time"),
+			},
+			"findings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("dataset_health", "schema", "findings", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_health", "schema", "findings", "dataset"),
+						},
+						"dataset_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_health", "schema", "findings", "dataset_name"),
+						},
+						"stage": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_health", "schema", "findings", "stage"),
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_health", "schema", "findings", "severity"),
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_health", "schema", "findings", "message"),
+						},
+					},
+				},
+			},
+			"acceleration_errors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("dataset_health", "schema", "acceleration_errors", "description"),
+				Elem:        accelerationErrorResource("dataset_health", "acceleration_errors"),
+			},
+		},
+	}
+}
+
+func dataSourceDatasetHealthRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	id, _ := oid.NewOID(data.Get("dataset").(string))
+
+	reports, err := client.GetDatasetDoctorReport(ctx, id.Id, data.Get("up_levels").(int))
+	if err != nil {
+		return diag.Errorf("failed to read dataset health: %s", err.Error())
+	}
+
+	data.SetId(id.Id)
+	return datasetReportsToResourceData(id.Id, reports, data)
+}
+
+func datasetReportsToResourceData(id string, reports []gql.DatasetReport, data *schema.ResourceData) (diags diag.Diagnostics) {
+	var (
+		healthy             = true
+		state               string
+		lastMaterialization string
+		findings            = make([]interface{}, 0)
+		accelerationErrors  = make([]gql.DatasetAccelerationInfoErrorsAccelerationError, 0)
+	)
+
+	for _, r := range reports {
+		finding := func(stage string, severity string, message string) {
+			if severity == datasetFindingSeverityError {
+				healthy = false
+			}
+			findings = append(findings, map[string]interface{}{
+				"dataset":      oid.DatasetOid(r.DatasetId).String(),
+				"dataset_name": r.DatasetLabel,
+				"stage":        stage,
+				"severity":     severity,
+				"message":      message,
+			})
+		}
+
+		for _, comment := range r.DoctorComments {
+			finding("", datasetFindingSeverityComment, comment)
+		}
+		for _, note := range r.StageNotes {
+			for _, e := range note.Errors {
+				finding(note.StageId, datasetFindingSeverityError, formatPipelineSymbol(e))
+			}
+			for _, w := range note.Warnings {
+				finding(note.StageId, datasetFindingSeverityWarning, formatPipelineSymbol(w.Symbol))
+			}
+		}
+		if r.OngoingErrorReason != nil {
+			finding("", datasetFindingSeverityError, r.OngoingErrorReason.Text)
+		}
+		if r.BackfillErrorReason != nil {
+			finding("", datasetFindingSeverityError, r.BackfillErrorReason.Text)
+		}
+
+		if info := r.AccelerationInfo; info != nil {
+			if info.State == gql.AccelerationStateError {
+				healthy = false
+			}
+			accelerationErrors = append(accelerationErrors, info.Errors...)
+
+			if r.DatasetId == id {
+				state = toSnake(string(info.State))
+				if info.FreshnessTime != nil {
+					lastMaterialization = info.FreshnessTime.String()
+				}
+			}
+		}
+	}
+
+	if len(accelerationErrors) > 0 {
+		healthy = false
+	}
+
+	if err := data.Set("healthy", healthy); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("state", state); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("last_materialization_time", lastMaterialization); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("findings", findings); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("acceleration_errors", flattenAccelerationErrors(accelerationErrors)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
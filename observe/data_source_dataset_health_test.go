@@ -0,0 +1,44 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceDatasetHealth(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				resource "observe_dataset" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+
+					inputs = { "test" = observe_datastream.test.dataset }
+
+					stage {
+						pipeline = "filter true"
+					}
+				}
+
+				data "observe_dataset_health" "first" {
+					dataset   = observe_dataset.first.oid
+					up_levels = 1
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_dataset_health.first", "healthy", "true"),
+					resource.TestCheckResourceAttrSet("data.observe_dataset_health.first", "state"),
+					resource.TestCheckResourceAttr("data.observe_dataset_health.first", "acceleration_errors.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,101 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDatasetLineage() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("dataset_lineage", "description"),
+		ReadContext: dataSourceDatasetLineageRead,
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				Description:      descriptions.Get("dataset_lineage", "schema", "dataset"),
+			},
+			"depth": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      descriptions.Get("dataset_lineage", "schema", "depth"),
+			},
+			// computed values
+			"upstream_datasets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("dataset_lineage", "schema", "upstream_datasets"),
+			},
+			"downstream_datasets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("dataset_lineage", "schema", "downstream_datasets"),
+			},
+			"downstream_monitors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("dataset_lineage", "schema", "downstream_monitors"),
+			},
+			"downstream_dashboards": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("dataset_lineage", "schema", "downstream_dashboards"),
+			},
+			"downstream_shares": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("dataset_lineage", "schema", "downstream_shares"),
+			},
+		},
+	}
+}
+
+func dataSourceDatasetLineageRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	id, _ := oid.NewOID(data.Get("dataset").(string))
+
+	dataset, err := client.GetDataset(ctx, id.Id)
+	if err != nil {
+		return diag.Errorf("failed to read dataset: %s", err.Error())
+	}
+
+	lineage, err := getDatasetLineage(ctx, client, dataset.WorkspaceId, id.Id, data.Get("depth").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(id.Id)
+
+	for key, objects := range map[string][]datasetLineageObject{
+		"upstream_datasets":     lineage.UpstreamDatasets,
+		"downstream_datasets":   lineage.DownstreamDatasets,
+		"downstream_monitors":   lineage.DownstreamMonitors,
+		"downstream_dashboards": lineage.DownstreamDashboards,
+		"downstream_shares":     lineage.DownstreamShares,
+	} {
+		oids := make([]string, 0, len(objects))
+		for _, o := range objects {
+			oids = append(oids, o.Oid)
+		}
+		if err := data.Set(key, oids); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	return diags
+}
@@ -0,0 +1,92 @@
+package observe
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceDatasetLineage(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				resource "observe_dataset" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-first"
+
+					inputs = { "test" = observe_datastream.test.dataset }
+
+					stage {
+						pipeline = "filter true"
+					}
+				}
+
+				resource "observe_dataset" "second" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-second"
+
+					inputs = { "first" = observe_dataset.first.oid }
+
+					stage {
+						pipeline = "filter true"
+					}
+				}
+
+				resource "observe_monitor" "second" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-second"
+
+					inputs = { "second" = observe_dataset.second.oid }
+
+					stage {}
+
+					rule {
+						count {
+							compare_function = "less_or_equal"
+							compare_values   = [1]
+							lookback_time    = "1m"
+						}
+					}
+				}
+
+				data "observe_dataset_lineage" "direct" {
+					dataset = observe_dataset.first.oid
+
+					depends_on = [observe_dataset.second, observe_monitor.second]
+				}
+
+				data "observe_dataset_lineage" "second" {
+					dataset = observe_dataset.second.oid
+
+					depends_on = [observe_monitor.second]
+				}
+
+				data "observe_dataset_lineage" "transitive" {
+					dataset = observe_datastream.test.dataset
+					depth   = 2
+
+					depends_on = [observe_dataset.second, observe_monitor.second]
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.direct", "upstream_datasets.#", "1"),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.direct", "downstream_datasets.#", "1"),
+					resource.TestMatchResourceAttr("data.observe_dataset_lineage.direct", "downstream_datasets.0", regexp.MustCompile(`^o:::dataset:\d+$`)),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.direct", "downstream_monitors.#", "0"),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.transitive", "downstream_datasets.#", "2"),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.transitive", "downstream_monitors.#", "0"),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.second", "downstream_monitors.#", "1"),
+					resource.TestCheckResourceAttrPair("data.observe_dataset_lineage.second", "downstream_monitors.0", "observe_monitor.second", "oid"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDatasets() *schema.Resource {
+	itemSchema := listItemSchema("datasets")
+	itemSchema["source"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: descriptions.Get("datasets", "schema", "item_source"),
+	}
+
+	s := listFilterSchema("datasets")
+	s["workspace"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+		Description:      descriptions.Get("common", "schema", "workspace"),
+	}
+	s["source"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: descriptions.Get("datasets", "schema", "source"),
+	}
+	// computed values
+	s["datasets"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: descriptions.Get("datasets", "schema", "datasets"),
+		Elem: &schema.Resource{
+			Schema: itemSchema,
+		},
+	}
+
+	return &schema.Resource{
+		Description: descriptions.Get("datasets", "description"),
+
+		ReadContext: dataSourceDatasetsRead,
+
+		Schema: s,
+	}
+}
+
+func dataSourceDatasetsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client       = meta.(*observe.Client)
+		workspaceOid = maybeOID(data.GetOk("workspace"))
+		source       = data.Get("source").(string)
+	)
+
+	filters, diags := newListFilters(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	result, err := client.SearchDatasets(ctx, workspaceOid.Id, nil, nil)
+	if err != nil {
+		return diag.Errorf("failed to list datasets: %s", err.Error())
+	}
+
+	datasets := make([]interface{}, 0, len(result))
+	for _, d := range result {
+		if !filters.match(d.Name, d.FolderId, d.ManagedById) {
+			continue
+		}
+		if source != "" && (d.Source == nil || *d.Source != source) {
+			continue
+		}
+		item := flattenListItem(d.Id, oid.DatasetOid(d.Id), d.Name, d.Description, d.WorkspaceId, d.FolderId, d.ManagedById)
+		if d.Source != nil {
+			item["source"] = *d.Source
+		}
+		datasets = append(datasets, item)
+	}
+	sortListItems(datasets)
+
+	if err := data.Set("datasets", datasets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(listDataSourceId(workspaceOid.Id, filters.folderId, filters.managedById, filters.namePrefix, source))
+	return diags
+}
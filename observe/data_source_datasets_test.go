@@ -0,0 +1,65 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceDatasets(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					resource "observe_dataset" "b" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-b"
+
+						inputs = { "test" = observe_datastream.test.dataset }
+
+						stage {
+							pipeline = "filter false"
+						}
+					}
+
+					resource "observe_dataset" "a" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-a"
+
+						inputs = { "test" = observe_datastream.test.dataset }
+
+						stage {
+							pipeline = "filter false"
+						}
+					}
+
+					data "observe_datasets" "prefix" {
+						workspace   = data.observe_workspace.default.oid
+						name_prefix = "%[1]s-"
+						depends_on  = [observe_dataset.a, observe_dataset.b]
+					}
+
+					data "observe_datasets" "source" {
+						workspace   = data.observe_workspace.default.oid
+						name_prefix = "%[1]s-"
+						source      = "does-not-exist"
+						depends_on  = [observe_dataset.a, observe_dataset.b]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_datasets.prefix", "datasets.#", "2"),
+					resource.TestCheckResourceAttrPair("data.observe_datasets.prefix", "datasets.0.oid", "observe_dataset.a", "oid"),
+					resource.TestCheckResourceAttrPair("data.observe_datasets.prefix", "datasets.1.oid", "observe_dataset.b", "oid"),
+					resource.TestCheckResourceAttrSet("data.observe_datasets.prefix", "datasets.0.folder"),
+					resource.TestCheckResourceAttr("data.observe_datasets.source", "datasets.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,138 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Fetches data for an existing Observe datasource.",
+
+		ReadContext: dataSourceDatasourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				RequiredWith:     []string{"name"},
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				ExactlyOneOf: []string{"name", "id"},
+				Optional:     true,
+				Computed:     true,
+				Description:  descriptions.Get("datasource", "schema", "name"),
+			},
+			"id": {
+				Type:             schema.TypeString,
+				ExactlyOneOf:     []string{"name", "id"},
+				Optional:         true,
+				ValidateDiagFunc: validateID(),
+				Description:      "Datasource ID. Either `name` or `id` must be provided.",
+			},
+			// computed values
+			"folder": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("datasource", "schema", "folder"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("datasource", "schema", "description"),
+			},
+			"data_connection": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("datasource", "schema", "data_connection"),
+			},
+			"datastream": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("datasource", "schema", "datastream"),
+			},
+			"datastream_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("datasource", "schema", "datastream_token"),
+			},
+			"variables": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("datasource", "schema", "variables"),
+			},
+			"client_stack_attributes": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("datasource", "schema", "client_stack_attributes"),
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("datasource", "schema", "type"),
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("datasource", "schema", "state"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+		},
+	}
+}
+
+func dataSourceDatasourceRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client     = meta.(*observe.Client)
+		name       = data.Get("name").(string)
+		explicitId = data.Get("id").(string)
+	)
+
+	var d *gql.Datasource
+	var err error
+
+	if explicitId != "" {
+		d, err = client.GetDatasource(ctx, explicitId)
+	} else if name != "" {
+		defer func() {
+			// right now SDK does not report where this error happened,
+			// so we need to provide a little extra context
+			for i := range diags {
+				diags[i].Detail = fmt.Sprintf("failed to read datasource %q", name)
+			}
+		}()
+
+		implicitId, _ := oid.NewOID(data.Get("workspace").(string))
+		d, err = client.LookupDatasource(ctx, implicitId.Id, name)
+	}
+
+	if err != nil {
+		diags = diag.FromErr(err)
+		return
+	}
+	data.SetId(d.Id)
+	return datasourceToResourceData(d, data)
+}
@@ -3,6 +3,7 @@ package observe
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -51,6 +52,30 @@ func dataSourceFolder() *schema.Resource {
 				Computed:    true,
 				Description: schemaDatasetIconDescription,
 			},
+			"datasets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "OIDs of datasets contained in the folder.",
+			},
+			"dashboards": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "OIDs of dashboards contained in the folder.",
+			},
+			"worksheets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "OIDs of worksheets contained in the folder.",
+			},
+			"monitors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "OIDs of monitors contained in the folder.",
+			},
 		},
 	}
 }
@@ -86,5 +111,69 @@ func dataSourceFolderRead(ctx context.Context, data *schema.ResourceData, meta i
 		return
 	}
 	data.SetId(f.Id)
-	return folderToResourceData(f, data)
+	diags = folderToResourceData(f, data)
+	return append(diags, folderContentsToResourceData(ctx, client, f, data)...)
+}
+
+// folderContentsToResourceData lists the objects contained in a folder, by type
+func folderContentsToResourceData(ctx context.Context, client *observe.Client, f *gql.Folder, data *schema.ResourceData) (diags diag.Diagnostics) {
+	var datasets, dashboards, worksheets, monitors []string
+
+	datasetResults, err := client.SearchDatasets(ctx, f.WorkspaceId, nil, nil)
+	if err != nil {
+		return diag.Errorf("failed to list datasets: %s", err.Error())
+	}
+	for _, d := range datasetResults {
+		if d.FolderId == f.Id {
+			datasets = append(datasets, oid.DatasetOid(d.Id).String())
+		}
+	}
+
+	terms := gql.DWSearchInput{
+		WorkspaceId: []string{f.WorkspaceId},
+		FolderId:    []string{f.Id},
+	}
+
+	dashboardResults, err := client.SearchDashboards(ctx, terms)
+	if err != nil {
+		return diag.Errorf("failed to list dashboards: %s", err.Error())
+	}
+	for _, d := range dashboardResults {
+		if d.FolderId == f.Id {
+			dashboards = append(dashboards, oid.DashboardOid(d.Id).String())
+		}
+	}
+
+	worksheetResults, err := client.SearchWorksheets(ctx, terms)
+	if err != nil {
+		return diag.Errorf("failed to list worksheets: %s", err.Error())
+	}
+	for _, w := range worksheetResults {
+		if w.FolderId == f.Id {
+			worksheets = append(worksheets, oid.WorksheetOid(w.Id).String())
+		}
+	}
+
+	monitorResults, err := client.ListMonitorV2(ctx, &f.WorkspaceId)
+	if err != nil {
+		return diag.Errorf("failed to list monitors: %s", err.Error())
+	}
+	for _, m := range monitorResults {
+		if m.FolderId == f.Id {
+			monitors = append(monitors, m.Oid().String())
+		}
+	}
+
+	for key, oids := range map[string][]string{
+		"datasets":   datasets,
+		"dashboards": dashboards,
+		"worksheets": worksheets,
+		"monitors":   monitors,
+	} {
+		sort.Strings(oids)
+		if err := data.Set(key, oids); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	return diags
 }
@@ -2,10 +2,12 @@ package observe
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccObserveSourceFolder(t *testing.T) {
@@ -16,21 +18,96 @@ func TestAccObserveSourceFolder(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(configPreamble+`
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
 					resource "observe_folder" "a" {
 						workspace = data.observe_workspace.default.oid
 						name      = "%[1]s"
 					}
 
+					resource "observe_dataset" "a" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-dataset"
+
+						inputs = { "test" = observe_datastream.test.dataset }
+
+						stage {
+							pipeline = "filter false"
+						}
+					}
+
+					resource "observe_worksheet" "a" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-worksheet"
+						queries   = jsonencode([{
+							id       = "stage"
+							pipeline = "filter false"
+							input = [{
+								inputName = "test"
+								inputRole = "Data"
+								datasetId = observe_dataset.a.id
+							}]
+						}])
+					}
+
 					data "observe_folder" "lookup_by_name" {
 						workspace = data.observe_workspace.default.oid
 						name      = observe_folder.a.name
 					}
+
+					# datasets and worksheets cannot be moved between folders,
+					# so look up the folder they were created in
+					data "observe_datasets" "a" {
+						workspace   = data.observe_workspace.default.oid
+						name_prefix = observe_dataset.a.name
+					}
+
+					data "observe_oid" "folder" {
+						oid = one(data.observe_datasets.a.datasets).folder
+					}
+
+					data "observe_folder" "lookup_by_id" {
+						workspace  = data.observe_workspace.default.oid
+						id         = data.observe_oid.folder.version
+						depends_on = [observe_worksheet.a]
+					}
 				`, randomPrefix),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.observe_folder.lookup_by_name", "name", randomPrefix),
+					resource.TestCheckResourceAttr("data.observe_folder.lookup_by_name", "datasets.#", "0"),
+					resource.TestCheckResourceAttr("data.observe_folder.lookup_by_name", "dashboards.#", "0"),
+					resource.TestCheckResourceAttr("data.observe_folder.lookup_by_name", "worksheets.#", "0"),
+					resource.TestCheckResourceAttr("data.observe_folder.lookup_by_name", "monitors.#", "0"),
+					resource.TestCheckTypeSetElemAttrPair("data.observe_folder.lookup_by_id", "datasets.*", "observe_dataset.a", "oid"),
+					resource.TestCheckTypeSetElemAttrPair("data.observe_folder.lookup_by_id", "worksheets.*", "observe_worksheet.a", "oid"),
+					// the dataset lives outside the folder created above
+					testAccCheckListNotContainsPair("data.observe_folder.lookup_by_name", "datasets", "observe_dataset.a", "oid"),
+					testAccCheckListNotContainsPair("data.observe_folder.lookup_by_name", "worksheets", "observe_worksheet.a", "oid"),
 				),
 			},
 		},
 	})
 }
+
+// testAccCheckListNotContainsPair verifies the list attribute of one resource
+// does not contain the value of an attribute of another
+func testAccCheckListNotContainsPair(name, key, otherName, otherKey string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+		other, ok := s.RootModule().Resources[otherName]
+		if !ok {
+			return fmt.Errorf("not found: %s", otherName)
+		}
+		value := other.Primary.Attributes[otherKey]
+
+		count, _ := strconv.Atoi(rs.Primary.Attributes[key+".#"])
+		for i := 0; i < count; i++ {
+			if rs.Primary.Attributes[fmt.Sprintf("%s.%d", key, i)] == value {
+				return fmt.Errorf("%s: %s unexpectedly contains %q", name, key, value)
+			}
+		}
+		return nil
+	}
+}
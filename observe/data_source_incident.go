@@ -0,0 +1,152 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceIncident() *schema.Resource {
+	return &schema.Resource{
+		Description: "Fetches data for an existing Observe incident.",
+
+		ReadContext: dataSourceIncidentRead,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				RequiredWith:     []string{"name"},
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				ExactlyOneOf: []string{"name", "id"},
+				Optional:     true,
+				Computed:     true,
+				Description:  descriptions.Get("incident", "schema", "name"),
+			},
+			"id": {
+				Type:             schema.TypeString,
+				ExactlyOneOf:     []string{"name", "id"},
+				Optional:         true,
+				ValidateDiagFunc: validateID(),
+				Description:      "Incident ID. Either `name` or `id` must be provided.",
+			},
+			// computed values
+			"folder": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "folder"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "description"),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "status"),
+			},
+			"users": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("incident", "schema", "users"),
+			},
+			"worksheets": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("incident", "schema", "worksheets"),
+			},
+			"dashboards": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("incident", "schema", "dashboards"),
+			},
+			"slack_channel": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "slack_channel", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("incident", "schema", "slack_channel", "connection_id"),
+						},
+						"channel_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("incident", "schema", "slack_channel", "channel_id"),
+						},
+					},
+				},
+			},
+			"inactive_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "inactive_time"),
+			},
+			"closed_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "closed_time"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+		},
+	}
+}
+
+func dataSourceIncidentRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client     = meta.(*observe.Client)
+		name       = data.Get("name").(string)
+		explicitId = data.Get("id").(string)
+	)
+
+	var incident *gql.Incident
+	var err error
+
+	if explicitId != "" {
+		incident, err = client.GetIncident(ctx, explicitId)
+	} else if name != "" {
+		defer func() {
+			// right now SDK does not report where this error happened,
+			// so we need to provide a little extra context
+			for i := range diags {
+				diags[i].Detail = fmt.Sprintf("failed to read incident %q", name)
+			}
+		}()
+
+		implicitId, _ := oid.NewOID(data.Get("workspace").(string))
+		incident, err = client.LookupIncident(ctx, implicitId.Id, name)
+	}
+
+	if err != nil {
+		diags = diag.FromErr(err)
+		return
+	}
+	data.SetId(incident.Id)
+	return incidentToResourceData(incident, data)
+}
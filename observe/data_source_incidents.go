@@ -0,0 +1,126 @@
+package observe
+
+import (
+	"context"
+	"hash/crc32"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceIncidents() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("incidents", "description"),
+
+		ReadContext: dataSourceIncidentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateEnums(gql.AllIncidentStatuses),
+				DiffSuppressFunc: diffSuppressEnums,
+				Description:      descriptions.Get("incidents", "schema", "status"),
+			},
+			"starting_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateTimestamp,
+				Description:      descriptions.Get("incidents", "schema", "starting_at"),
+			},
+			"ending_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateTimestamp,
+				Description:      descriptions.Get("incidents", "schema", "ending_at"),
+			},
+			// computed values
+			"incidents": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("incidents", "schema", "incidents"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "id"),
+						},
+						"oid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "oid"),
+						},
+						"workspace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "workspace"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("incident", "schema", "name"),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("incident", "schema", "description"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIncidentsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client     = meta.(*observe.Client)
+		status     = data.Get("status").(string)
+		startingAt = data.Get("starting_at").(string)
+		endingAt   = data.Get("ending_at").(string)
+	)
+
+	var start, end *types.TimeScalar
+	if startingAt != "" {
+		t, _ := time.Parse(time.RFC3339, startingAt)
+		start = (*types.TimeScalar)(&t)
+	}
+	if endingAt != "" {
+		t, _ := time.Parse(time.RFC3339, endingAt)
+		end = (*types.TimeScalar)(&t)
+	}
+
+	result, err := client.GetIncidentsForStatus(ctx, gql.IncidentStatus(toCamel(status)), start, end)
+	if err != nil {
+		return diag.Errorf("failed to read incidents: %s", err.Error())
+	}
+
+	incidents := make([]interface{}, 0, len(result))
+	for _, incident := range result {
+		i := map[string]interface{}{
+			"id":        incident.Id,
+			"oid":       incident.Oid().String(),
+			"workspace": oid.WorkspaceOid(incident.WorkspaceId).String(),
+			"name":      incident.Name,
+		}
+		if incident.Description != nil {
+			i["description"] = *incident.Description
+		}
+		incidents = append(incidents, i)
+	}
+
+	if err := data.Set("incidents", incidents); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(status+"/"+startingAt+"/"+endingAt))), 10))
+	return diags
+}
@@ -0,0 +1,109 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceInvestigationNotebook() *schema.Resource {
+	return &schema.Resource{
+		Description: "Fetches data for an existing Observe investigation notebook.",
+
+		ReadContext: dataSourceInvestigationNotebookRead,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				ExactlyOneOf: []string{"name", "id"},
+				Optional:     true,
+				Computed:     true,
+				Description:  descriptions.Get("investigation_notebook", "schema", "name"),
+			},
+			"id": {
+				Type:             schema.TypeString,
+				ExactlyOneOf:     []string{"name", "id"},
+				Optional:         true,
+				ValidateDiagFunc: validateID(),
+				Description:      "Notebook ID. Either `name` or `id` must be provided.",
+			},
+			// computed values
+			"folder": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "folder"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "description"),
+			},
+			"runbook_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "runbook_url"),
+			},
+			"incident": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "incident"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+		},
+	}
+}
+
+func dataSourceInvestigationNotebookRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client     = meta.(*observe.Client)
+		name       = data.Get("name").(string)
+		explicitId = data.Get("id").(string)
+	)
+
+	implicitId, _ := oid.NewOID(data.Get("workspace").(string))
+
+	var n *gql.InvestigationNotebook
+	var err error
+
+	if explicitId != "" {
+		n, err = client.GetInvestigationNotebook(ctx, explicitId)
+	} else if name != "" {
+		defer func() {
+			// right now SDK does not report where this error happened,
+			// so we need to provide a little extra context
+			for i := range diags {
+				diags[i].Detail = fmt.Sprintf("failed to read notebook %q", name)
+			}
+		}()
+
+		n, err = client.LookupInvestigationNotebook(ctx, implicitId.Id, name)
+	}
+
+	if err != nil {
+		diags = diag.FromErr(err)
+		return
+	}
+	data.SetId(n.Id)
+	return investigationNotebookToResourceData(n, data)
+}
@@ -0,0 +1,44 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceInvestigationNotebook(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_investigation_notebook" "a" {
+						workspace   = data.observe_workspace.default.oid
+						name        = "%[1]s"
+						runbook_url = "https://example.com/runbooks/a"
+
+						block {
+							markdown {
+								text = "hello"
+							}
+						}
+					}
+
+					data "observe_investigation_notebook" "lookup_by_name" {
+						workspace = data.observe_workspace.default.oid
+						name      = observe_investigation_notebook.a.name
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.observe_investigation_notebook.lookup_by_name", "oid", "observe_investigation_notebook.a", "oid"),
+					resource.TestCheckResourceAttr("data.observe_investigation_notebook.lookup_by_name", "runbook_url", "https://example.com/runbooks/a"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,148 @@
+package observe
+
+import (
+	"hash/crc32"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+// listFilters holds the filters shared by data sources which return lists of
+// objects, e.g. observe_datasets
+type listFilters struct {
+	folderId    string
+	managedById string
+	namePrefix  string
+}
+
+func newListFilters(data *schema.ResourceData) (*listFilters, diag.Diagnostics) {
+	f := &listFilters{
+		managedById: data.Get("managed_by_id").(string),
+		namePrefix:  data.Get("name_prefix").(string),
+	}
+	if folder := maybeOID(data.GetOk("folder")); folder != nil {
+		if folder.Version == nil {
+			return nil, diag.Errorf("folder %q does not include a folder id", folder)
+		}
+		f.folderId = *folder.Version
+	}
+	return f, nil
+}
+
+func (f *listFilters) match(name string, folderId string, managedById *string) bool {
+	if f.namePrefix != "" && !strings.HasPrefix(name, f.namePrefix) {
+		return false
+	}
+	if f.folderId != "" && folderId != f.folderId {
+		return false
+	}
+	if f.managedById != "" && (managedById == nil || *managedById != f.managedById) {
+		return false
+	}
+	return true
+}
+
+// sortListItems sorts list items by name, breaking ties by id, so that the
+// order is stable across reads
+func sortListItems(items []interface{}) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i].(map[string]interface{}), items[j].(map[string]interface{})
+		if a["name"] != b["name"] {
+			return a["name"].(string) < b["name"].(string)
+		}
+		return a["id"].(string) < b["id"].(string)
+	})
+}
+
+// listDataSourceId derives an id for a list data source from its arguments
+func listDataSourceId(args ...string) string {
+	return strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(strings.Join(args, "/")))), 10)
+}
+
+func flattenListItem(id string, objectOid oid.OID, name string, description *string, workspaceId string, folderId string, managedById *string) map[string]interface{} {
+	item := map[string]interface{}{
+		"id":        id,
+		"oid":       objectOid.String(),
+		"workspace": oid.WorkspaceOid(workspaceId).String(),
+		"name":      name,
+		"folder":    oid.FolderOid(folderId, workspaceId).String(),
+	}
+	if description != nil {
+		item["description"] = *description
+	}
+	if managedById != nil {
+		item["managed_by_id"] = *managedById
+	}
+	return item
+}
+
+// listFilterSchema returns the filter arguments shared by list data sources,
+// using descriptions from the given file
+func listFilterSchema(file string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"folder": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateOID(oid.TypeFolder),
+			Description:      descriptions.Get(file, "schema", "folder"),
+		},
+		"managed_by_id": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateID(),
+			Description:      descriptions.Get(file, "schema", "managed_by_id"),
+		},
+		"name_prefix": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions.Get(file, "schema", "name_prefix"),
+		},
+	}
+}
+
+// listItemSchema returns the attributes shared by items returned from list data
+// sources, using descriptions from the given file
+func listItemSchema(file string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: descriptions.Get("common", "schema", "id"),
+		},
+		"oid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: descriptions.Get("common", "schema", "oid"),
+		},
+		"workspace": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: descriptions.Get("common", "schema", "workspace"),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: descriptions.Get(file, "schema", "item_name"),
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: descriptions.Get(file, "schema", "item_description"),
+		},
+		"folder": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: descriptions.Get(file, "schema", "item_folder"),
+		},
+		"managed_by_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: descriptions.Get(file, "schema", "item_managed_by_id"),
+		},
+	}
+}
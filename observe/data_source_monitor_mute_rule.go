@@ -0,0 +1,172 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceMonitorMuteRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Fetches data for an existing Observe monitor mute rule.",
+
+		ReadContext: dataSourceMonitorMuteRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				RequiredWith:     []string{"name"},
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				ExactlyOneOf: []string{"name", "id"},
+				Optional:     true,
+				Computed:     true,
+				Description:  descriptions.Get("monitor_mute_rule", "schema", "name"),
+			},
+			"id": {
+				Type:             schema.TypeString,
+				ExactlyOneOf:     []string{"name", "id"},
+				Optional:         true,
+				ValidateDiagFunc: validateID(),
+				Description:      "Monitor mute rule ID. Either `name` or `id` must be provided.",
+			},
+			// computed values
+			"icon_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_mute_rule", "schema", "description"),
+			},
+			"start_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_mute_rule", "schema", "start_date"),
+			},
+			"duration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_mute_rule", "schema", "duration"),
+			},
+			"filter_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_mute_rule", "schema", "filter_type"),
+			},
+			"filter_per_column": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_column", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_column", "column"),
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_column", "values"),
+						},
+					},
+				},
+			},
+			"filter_per_resource": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_resource", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_resource", "resource", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dataset": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_resource", "resource", "dataset"),
+									},
+									"primary_key_value": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_resource", "resource", "primary_key_value", "description"),
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_resource", "resource", "primary_key_value", "name"),
+												},
+												"value": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_resource", "resource", "primary_key_value", "value"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+		},
+	}
+}
+
+func dataSourceMonitorMuteRuleRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client     = meta.(*observe.Client)
+		name       = data.Get("name").(string)
+		explicitId = data.Get("id").(string)
+	)
+
+	var m *gql.MonitorMuteRule
+	var err error
+
+	if explicitId != "" {
+		m, err = client.GetMonitorMuteRule(ctx, explicitId)
+	} else if name != "" {
+		defer func() {
+			// right now SDK does not report where this error happened,
+			// so we need to provide a little extra context
+			for i := range diags {
+				diags[i].Detail = fmt.Sprintf("failed to read monitor mute rule %q", name)
+			}
+		}()
+
+		implicitId, _ := oid.NewOID(data.Get("workspace").(string))
+		m, err = client.LookupMonitorMuteRule(ctx, implicitId.Id, name)
+	}
+
+	if err != nil {
+		diags = diag.FromErr(err)
+		return
+	}
+	data.SetId(m.Id)
+	return monitorMuteRuleToResourceData(m, data)
+}
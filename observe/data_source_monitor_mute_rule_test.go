@@ -0,0 +1,49 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceMonitorMuteRule(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_monitor_mute_rule" "a" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+						duration  = "1h"
+
+						filter_per_column {
+							column = "host"
+							values = ["a"]
+						}
+					}
+
+					data "observe_monitor_mute_rule" "lookup_by_name" {
+						workspace = data.observe_workspace.default.oid
+						name      = observe_monitor_mute_rule.a.name
+					}
+
+					data "observe_monitor_mute_rule" "lookup_by_id" {
+						id = observe_monitor_mute_rule.a.id
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.observe_monitor_mute_rule.lookup_by_name", "oid", "observe_monitor_mute_rule.a", "oid"),
+					resource.TestCheckResourceAttr("data.observe_monitor_mute_rule.lookup_by_name", "filter_type", "filter_per_column"),
+					resource.TestCheckResourceAttr("data.observe_monitor_mute_rule.lookup_by_id", "name", randomPrefix),
+					resource.TestCheckResourceAttr("data.observe_monitor_mute_rule.lookup_by_id", "filter_per_column.0.values.0", "a"),
+				),
+			},
+		},
+	})
+}
@@ -257,6 +257,49 @@ func dataSourceMonitorV2() *schema.Resource {
 				},
 				Description: descriptions.Get("monitorv2", "schema", "actions", "description"),
 			},
+			"mute_schedule": { // [MonitorV2MuteRule!]
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitorv2", "schema", "mute_schedule", "name"),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitorv2", "schema", "mute_schedule", "mute_description"),
+						},
+						"one_time": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("monitorv2", "schema", "mute_schedule", "one_time", "start_time"),
+									},
+									"end_time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("monitorv2", "schema", "mute_schedule", "one_time", "end_time"),
+									},
+								},
+							},
+							Description: descriptions.Get("monitorv2", "schema", "mute_schedule", "one_time", "description"),
+						},
+						"oid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitorv2", "schema", "mute_schedule", "oid"),
+						},
+					},
+				},
+				Description: descriptions.Get("monitorv2", "schema", "mute_schedule", "description"),
+			},
 		},
 	}
 }
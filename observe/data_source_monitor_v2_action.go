@@ -48,6 +48,16 @@ func dataSourceMonitorV2Action() *schema.Resource {
 				Computed: true,
 				Elem:     monitorV2WebhookActionDatasource(),
 			},
+			"pagerduty": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     monitorV2PagerDutyActionDatasource(),
+			},
+			"slack": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     monitorV2SlackActionDatasource(),
+			},
 			"description": { // String
 				Type:     schema.TypeString,
 				Computed: true,
@@ -123,6 +133,42 @@ func monitorV2WebhookActionDatasource() *schema.Resource {
 	}
 }
 
+func monitorV2PagerDutyActionDatasource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"routing_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"severity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dedup_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func monitorV2SlackActionDatasource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"channel": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
 func monitorV2WebhookHeaderDatasource() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
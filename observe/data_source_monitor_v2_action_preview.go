@@ -0,0 +1,269 @@
+package observe
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+const (
+	monitorV2PreviewAlarmId = "preview"
+	// the sample alarm has fixed times, so that rendered output is stable
+	monitorV2PreviewAlarmStart    = "2024-01-01T00:00:00Z"
+	monitorV2PreviewAlarmDuration = time.Hour
+)
+
+func dataSourceMonitorV2ActionPreview() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("monitor_v2_action_preview", "description"),
+		ReadContext: dataSourceMonitorV2ActionPreviewRead,
+		Schema: map[string]*schema.Schema{
+			"monitor_v2": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeMonitorV2),
+				Description:      descriptions.Get("monitor_v2_action_preview", "schema", "monitor_v2"),
+			},
+			"action": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"action", "email", "webhook"},
+				ValidateDiagFunc: validateOID(oid.TypeMonitorV2Action),
+				Description:      descriptions.Get("monitor_v2_action_preview", "schema", "action"),
+			},
+			"email": {
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{"action", "email", "webhook"},
+				Elem:         monitorV2EmailActionInput(),
+				Description:  descriptions.Get("monitor_v2_action_preview", "schema", "email"),
+			},
+			"webhook": {
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{"action", "email", "webhook"},
+				Elem:         monitorV2WebhookActionInput(),
+				Description:  descriptions.Get("monitor_v2_action_preview", "schema", "webhook"),
+			},
+			"level": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          toSnake(string(gql.MonitorV2AlarmLevelCritical)),
+				ValidateDiagFunc: validateEnums(gql.AllMonitorV2AlarmLevels),
+				Description:      descriptions.Get("monitor_v2_action_preview", "schema", "level"),
+			},
+			"alert_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          toSnake(string(gql.MonitorV2AlertTypeNew)),
+				ValidateDiagFunc: validateEnums(gql.AllMonitorV2AlertTypes),
+				Description:      descriptions.Get("monitor_v2_action_preview", "schema", "alert_type"),
+			},
+			"alarm_start": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          monitorV2PreviewAlarmStart,
+				ValidateDiagFunc: validateTimestamp,
+				Description:      descriptions.Get("monitor_v2_action_preview", "schema", "alarm_start"),
+			},
+			"assert": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: descriptions.Get("monitor_v2_action_preview", "schema", "assert", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"update": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: descriptions.Get("monitor_v2_action_preview", "schema", "assert", "update"),
+						},
+						"golden_file": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("monitor_v2_action_preview", "schema", "assert", "golden_file"),
+						},
+					},
+				},
+			},
+			// computed values
+			"dictionary": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_action_preview", "schema", "dictionary"),
+			},
+			"rendered_email": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        monitorV2EmailActionDatasource(),
+				Description: descriptions.Get("monitor_v2_action_preview", "schema", "rendered_email"),
+			},
+			"rendered_webhook": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        monitorV2WebhookActionDatasource(),
+				Description: descriptions.Get("monitor_v2_action_preview", "schema", "rendered_webhook"),
+			},
+		},
+	}
+}
+
+func dataSourceMonitorV2ActionPreviewRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	monitorId, _ := oid.NewOID(data.Get("monitor_v2").(string))
+
+	// reuse the resource read to convert the monitor and action into inputs
+	monitorData := resourceMonitorV2().Data(nil)
+	monitorData.SetId(monitorId.Id)
+	if diags := resourceMonitorV2Read(ctx, monitorData, meta); diags.HasError() {
+		return diags
+	}
+	monitorInput, diags := newMonitorV2Input(monitorData)
+	if diags.HasError() {
+		return diags
+	}
+
+	actionInput, diags := newMonitorV2ActionPreviewInput(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	start, err := time.Parse(time.RFC3339, data.Get("alarm_start").(string))
+	if err != nil {
+		return diag.Errorf("failed to parse alarm_start: %s", err)
+	}
+
+	alertType := gql.MonitorV2AlertType(toCamel(data.Get("alert_type").(string)))
+	alarmInput := &gql.MonitorV2AlarmInput{
+		Id:             monitorV2PreviewAlarmId,
+		Start:          types.TimeScalar(start),
+		CapturedValues: make([]gql.MonitorV2CapturedValueInput, 0),
+		IsActive:       alertType != gql.MonitorV2AlertTypeEnded,
+		Level:          gql.MonitorV2AlarmLevel(toCamel(data.Get("level").(string))),
+	}
+	if !alarmInput.IsActive {
+		end := types.TimeScalar(start.Add(monitorV2PreviewAlarmDuration))
+		alarmInput.End = &end
+	}
+
+	dictionary, err := client.GetMonitorV2TemplateDictionary(ctx, &alertType, monitorInput, alarmInput)
+	if err != nil {
+		return diag.Errorf("failed to get monitor template dictionary: %s", err.Error())
+	}
+
+	rendered, err := client.RenderMonitorV2Template(ctx, dictionary, actionInput)
+	if err != nil {
+		return diag.Errorf("failed to render monitor action template: %s", err.Error())
+	}
+
+	data.SetId(monitorId.Id)
+
+	if err := data.Set("dictionary", dictionary.String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	renderedEmail := make([]interface{}, 0)
+	if rendered.Email != nil {
+		renderedEmail = monitorV2FlattenEmailAction(rendered.Email.Action)
+	}
+	if err := data.Set("rendered_email", renderedEmail); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	renderedWebhook := make([]interface{}, 0)
+	if rendered.Webhook != nil {
+		renderedWebhook = monitorV2FlattenWebhookAction(rendered.Webhook.Action)
+	}
+	if err := data.Set("rendered_webhook", renderedWebhook); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if v, ok := data.GetOk("assert.0.golden_file"); ok {
+		diags = append(diags, assertMonitorV2RenderedTemplate(rendered, v.(string), data.Get("assert.0.update").(bool))...)
+	}
+	return diags
+}
+
+func newMonitorV2ActionPreviewInput(ctx context.Context, data *schema.ResourceData, meta interface{}) (input *gql.MonitorV2ActionInput, diags diag.Diagnostics) {
+	if v, ok := data.GetOk("action"); ok {
+		actionId, _ := oid.NewOID(v.(string))
+
+		actionData := resourceMonitorV2Action().Data(nil)
+		actionData.SetId(actionId.Id)
+		if diags := resourceMonitorV2ActionRead(ctx, actionData, meta); diags.HasError() {
+			return nil, diags
+		}
+		return newMonitorV2ActionInput(actionData)
+	}
+
+	input = &gql.MonitorV2ActionInput{
+		Name:   monitorV2PreviewAlarmId,
+		Inline: boolPtr(true),
+	}
+
+	if _, ok := data.GetOk("email"); ok {
+		input.Type = gql.MonitorV2ActionTypeEmail
+		input.Email, diags = newMonitorV2EmailActionInput(data, "email.0.")
+	}
+	if _, ok := data.GetOk("webhook"); ok {
+		input.Type = gql.MonitorV2ActionTypeWebhook
+		input.Webhook, diags = newMonitorV2WebhookActionInput(data, "webhook.0.")
+	}
+	return input, diags
+}
+
+func assertMonitorV2RenderedTemplate(rendered *gql.MonitorV2RenderedTemplate, filename string, update bool) diag.Diagnostics {
+	if update {
+		// we indent only when writing to golden file, since we want pretty diffs
+		output, err := json.MarshalIndent(rendered, "", "  ")
+		if err != nil {
+			return diag.Errorf("failed to marshal rendered template: %s", err)
+		}
+		if err := os.WriteFile(filename, append(output, '\n'), os.FileMode(0644)); err != nil {
+			return diag.Errorf("failed to write to golden file: %s", err)
+		}
+		return nil
+	}
+
+	golden, err := os.ReadFile(filename)
+	if err != nil {
+		return diag.Errorf("failed to read golden file: %s", err)
+	}
+
+	// compare as decoded JSON so formatting differences are ignored
+	var expected, actual interface{}
+	if err := json.Unmarshal(golden, &expected); err != nil {
+		return diag.Errorf("failed to parse golden file: %s", err)
+	}
+	output, err := json.Marshal(rendered)
+	if err != nil {
+		return diag.Errorf("failed to marshal rendered template: %s", err)
+	}
+	if err := json.Unmarshal(output, &actual); err != nil {
+		return diag.Errorf("failed to parse rendered template: %s", err)
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		return diag.Errorf("rendered template does not match golden file: %s", diff)
+	}
+	return nil
+}
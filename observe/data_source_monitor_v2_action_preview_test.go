@@ -0,0 +1,155 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveMonitorV2ActionPreview(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(monitorV2ConfigPreamble+`
+					resource "observe_monitor_v2" "first" {
+						workspace = data.observe_workspace.default.oid
+						rule_kind = "count"
+						name = "%[1]s"
+						lookback_time = "30m"
+						inputs = {
+							"test" = observe_datastream.test.dataset
+						}
+						stage {
+							pipeline = <<-EOF
+								filter true
+							EOF
+						}
+						rules {
+							level = "informational"
+							count {
+								compare_values {
+									compare_fn = "greater"
+									value_int64 = [0]
+								}
+							}
+						}
+						scheduling {
+							transform {
+								freshness_goal = "15m"
+							}
+						}
+					}
+
+					resource "observe_monitor_v2_action" "act" {
+						workspace = data.observe_workspace.default.oid
+						type = "webhook"
+						webhook {
+							body = "{{monitor.name}}"
+							url = "https://example.com/"
+							method = "post"
+						}
+						name = "%[1]s"
+					}
+
+					data "observe_monitor_v2_action_preview" "email" {
+						monitor_v2 = observe_monitor_v2.first.oid
+						level = "informational"
+						email {
+							subject = "{{monitor.name}}"
+							addresses = ["test@observeinc.com"]
+						}
+					}
+
+					data "observe_monitor_v2_action_preview" "webhook" {
+						monitor_v2 = observe_monitor_v2.first.oid
+						action = observe_monitor_v2_action.act.oid
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.observe_monitor_v2_action_preview.email", "dictionary"),
+					resource.TestCheckResourceAttr("data.observe_monitor_v2_action_preview.email", "rendered_email.0.subject", randomPrefix),
+					resource.TestCheckResourceAttr("data.observe_monitor_v2_action_preview.email", "rendered_webhook.#", "0"),
+					resource.TestCheckResourceAttr("data.observe_monitor_v2_action_preview.webhook", "rendered_webhook.0.body", randomPrefix),
+					resource.TestCheckResourceAttr("data.observe_monitor_v2_action_preview.webhook", "rendered_webhook.0.url", "https://example.com/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObserveMonitorV2ActionPreviewPagerDuty(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(monitorV2ConfigPreamble+`
+					resource "observe_monitor_v2" "first" {
+						workspace = data.observe_workspace.default.oid
+						rule_kind = "count"
+						name = "%[1]s"
+						lookback_time = "30m"
+						inputs = {
+							"test" = observe_datastream.test.dataset
+						}
+						stage {
+							pipeline = <<-EOF
+								filter true
+							EOF
+						}
+						rules {
+							level = "critical"
+							count {
+								compare_values {
+									compare_fn = "greater"
+									value_int64 = [0]
+								}
+							}
+						}
+						scheduling {
+							transform {
+								freshness_goal = "15m"
+							}
+						}
+					}
+
+					resource "observe_monitor_v2_action" "pagerduty" {
+						workspace = data.observe_workspace.default.oid
+						type = "pager_duty"
+						pagerduty {
+							routing_key = "R0UT1NGK3Y"
+							severity = "warning"
+						}
+						name = "%[1]s"
+					}
+
+					data "observe_monitor_v2_action_preview" "new" {
+						monitor_v2 = observe_monitor_v2.first.oid
+						action = observe_monitor_v2_action.pagerduty.oid
+						alert_type = "new"
+					}
+
+					data "observe_monitor_v2_action_preview" "ended" {
+						monitor_v2 = observe_monitor_v2.first.oid
+						action = observe_monitor_v2_action.pagerduty.oid
+						alert_type = "ended"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_monitor_v2_action_preview.new", "rendered_webhook.0.body",
+						fmt.Sprintf(`{"routing_key":"R0UT1NGK3Y","event_action":"trigger","payload":{"summary":"%s","source":"observe","severity":"warning"}}`, randomPrefix)),
+					resource.TestCheckResourceAttr("data.observe_monitor_v2_action_preview.ended", "rendered_webhook.0.body",
+						fmt.Sprintf(`{"routing_key":"R0UT1NGK3Y","event_action":"trigger","payload":{"summary":"%s","source":"observe","severity":"warning"}}`, randomPrefix)),
+				),
+			},
+		},
+	})
+}
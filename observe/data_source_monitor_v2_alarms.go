@@ -0,0 +1,272 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceMonitorV2Alarms() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("monitor_v2_alarms", "description"),
+
+		ReadContext: dataSourceMonitorV2AlarmsRead,
+
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"monitors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeMonitorV2),
+				},
+				Description: descriptions.Get("monitor_v2_alarms", "schema", "monitors"),
+			},
+			"levels": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateEnums(gql.AllMonitorV2AlarmLevels),
+				},
+				Description: descriptions.Get("monitor_v2_alarms", "schema", "levels"),
+			},
+			"active_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions.Get("monitor_v2_alarms", "schema", "active_only"),
+			},
+			"starting_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateTimestamp,
+				Description:      descriptions.Get("monitor_v2_alarms", "schema", "starting_at"),
+			},
+			"ending_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateTimestamp,
+				Description:      descriptions.Get("monitor_v2_alarms", "schema", "ending_at"),
+			},
+			// computed values
+			"alarms": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "id"),
+						},
+						"monitor": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "monitor"),
+						},
+						"monitor_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "monitor_name"),
+						},
+						"monitor_version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "monitor_version"),
+						},
+						"level": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "level"),
+						},
+						"active": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "active"),
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "start_time"),
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "end_time"),
+						},
+						"grouping_hash": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "grouping_hash"),
+						},
+						"groupings": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "groupings"),
+						},
+						"captured_values": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "captured_values", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "captured_values", "column"),
+									},
+									"path": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "captured_values", "path"),
+									},
+									"types": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "captured_values", "types"),
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("monitor_v2_alarms", "schema", "alarms", "captured_values", "value"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitorV2AlarmsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client      = meta.(*observe.Client)
+		workspaceId = data.Get("workspace").(string)
+		startingAt  = data.Get("starting_at").(string)
+		endingAt    = data.Get("ending_at").(string)
+	)
+
+	workspace, _ := oid.NewOID(workspaceId)
+
+	var monitorIds []string
+	for _, v := range data.Get("monitors").([]interface{}) {
+		monitor, _ := oid.NewOID(v.(string))
+		monitorIds = append(monitorIds, monitor.Id)
+	}
+
+	var levels []gql.MonitorV2AlarmLevel
+	for _, v := range data.Get("levels").([]interface{}) {
+		levels = append(levels, gql.MonitorV2AlarmLevel(toCamel(v.(string))))
+	}
+
+	var active *bool
+	if data.Get("active_only").(bool) {
+		active = boolPtr(true)
+	}
+
+	var start, end *types.TimeScalar
+	if startingAt != "" {
+		t, _ := time.Parse(time.RFC3339, startingAt)
+		start = (*types.TimeScalar)(&t)
+	}
+	if endingAt != "" {
+		t, _ := time.Parse(time.RFC3339, endingAt)
+		end = (*types.TimeScalar)(&t)
+	}
+
+	result, err := client.SearchMonitorV2Alarms(ctx, workspace.Id, monitorIds, start, end, levels, active)
+	if err != nil {
+		return diag.Errorf("failed to read monitor alarms: %s", err.Error())
+	}
+
+	alarms := make([]interface{}, 0, len(result))
+	for _, alarm := range result {
+		alarms = append(alarms, monitorV2FlattenAlarm(alarm))
+	}
+
+	if err := data.Set("alarms", alarms); err != nil {
+		return diag.FromErr(err)
+	}
+
+	key := fmt.Sprintf("%s/%v/%v/%v/%s/%s", workspace.Id, monitorIds, levels, active != nil, startingAt, endingAt)
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(key))), 10))
+	return diags
+}
+
+func monitorV2FlattenAlarm(gqlAlarm gql.MonitorV2Alarm) interface{} {
+	alarm := map[string]interface{}{
+		"id":              gqlAlarm.Id,
+		"monitor_version": int(gqlAlarm.MonitorVersion),
+		"level":           toSnake(string(gqlAlarm.Level)),
+		"active":          gqlAlarm.IsActive,
+		"start_time":      gqlAlarm.Start.String(),
+		"grouping_hash":   gqlAlarm.GroupingHash.String(),
+	}
+	if gqlAlarm.End != nil {
+		alarm["end_time"] = gqlAlarm.End.String()
+	}
+	if gqlAlarm.Monitor != nil {
+		alarm["monitor"] = oid.MonitorV2Oid(gqlAlarm.Monitor.Id).String()
+		alarm["monitor_name"] = gqlAlarm.Monitor.Name
+	}
+
+	groupings := make(map[string]interface{})
+	capturedValues := make([]interface{}, 0, len(gqlAlarm.CapturedValues))
+	for _, cv := range gqlAlarm.CapturedValues {
+		var name, path, value string
+		switch {
+		case cv.Column.LinkColumn != nil:
+			name = cv.Column.LinkColumn.Name
+		case cv.Column.ColumnPath != nil:
+			name = cv.Column.ColumnPath.Name
+			if cv.Column.ColumnPath.Path != nil {
+				path = *cv.Column.ColumnPath.Path
+			}
+		}
+		if cv.Value != nil {
+			value = *cv.Value
+		}
+
+		captureTypes := make([]string, 0, len(cv.Types))
+		for _, t := range cv.Types {
+			captureTypes = append(captureTypes, toSnake(string(t)))
+			if t == gql.MonitorV2CapturedValueTypeGroupby {
+				groupings[strings.Trim(name+"."+path, ".")] = value
+			}
+		}
+
+		capturedValues = append(capturedValues, map[string]interface{}{
+			"column": name,
+			"path":   path,
+			"types":  captureTypes,
+			"value":  value,
+		})
+	}
+	alarm["groupings"] = groupings
+	alarm["captured_values"] = capturedValues
+
+	return alarm
+}
@@ -0,0 +1,64 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveMonitorV2Alarms(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(monitorV2ConfigPreamble+`
+					resource "observe_monitor_v2" "first" {
+						workspace = data.observe_workspace.default.oid
+						rule_kind = "count"
+						name = "%[1]s"
+						lookback_time = "30m"
+						inputs = {
+							"test" = observe_datastream.test.dataset
+						}
+						stage {
+							pipeline = <<-EOF
+								filter true
+							EOF
+						}
+						rules {
+							level = "critical"
+							count {
+								compare_values {
+									compare_fn = "greater"
+									value_int64 = [0]
+								}
+							}
+						}
+						scheduling {
+							transform {
+								freshness_goal = "15m"
+							}
+						}
+					}
+
+					data "observe_monitor_v2_alarms" "first" {
+						workspace   = data.observe_workspace.default.oid
+						monitors    = [observe_monitor_v2.first.oid]
+						levels      = ["critical"]
+						active_only = true
+						starting_at = "2024-01-01T00:00:00Z"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.observe_monitor_v2_alarms.first", "id"),
+					resource.TestCheckResourceAttrSet("data.observe_monitor_v2_alarms.first", "alarms.#"),
+				),
+			},
+		},
+	})
+}
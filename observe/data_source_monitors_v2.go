@@ -0,0 +1,71 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceMonitorsV2() *schema.Resource {
+	s := listFilterSchema("monitors_v2")
+	s["workspace"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+		Description:      descriptions.Get("common", "schema", "workspace"),
+	}
+	// computed values
+	s["monitors"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: descriptions.Get("monitors_v2", "schema", "monitors"),
+		Elem: &schema.Resource{
+			Schema: listItemSchema("monitors_v2"),
+		},
+	}
+
+	return &schema.Resource{
+		Description: descriptions.Get("monitors_v2", "description"),
+
+		ReadContext: dataSourceMonitorsV2Read,
+
+		Schema: s,
+	}
+}
+
+func dataSourceMonitorsV2Read(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client       = meta.(*observe.Client)
+		workspaceOid = maybeOID(data.GetOk("workspace"))
+	)
+
+	filters, diags := newListFilters(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	result, err := client.ListMonitorV2(ctx, &workspaceOid.Id)
+	if err != nil {
+		return diag.Errorf("failed to list monitors: %s", err.Error())
+	}
+
+	monitors := make([]interface{}, 0, len(result))
+	for _, m := range result {
+		if !filters.match(m.Name, m.FolderId, m.ManagedById) {
+			continue
+		}
+		monitors = append(monitors, flattenListItem(m.Id, oid.MonitorV2Oid(m.Id), m.Name, m.Description, m.WorkspaceId, m.FolderId, m.ManagedById))
+	}
+	sortListItems(monitors)
+
+	if err := data.Set("monitors", monitors); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(listDataSourceId(workspaceOid.Id, filters.folderId, filters.managedById, filters.namePrefix))
+	return diags
+}
@@ -0,0 +1,56 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceMonitorsV2(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(monitorV2ConfigPreamble+`
+					resource "observe_monitor_v2" "first" {
+						workspace = data.observe_workspace.default.oid
+						rule_kind = "count"
+						name = "%[1]s-first"
+						lookback_time = "30m"
+						inputs = {
+							"test" = observe_datastream.test.dataset
+						}
+						stage {
+							pipeline = "filter true"
+						}
+						rules {
+							level = "informational"
+							count {
+								compare_values {
+									compare_fn = "greater"
+									value_int64 = [0]
+								}
+							}
+						}
+					}
+
+					data "observe_monitors_v2" "prefix" {
+						workspace   = data.observe_workspace.default.oid
+						name_prefix = "%[1]s-"
+						depends_on  = [observe_monitor_v2.first]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_monitors_v2.prefix", "monitors.#", "1"),
+					resource.TestCheckResourceAttrPair("data.observe_monitors_v2.prefix", "monitors.0.oid", "observe_monitor_v2.first", "oid"),
+					resource.TestCheckResourceAttr("data.observe_monitors_v2.prefix", "monitors.0.name", randomPrefix+"-first"),
+				),
+			},
+		},
+	})
+}
@@ -161,7 +161,14 @@ func getOutputStagesCount(stages []Stage) int {
 	return c
 }
 
-func newQuery(data *schema.ResourceData) (*gql.MultiStageQueryInput, diag.Diagnostics) {
+// queryData is implemented by both schema.ResourceData and schema.ResourceDiff,
+// allowing queries to be built at plan time
+type queryData interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}
+
+func newQuery(data queryData) (*gql.MultiStageQueryInput, diag.Diagnostics) {
 	inputIds := make(map[string]string)
 	for k, v := range data.Get("inputs").(map[string]interface{}) {
 		is, _ := oid.NewOID(v.(string))
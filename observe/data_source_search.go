@@ -0,0 +1,210 @@
+package observe
+
+import (
+	"context"
+	"hash/crc32"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+// searchKinds maps each searchable kind to the type of OID returned in
+// results. Metrics are not objects, so they are identified by their dataset.
+var searchKinds = map[string]oid.Type{
+	"dataset":   oid.TypeDataset,
+	"dashboard": oid.TypeDashboard,
+	"worksheet": oid.TypeWorksheet,
+	"metric":    oid.TypeDataset,
+}
+
+func dataSourceSearch() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("search", "description"),
+
+		ReadContext: dataSourceSearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"kind": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateStringInSlice([]string{"dataset", "dashboard", "worksheet", "metric"}, false),
+				Description:      descriptions.Get("search", "schema", "kind"),
+			},
+			"workspace": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("search", "schema", "name"),
+			},
+			"name_regex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateRegexp,
+				Description:      descriptions.Get("search", "schema", "name_regex"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("search", "schema", "folder"),
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("search", "schema", "label"),
+			},
+			// computed values
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("search", "schema", "results"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "id"),
+						},
+						"oid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "oid"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("search", "schema", "results_name"),
+						},
+						"folder": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("search", "schema", "results_folder"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSearchRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client          = meta.(*observe.Client)
+		kind            = data.Get("kind").(string)
+		workspaceOid, _ = oid.NewOID(data.Get("workspace").(string))
+		name            = data.Get("name").(string)
+		nameRegex       = data.Get("name_regex").(string)
+		folder          = data.Get("folder").(string)
+		label           = data.Get("label").(string)
+	)
+
+	var folderId string
+	if folder != "" {
+		folderOid, _ := oid.NewOID(folder)
+		if folderOid.Version == nil {
+			return diag.Errorf("folder %q does not include a folder id", folder)
+		}
+		folderId = *folderOid.Version
+	}
+
+	var names, labels []string
+	if name != "" {
+		names = []string{name}
+	}
+	if label != "" {
+		if kind != "dataset" && kind != "metric" {
+			return diag.Errorf("label filter is not supported for %ss", kind)
+		}
+		labels = []string{label}
+	}
+
+	var (
+		result []gql.SearchedObject
+		err    error
+	)
+	switch kind {
+	case "dataset":
+		result, err = client.SearchDatasets(ctx, workspaceOid.Id, names, labels)
+	case "metric":
+		var metrics []gql.SearchedMetric
+		metrics, err = client.SearchMetrics(ctx, workspaceOid.Id, name, labels)
+		for _, m := range metrics {
+			result = append(result, gql.SearchedObject{
+				Id:          m.DatasetId,
+				Name:        m.Name,
+				WorkspaceId: m.WorkspaceId,
+				FolderId:    m.FolderId,
+			})
+		}
+	case "dashboard", "worksheet":
+		terms := gql.DWSearchInput{
+			Name:        names,
+			WorkspaceId: []string{workspaceOid.Id},
+		}
+		if folderId != "" {
+			terms.FolderId = []string{folderId}
+		}
+		if kind == "dashboard" {
+			result, err = client.SearchDashboards(ctx, terms)
+		} else {
+			result, err = client.SearchWorksheets(ctx, terms)
+		}
+	}
+	if err != nil {
+		return diag.Errorf("failed to search %ss: %s", kind, err.Error())
+	}
+
+	var re *regexp.Regexp
+	if nameRegex != "" {
+		re = regexp.MustCompile(nameRegex)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name == result[j].Name {
+			return result[i].Id < result[j].Id
+		}
+		return result[i].Name < result[j].Name
+	})
+
+	results := make([]interface{}, 0, len(result))
+	for _, object := range result {
+		// search matches loosely, so apply filters to the results as well
+		if name != "" && !strings.Contains(strings.ToLower(object.Name), strings.ToLower(name)) {
+			continue
+		}
+		if re != nil && !re.MatchString(object.Name) {
+			continue
+		}
+		if folderId != "" && object.FolderId != folderId {
+			continue
+		}
+		objectOid := oid.OID{Id: object.Id, Type: searchKinds[kind]}
+		results = append(results, map[string]interface{}{
+			"id":     object.Id,
+			"oid":    objectOid.String(),
+			"name":   object.Name,
+			"folder": oid.FolderOid(object.FolderId, object.WorkspaceId).String(),
+		})
+	}
+
+	if err := data.Set("results", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	key := strings.Join([]string{kind, workspaceOid.Id, name, nameRegex, folder, label}, "/")
+	data.SetId(strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(key))), 10))
+	return diags
+}
@@ -0,0 +1,97 @@
+package observe
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceSearch(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_dashboard" "first" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-first"
+						stages    = "[]"
+					}
+
+					resource "observe_dashboard" "second" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-second"
+						stages    = "[]"
+					}
+
+					data "observe_search" "all" {
+						kind       = "dashboard"
+						workspace  = data.observe_workspace.default.oid
+						name       = "%[1]s"
+						depends_on = [observe_dashboard.first, observe_dashboard.second]
+					}
+
+					data "observe_search" "regex" {
+						kind       = "dashboard"
+						workspace  = data.observe_workspace.default.oid
+						name       = "%[1]s"
+						name_regex = "-sec.*$"
+						depends_on = [observe_dashboard.first, observe_dashboard.second]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_search.all", "results.#", "2"),
+					resource.TestCheckResourceAttr("data.observe_search.all", "results.0.name", randomPrefix+"-first"),
+					resource.TestCheckResourceAttrPair("data.observe_search.all", "results.0.oid", "observe_dashboard.first", "oid"),
+					resource.TestCheckResourceAttrSet("data.observe_search.all", "results.0.folder"),
+					resource.TestCheckResourceAttr("data.observe_search.regex", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.observe_search.regex", "results.0.name", randomPrefix+"-second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObserveSourceSearchInvalidRegexp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configPreamble + `
+					data "observe_search" "invalid" {
+						kind       = "dataset"
+						workspace  = data.observe_workspace.default.oid
+						name_regex = "("
+					}
+				`,
+				ExpectError: regexp.MustCompile("not a valid regular expression"),
+			},
+		},
+	})
+}
+
+func TestAccObserveSourceSearchLabelUnsupported(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configPreamble + `
+					data "observe_search" "invalid" {
+						kind      = "dashboard"
+						workspace = data.observe_workspace.default.oid
+						label     = "service.name"
+					}
+				`,
+				ExpectError: regexp.MustCompile("label filter is not supported for dashboards"),
+			},
+		},
+	})
+}
@@ -2,24 +2,26 @@ package observe
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/binding"
 	gql "github.com/observeinc/terraform-provider-observe/client/meta"
 	oid "github.com/observeinc/terraform-provider-observe/client/oid"
 )
 
 func dataSourceTerraform() *schema.Resource {
 	return &schema.Resource{
-		Description: "Generates Terraform configuration for a given resource in Observe. Datasets, monitors, and dashboards are supported.",
+		Description: "Generates Terraform configuration for a given resource in Observe. Datasets, monitors, dashboards, worksheets, monitors v2, monitor v2 actions, pollers, datastreams and RBAC statements are supported.",
 		ReadContext: dataSourceTerraformRead,
 		Schema: map[string]*schema.Schema{
 			"target": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateDiagFunc: validateOID(oid.TypeDataset, oid.TypeMonitor, oid.TypeDashboard),
-				Description:      "The OID of the target object, for which Terraform configuration will be generated. This can be a dataset, monitor, or dashboard.",
+				ValidateDiagFunc: validateOID(oid.TypeDataset, oid.TypeMonitor, oid.TypeDashboard, oid.TypeWorksheet, oid.TypeMonitorV2, oid.TypeMonitorV2Action, oid.TypePoller, oid.TypeDatastream, oid.TypeRbacStatement),
+				Description:      "The OID of the target object, for which Terraform configuration will be generated.",
 			},
 			"resource": {
 				Type:     schema.TypeString,
@@ -37,6 +39,11 @@ func dataSourceTerraform() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"bindings": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON encoded bindings for objects referenced by the generated configuration. Only set when `export_object_bindings` is enabled and configuration is generated by the provider rather than the API.",
+			},
 		},
 	}
 }
@@ -47,17 +54,36 @@ func dataSourceTerraformRead(ctx context.Context, data *schema.ResourceData, met
 		target, _ = oid.NewOID(data.Get("target").(string))
 	)
 
-	var objType gql.TerraformObjectType
+	var (
+		r   *gql.TerraformDefinition
+		err error
+	)
+
 	switch target.Type {
 	case oid.TypeDataset:
-		objType = gql.TerraformObjectTypeDataset
+		r, err = client.GetTerraform(ctx, target.Id, gql.TerraformObjectTypeDataset)
 	case oid.TypeMonitor:
-		objType = gql.TerraformObjectTypeMonitor
+		r, err = client.GetTerraform(ctx, target.Id, gql.TerraformObjectTypeMonitor)
 	case oid.TypeDashboard:
-		objType = gql.TerraformObjectTypeDashboard
+		r, err = client.GetTerraform(ctx, target.Id, gql.TerraformObjectTypeDashboard)
+	default:
+		// the API cannot generate configuration for other types, do so ourselves
+		var gen *binding.Generator
+		r, gen, err = exportTerraform(ctx, client, target, nil)
+		if err == nil && gen.Enabled {
+			bindings := make(map[string]interface{})
+			if err := gen.InsertBindingsObject(bindings); err != nil {
+				return diag.FromErr(err)
+			}
+			b, err := json.Marshal(bindings["bindings"])
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := data.Set("bindings", string(b)); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
 	}
-
-	r, err := client.GetTerraform(ctx, target.Id, objType)
 	if err != nil {
 		diags = diag.FromErr(err)
 		return
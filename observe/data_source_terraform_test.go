@@ -2,6 +2,7 @@ package observe
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -47,3 +48,33 @@ func TestAccObserveSourceDatasetTerraform(t *testing.T) {
 		},
 	})
 }
+
+func TestAccObserveSourceDatastreamTerraform(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_datastream" "a" {
+						workspace   = data.observe_workspace.default.oid
+						name        = "%[1]s"
+						description = "exported"
+					}
+
+					data "observe_terraform" "datastream" {
+						target = observe_datastream.a.oid
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.observe_terraform.datastream", "import_id", "observe_datastream.a", "id"),
+					resource.TestMatchResourceAttr("data.observe_terraform.datastream", "resource", regexp.MustCompile(`resource "observe_datastream" "`+randomPrefix+`" {`)),
+					resource.TestMatchResourceAttr("data.observe_terraform.datastream", "resource", regexp.MustCompile(`description = "exported"`)),
+					resource.TestMatchResourceAttr("data.observe_terraform.datastream", "data_source", regexp.MustCompile(`data "observe_datastream"`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,210 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+// datasetLineageObject is an object related to a dataset
+type datasetLineageObject struct {
+	Oid  string
+	Name string
+}
+
+// datasetLineage contains the objects upstream and downstream of a dataset.
+// Each list is sorted by OID.
+type datasetLineage struct {
+	UpstreamDatasets     []datasetLineageObject
+	DownstreamDatasets   []datasetLineageObject
+	DownstreamMonitors   []datasetLineageObject
+	DownstreamDashboards []datasetLineageObject
+	DownstreamShares     []datasetLineageObject
+}
+
+// Downstream returns all downstream objects
+func (l *datasetLineage) Downstream() (result []datasetLineageObject) {
+	result = append(result, l.DownstreamDatasets...)
+	result = append(result, l.DownstreamMonitors...)
+	result = append(result, l.DownstreamDashboards...)
+	return append(result, l.DownstreamShares...)
+}
+
+// datasetGraph maps dataset IDs to adjacent dataset IDs
+type datasetGraph map[string][]string
+
+// walk returns the IDs of all datasets reachable from id within depth hops,
+// excluding id itself. A negative depth is unlimited.
+func (g datasetGraph) walk(id string, depth int) []string {
+	seen := map[string]bool{id: true}
+	frontier := []string{id}
+	var result []string
+	for i := 0; (depth < 0 || i < depth) && len(frontier) > 0; i++ {
+		var next []string
+		for _, cur := range frontier {
+			for _, adj := range g[cur] {
+				if seen[adj] {
+					continue
+				}
+				seen[adj] = true
+				next = append(next, adj)
+				result = append(result, adj)
+			}
+		}
+		frontier = next
+	}
+	return result
+}
+
+// getDatasetLineage returns the objects within depth hops of a dataset.
+// Monitors, dashboards and shares are one hop further away than the dataset
+// they read from. A negative depth is unlimited. Only objects in the workspace
+// of the dataset are considered.
+func getDatasetLineage(ctx context.Context, client *observe.Client, workspaceId string, id string, depth int) (*datasetLineage, error) {
+	datasets, err := client.ListDatasetInputs(ctx, workspaceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list datasets: %w", err)
+	}
+
+	names := make(map[string]string, len(datasets))
+	upstream, downstream := make(datasetGraph), make(datasetGraph)
+	for _, ds := range datasets {
+		names[ds.Id] = ds.Name
+		for _, input := range ds.Inputs {
+			upstream[ds.Id] = append(upstream[ds.Id], input.DatasetId)
+			downstream[input.DatasetId] = append(downstream[input.DatasetId], ds.Id)
+		}
+	}
+
+	datasetObjects := func(ids []string) []datasetLineageObject {
+		result := make([]datasetLineageObject, 0, len(ids))
+		for _, id := range ids {
+			result = append(result, datasetLineageObject{Oid: oid.DatasetOid(id).String(), Name: names[id]})
+		}
+		return result
+	}
+
+	lineage := &datasetLineage{
+		UpstreamDatasets:   datasetObjects(upstream.walk(id, depth)),
+		DownstreamDatasets: datasetObjects(downstream.walk(id, depth)),
+	}
+
+	consumerDepth := depth
+	if depth > 0 {
+		consumerDepth = depth - 1
+	}
+	consumed := map[string]bool{id: true}
+	for _, dsid := range downstream.walk(id, consumerDepth) {
+		consumed[dsid] = true
+	}
+
+	monitors, err := client.ListMonitors(ctx, workspaceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list monitors: %w", err)
+	}
+	for _, m := range monitors {
+		if stagesConsumeDataset(m.Query.Stages, consumed) {
+			lineage.DownstreamMonitors = append(lineage.DownstreamMonitors, datasetLineageObject{Oid: oid.MonitorOid(m.Id).String(), Name: m.Name})
+		}
+	}
+
+	monitorsV2, err := client.ListMonitorV2(ctx, &workspaceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list monitors: %w", err)
+	}
+	for _, m := range monitorsV2 {
+		if stagesConsumeDataset(m.Definition.InputQuery.Stages, consumed) {
+			lineage.DownstreamMonitors = append(lineage.DownstreamMonitors, datasetLineageObject{Oid: oid.MonitorV2Oid(m.Id).String(), Name: m.Name})
+		}
+	}
+
+	consumedIds := make([]string, 0, len(consumed))
+	for dsid := range consumed {
+		consumedIds = append(consumedIds, dsid)
+	}
+	sort.Strings(consumedIds)
+
+	dashboards, err := client.SearchDashboards(ctx, gql.DWSearchInput{
+		WorkspaceId: []string{workspaceId},
+		Input:       []gql.InputSearchInput{{Id: consumedIds}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search dashboards: %w", err)
+	}
+	for _, d := range dashboards {
+		lineage.DownstreamDashboards = append(lineage.DownstreamDashboards, datasetLineageObject{Oid: oid.DashboardOid(d.Id).String(), Name: d.Name})
+	}
+
+	shares, err := client.ListDatasetOutboundShares(ctx, &workspaceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dataset outbound shares: %w", err)
+	}
+	for _, s := range shares {
+		if consumed[s.DatasetID] {
+			lineage.DownstreamShares = append(lineage.DownstreamShares, datasetLineageObject{Oid: s.Oid().String(), Name: s.Name})
+		}
+	}
+
+	for _, objects := range [][]datasetLineageObject{
+		lineage.UpstreamDatasets,
+		lineage.DownstreamDatasets,
+		lineage.DownstreamMonitors,
+		lineage.DownstreamDashboards,
+		lineage.DownstreamShares,
+	} {
+		sort.Slice(objects, func(i, j int) bool { return objects[i].Oid < objects[j].Oid })
+	}
+	return lineage, nil
+}
+
+func stagesConsumeDataset(stages []gql.StageQuery, datasets map[string]bool) bool {
+	for _, stage := range stages {
+		for _, input := range stage.Input {
+			if input.DatasetId != nil && datasets[*input.DatasetId] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkDatasetDeletionProtection fails if deletion protection is enabled for
+// a dataset which still has downstream dependents
+func checkDatasetDeletionProtection(ctx context.Context, client *observe.Client, data *schema.ResourceData) diag.Diagnostics {
+	if !data.Get("deletion_protection").(bool) || client.Flags[flagForceDelete] {
+		return nil
+	}
+
+	workspaceOid, _ := oid.NewOID(data.Get("workspace").(string))
+	lineage, err := getDatasetLineage(ctx, client, workspaceOid.Id, data.Id(), -1)
+	if err != nil {
+		return diag.Errorf("failed to check dataset dependents: %s", err.Error())
+	}
+
+	dependents := lineage.Downstream()
+	if len(dependents) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(dependents))
+	for _, o := range dependents {
+		lines = append(lines, fmt.Sprintf("  - %s (%s)", o.Name, o.Oid))
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("failed to delete dataset [id=%s]: deletion protection is enabled and the dataset has dependents", data.Id()),
+		Detail: fmt.Sprintf("The following objects depend on this dataset:\n%s\n\n"+
+			"Remove them first, set deletion_protection to false, or set the provider flag %q to delete the dataset anyway.",
+			strings.Join(lines, "\n"), flagForceDelete),
+		AttributePath: cty.GetAttrPath("deletion_protection"),
+	}}
+}
@@ -0,0 +1,516 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/binding"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+// exportReferenceAddresses maps the kinds of objects which may be referenced
+// from exported configuration to the terraform address they are exported under
+var exportReferenceAddresses = map[binding.Kind]string{
+	binding.KindDataset:   "observe_dataset",
+	binding.KindWorksheet: "observe_worksheet",
+	binding.KindWorkspace: "data.observe_workspace",
+}
+
+// exportObject is an object to be written to an exported configuration file
+type exportObject struct {
+	id     string
+	tfName string
+	// kind is set for objects which other exported objects may reference
+	kind binding.Kind
+}
+
+// exportRenderer generates configuration for an object which has already been
+// fetched, binding references to other objects through gen
+type exportRenderer func(gen *binding.Generator) (*gql.TerraformDefinition, error)
+
+// exportFile collects the objects of a single type into one configuration file
+type exportFile struct {
+	filename     string
+	resourceType string
+	// list returns all objects of this type in the workspace
+	list func(ctx context.Context, client *observe.Client, workspace *gql.Workspace, cache *binding.ResourceCache) ([]exportObject, error)
+	// fetch retrieves a single object, returning a renderer for it
+	fetch func(ctx context.Context, client *observe.Client, id string) (exportRenderer, error)
+}
+
+var exportFiles = []exportFile{
+	{
+		filename:     "datasets.tf",
+		resourceType: "observe_dataset",
+		list: func(ctx context.Context, client *observe.Client, workspace *gql.Workspace, cache *binding.ResourceCache) ([]exportObject, error) {
+			datasets, err := client.SearchDatasets(ctx, workspace.Id, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			return cachedExportObjects(cache, binding.KindDataset, len(datasets), func(i int) string { return datasets[i].Id }), nil
+		},
+		fetch: apiExporter(gql.TerraformObjectTypeDataset),
+	},
+	{
+		filename:     "worksheets.tf",
+		resourceType: "observe_worksheet",
+		list: func(ctx context.Context, client *observe.Client, workspace *gql.Workspace, cache *binding.ResourceCache) ([]exportObject, error) {
+			worksheets, err := client.ListWorksheetIdLabelOnly(ctx, workspace.Id)
+			if err != nil {
+				return nil, err
+			}
+			return cachedExportObjects(cache, binding.KindWorksheet, len(worksheets), func(i int) string { return worksheets[i].Id }), nil
+		},
+		fetch: clientExporter(oid.WorksheetOid),
+	},
+	{
+		filename:     "dashboards.tf",
+		resourceType: "observe_dashboard",
+		list: func(ctx context.Context, client *observe.Client, workspace *gql.Workspace, _ *binding.ResourceCache) ([]exportObject, error) {
+			dashboards, err := client.SearchDashboards(ctx, gql.DWSearchInput{WorkspaceId: []string{workspace.Id}})
+			if err != nil {
+				return nil, err
+			}
+			names := make(exportNames)
+			objects := make([]exportObject, 0, len(dashboards))
+			for _, d := range dashboards {
+				objects = append(objects, exportObject{id: d.Id, tfName: names.add(d.Name)})
+			}
+			return objects, nil
+		},
+		fetch: apiExporter(gql.TerraformObjectTypeDashboard),
+	},
+	{
+		filename:     "datastreams.tf",
+		resourceType: "observe_datastream",
+		list: func(ctx context.Context, client *observe.Client, workspace *gql.Workspace, _ *binding.ResourceCache) ([]exportObject, error) {
+			datastreams, err := client.ListDatastreams(ctx, workspace.Id)
+			if err != nil {
+				return nil, err
+			}
+			names := make(exportNames)
+			objects := make([]exportObject, 0, len(datastreams))
+			for _, d := range datastreams {
+				objects = append(objects, exportObject{id: d.Id, tfName: names.add(d.Name)})
+			}
+			return objects, nil
+		},
+		fetch: clientExporter(oid.DatastreamOid),
+	},
+	{
+		filename:     "pollers.tf",
+		resourceType: "observe_poller",
+		list: func(ctx context.Context, client *observe.Client, workspace *gql.Workspace, _ *binding.ResourceCache) ([]exportObject, error) {
+			pollers, err := client.ListPollers(ctx, workspace.Id)
+			if err != nil {
+				return nil, err
+			}
+			names := make(exportNames)
+			objects := make([]exportObject, 0, len(pollers))
+			for _, p := range pollers {
+				label := "poller_" + p.Id
+				if name := p.Config.GetName(); name != nil && *name != "" {
+					label = *name
+				}
+				objects = append(objects, exportObject{id: p.Id, tfName: names.add(label)})
+			}
+			return objects, nil
+		},
+		fetch: clientExporter(oid.PollerOid),
+	},
+	{
+		filename:     "monitors.tf",
+		resourceType: "observe_monitor",
+		list: func(ctx context.Context, client *observe.Client, workspace *gql.Workspace, _ *binding.ResourceCache) ([]exportObject, error) {
+			monitors, err := client.ListMonitors(ctx, workspace.Id)
+			if err != nil {
+				return nil, err
+			}
+			names := make(exportNames)
+			objects := make([]exportObject, 0, len(monitors))
+			for _, m := range monitors {
+				objects = append(objects, exportObject{id: m.Id, tfName: names.add(m.Name)})
+			}
+			return objects, nil
+		},
+		fetch: apiExporter(gql.TerraformObjectTypeMonitor),
+	},
+	{
+		filename:     "monitors_v2.tf",
+		resourceType: "observe_monitor_v2",
+		list: func(ctx context.Context, client *observe.Client, workspace *gql.Workspace, _ *binding.ResourceCache) ([]exportObject, error) {
+			monitors, err := client.ListMonitorV2(ctx, &workspace.Id)
+			if err != nil {
+				return nil, err
+			}
+			names := make(exportNames)
+			objects := make([]exportObject, 0, len(monitors))
+			for _, m := range monitors {
+				objects = append(objects, exportObject{id: m.Id, tfName: names.add(m.Name)})
+			}
+			return objects, nil
+		},
+		fetch: clientExporter(oid.MonitorV2Oid),
+	},
+	{
+		filename:     "monitor_v2_actions.tf",
+		resourceType: "observe_monitor_v2_action",
+		list: func(ctx context.Context, client *observe.Client, workspace *gql.Workspace, _ *binding.ResourceCache) ([]exportObject, error) {
+			actions, err := client.ListMonitorV2Actions(ctx, &workspace.Id)
+			if err != nil {
+				return nil, err
+			}
+			names := make(exportNames)
+			objects := make([]exportObject, 0, len(actions))
+			for _, a := range actions {
+				objects = append(objects, exportObject{id: a.Id, tfName: names.add(a.Name)})
+			}
+			return objects, nil
+		},
+		fetch: clientExporter(oid.MonitorV2ActionOid),
+	},
+	{
+		filename:     "rbac_statements.tf",
+		resourceType: "observe_rbac_statement",
+		list: func(ctx context.Context, client *observe.Client, _ *gql.Workspace, _ *binding.ResourceCache) ([]exportObject, error) {
+			statements, err := client.ListRbacStatements(ctx)
+			if err != nil {
+				return nil, err
+			}
+			names := make(exportNames)
+			objects := make([]exportObject, 0, len(statements))
+			for _, r := range statements {
+				// statements have no name, and descriptions are optional
+				label := r.Description
+				if label == "" {
+					label = "statement_" + r.Id
+				}
+				objects = append(objects, exportObject{id: r.Id, tfName: names.add(label)})
+			}
+			return objects, nil
+		},
+		fetch: clientExporter(oid.RbacStatementOid),
+	},
+}
+
+// cachedExportObjects returns objects named after their resource cache entry,
+// so that references bound through the cache match the exported resources.
+// Objects created since the cache was populated are skipped.
+func cachedExportObjects(cache *binding.ResourceCache, kind binding.Kind, n int, id func(i int) string) []exportObject {
+	objects := make([]exportObject, 0, n)
+	for i := 0; i < n; i++ {
+		entry := cache.LookupId(kind, id(i))
+		if entry == nil {
+			fmt.Fprintf(os.Stderr, "Skipping %s %s: not found\n", kind, id(i))
+			continue
+		}
+		objects = append(objects, exportObject{id: id(i), tfName: entry.TfName, kind: kind})
+	}
+	return objects
+}
+
+// apiExporter fetches configuration generated by the terraform API
+func apiExporter(objectType gql.TerraformObjectType) func(context.Context, *observe.Client, string) (exportRenderer, error) {
+	return func(ctx context.Context, client *observe.Client, id string) (exportRenderer, error) {
+		definition, err := client.GetTerraform(ctx, id, objectType)
+		if err != nil {
+			return nil, err
+		}
+		return func(gen *binding.Generator) (*gql.TerraformDefinition, error) {
+			rendered := *definition
+			// configuration generated by the API contains hard-coded OIDs
+			if rendered.Resource != nil {
+				s, err := rewriteTerraformReferences(*rendered.Resource, gen)
+				if err != nil {
+					return nil, err
+				}
+				rendered.Resource = &s
+			}
+			return &rendered, nil
+		}, nil
+	}
+}
+
+// clientExporter reads objects through their resource, and generates
+// configuration client side
+func clientExporter(toOid func(id string) oid.OID) func(context.Context, *observe.Client, string) (exportRenderer, error) {
+	return func(ctx context.Context, client *observe.Client, id string) (exportRenderer, error) {
+		target := toOid(id)
+		object, err := readTerraformObject(ctx, client, &target)
+		if err != nil {
+			return nil, err
+		}
+		return func(gen *binding.Generator) (*gql.TerraformDefinition, error) {
+			return object.render(gen), nil
+		}, nil
+	}
+}
+
+// exportNames allocates unique terraform names within a file
+type exportNames map[string]struct{}
+
+func (n exportNames) add(label string) string {
+	name := binding.SanitizeIdentifier(label)
+	for i := 1; ; i++ {
+		if _, found := n[name]; !found {
+			break
+		}
+		name = fmt.Sprintf("%s_%d", binding.SanitizeIdentifier(label), i)
+	}
+	n[name] = struct{}{}
+	return name
+}
+
+// ExportWorkspace writes terraform configuration for all supported objects in
+// the named workspace to dir, one file per object type. The name may be
+// omitted if the customer has a single workspace. The provider is configured
+// from the environment, e.g. OBSERVE_CUSTOMER and OBSERVE_API_TOKEN.
+//
+// Each resource is preceded by an import block, so that applying the
+// configuration adopts existing objects rather than creating new ones.
+// References between exported objects are written as resource references.
+// Objects which cannot be exported are skipped, and reported on stderr.
+func ExportWorkspace(ctx context.Context, dir string, workspaceName string) error {
+	provider := Provider()
+	if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		for _, d := range diags {
+			if d.Severity == diag.Error {
+				return fmt.Errorf("failed to configure provider: %s: %s", d.Summary, d.Detail)
+			}
+		}
+	}
+	client := provider.Meta().(*observe.Client)
+	// references are resolved against exported resources, not local variables
+	client.Config.ExportObjectBindings = false
+	return exportWorkspace(ctx, client, dir, workspaceName)
+}
+
+// selectExportWorkspace returns the workspace with the given name, or the only
+// workspace if name is empty
+func selectExportWorkspace(workspaces []*gql.Workspace, name string) (*gql.Workspace, error) {
+	if name != "" {
+		for _, workspace := range workspaces {
+			if workspace.Label == name {
+				return workspace, nil
+			}
+		}
+		return nil, fmt.Errorf("workspace %q not found", name)
+	}
+	switch len(workspaces) {
+	case 0:
+		return nil, fmt.Errorf("no workspace found")
+	case 1:
+		return workspaces[0], nil
+	}
+	labels := make([]string, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		labels = append(labels, fmt.Sprintf("%q", workspace.Label))
+	}
+	return nil, fmt.Errorf("found %d workspaces, select one of %s", len(workspaces), strings.Join(labels, ", "))
+}
+
+func exportWorkspace(ctx context.Context, client *observe.Client, dir string, workspaceName string) error {
+	workspaces, err := client.ListWorkspaces(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list workspaces: %w", err)
+	}
+	workspace, err := selectExportWorkspace(workspaces, workspaceName)
+	if err != nil {
+		return err
+	}
+
+	cache, err := binding.NewWorkspaceResourceCache(ctx, binding.NewKindSet(binding.KindDataset, binding.KindWorksheet), client, workspace)
+	if err != nil {
+		return fmt.Errorf("failed to list objects: %w", err)
+	}
+	workspaceEntry := cache.LookupId(binding.KindWorkspace, workspace.Oid().String())
+	if workspaceEntry == nil {
+		return fmt.Errorf("failed to resolve workspace %s", workspace.Id)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	f := hclwrite.NewEmptyFile()
+	block := f.Body().AppendNewBlock("data", []string{"observe_workspace", workspaceEntry.TfName})
+	block.Body().SetAttributeValue("name", cty.StringVal(workspace.Label))
+	if err := writeExportFile(filepath.Join(dir, "workspace.tf"), f); err != nil {
+		return err
+	}
+
+	// Objects are fetched before any configuration is rendered, so that
+	// references are only bound to objects which are written out. The
+	// remaining references are left as OIDs.
+	type fetchedObject struct {
+		exportObject
+		render exportRenderer
+	}
+	fetched := make([][]fetchedObject, len(exportFiles))
+	for i, file := range exportFiles {
+		objects, err := file.list(ctx, client, workspace, &cache)
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %w", file.resourceType, err)
+		}
+		sort.Slice(objects, func(i, j int) bool {
+			return objects[i].tfName < objects[j].tfName
+		})
+
+		for _, object := range objects {
+			render, err := file.fetch(ctx, client, object.id)
+			if err == nil {
+				err = validateExportedResource(file.resourceType, object.tfName, render)
+			}
+			if err != nil {
+				// some objects, e.g. those managed by apps, cannot be exported
+				fmt.Fprintf(os.Stderr, "Skipping %s %s: %s\n", file.resourceType, object.id, err)
+				if object.kind != "" {
+					cache.Remove(object.kind, object.id)
+				}
+				continue
+			}
+			fetched[i] = append(fetched[i], fetchedObject{exportObject: object, render: render})
+		}
+	}
+
+	gen := binding.NewReferenceGenerator(cache, exportReferenceAddresses)
+	for i, file := range exportFiles {
+		f := hclwrite.NewEmptyFile()
+		for _, object := range fetched[i] {
+			definition, err := object.render(&gen)
+			if err == nil {
+				err = appendExportedResource(f.Body(), file.resourceType, object.tfName, definition)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to export %s %s: %w", file.resourceType, object.id, err)
+			}
+		}
+		if err := writeExportFile(filepath.Join(dir, file.filename), f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateExportedResource verifies configuration can be generated for an
+// object, without binding any references
+func validateExportedResource(resourceType string, tfName string, render exportRenderer) error {
+	definition, err := render(&binding.Generator{})
+	if err != nil {
+		return err
+	}
+	return appendExportedResource(hclwrite.NewEmptyFile().Body(), resourceType, tfName, definition)
+}
+
+// appendExportedResource appends an import block followed by the resource
+// definition, renamed to tfName
+func appendExportedResource(body *hclwrite.Body, resourceType string, tfName string, definition *gql.TerraformDefinition) error {
+	if definition.Resource == nil || definition.ImportId == nil {
+		return fmt.Errorf("no terraform definition available")
+	}
+	parsed, diags := hclwrite.ParseConfig([]byte(*definition.Resource), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	var resource *hclwrite.Block
+	for _, block := range parsed.Body().Blocks() {
+		if labels := block.Labels(); block.Type() == "resource" && len(labels) == 2 && labels[0] == resourceType {
+			resource = block
+			break
+		}
+	}
+	if resource == nil {
+		return fmt.Errorf("no %s resource in terraform definition", resourceType)
+	}
+	resource.SetLabels([]string{resourceType, tfName})
+
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+	importBlock := body.AppendNewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: tfName},
+	})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(*definition.ImportId))
+	body.AppendNewline()
+	body.AppendBlock(resource)
+	return nil
+}
+
+// rewriteTerraformReferences replaces quoted strings in configuration which
+// refer to other objects with references to the resources managing them
+func rewriteTerraformReferences(src string, gen *binding.Generator) (string, error) {
+	f, diags := hclwrite.ParseConfig([]byte(src), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return "", diags
+	}
+	rewriteTerraformBodyReferences(f.Body(), gen)
+	return string(hclwrite.Format(f.Bytes())), nil
+}
+
+func rewriteTerraformBodyReferences(body *hclwrite.Body, gen *binding.Generator) {
+	for name, attr := range body.Attributes() {
+		tokens := attr.Expr().BuildTokens(nil)
+		rewritten := make(hclwrite.Tokens, 0, len(tokens))
+		changed := false
+		for i := 0; i < len(tokens); i++ {
+			if i+2 < len(tokens) &&
+				tokens[i].Type == hclsyntax.TokenOQuote &&
+				tokens[i+1].Type == hclsyntax.TokenQuotedLit &&
+				tokens[i+2].Type == hclsyntax.TokenCQuote {
+				if s, ok := decodeTerraformQuotedLit(tokens[i+1].Bytes); ok {
+					if bound := bindTerraformString(gen, s); bound != s {
+						rewritten = append(rewritten, terraformTokensForString(bound)...)
+						changed = true
+						i += 2
+						continue
+					}
+				}
+			}
+			rewritten = append(rewritten, tokens[i])
+		}
+		if changed {
+			body.SetAttributeRaw(name, rewritten)
+		}
+	}
+	for _, block := range body.Blocks() {
+		rewriteTerraformBodyReferences(block.Body(), gen)
+	}
+}
+
+// decodeTerraformQuotedLit returns the value of a quoted string literal. Strings
+// containing interpolations are not decoded.
+func decodeTerraformQuotedLit(lit []byte) (string, bool) {
+	src := append(append([]byte{'"'}, lit...), '"')
+	expr, diags := hclsyntax.ParseExpression(src, "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return "", false
+	}
+	v, diags := expr.Value(nil)
+	if diags.HasErrors() || !v.Type().Equals(cty.String) || !v.IsKnown() || v.IsNull() {
+		return "", false
+	}
+	return v.AsString(), true
+}
+
+func writeExportFile(path string, f *hclwrite.File) error {
+	if err := os.WriteFile(path, hclwrite.Format(f.Bytes()), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package observe
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+func TestExportNames(t *testing.T) {
+	names := make(exportNames)
+	for _, tt := range []struct {
+		label    string
+		expected string
+	}{
+		{"My Monitor", "my_monitor"},
+		{"my monitor", "my_monitor_1"},
+		{"My-Monitor", "my-monitor"},
+		{"my_monitor", "my_monitor_2"},
+		{"1st", "_1st"},
+	} {
+		if name := names.add(tt.label); name != tt.expected {
+			t.Errorf("unexpected name for %q: got %s, want %s", tt.label, name, tt.expected)
+		}
+	}
+}
+
+func TestAppendExportedResource(t *testing.T) {
+	f := hclwrite.NewEmptyFile()
+	for _, tt := range []struct {
+		tfName     string
+		definition *gql.TerraformDefinition
+	}{
+		{"a", &gql.TerraformDefinition{
+			Resource: stringPtr("resource \"observe_worksheet\" \"original\" {\n  name = \"A\"\n}\n"),
+			ImportId: stringPtr("41000001"),
+		}},
+		{"b", &gql.TerraformDefinition{
+			// only the resource block is kept
+			Resource: stringPtr("data \"observe_workspace\" \"default\" {\n  name = \"Default\"\n}\n\nresource \"observe_worksheet\" \"original\" {\n  name = \"B\"\n}\n"),
+			ImportId: stringPtr("41000002"),
+		}},
+	} {
+		if err := appendExportedResource(f.Body(), "observe_worksheet", tt.tfName, tt.definition); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := `import {
+  to = observe_worksheet.a
+  id = "41000001"
+}
+
+resource "observe_worksheet" "a" {
+  name = "A"
+}
+
+import {
+  to = observe_worksheet.b
+  id = "41000002"
+}
+
+resource "observe_worksheet" "b" {
+  name = "B"
+}
+`
+	if s := string(hclwrite.Format(f.Bytes())); s != expected {
+		t.Fatalf("unexpected output: %s", cmp.Diff(expected, s))
+	}
+
+	if err := appendExportedResource(f.Body(), "observe_dataset", "x", &gql.TerraformDefinition{
+		Resource: stringPtr("resource \"observe_worksheet\" \"original\" {}\n"),
+		ImportId: stringPtr("41000003"),
+	}); err == nil {
+		t.Fatal("expected error for missing resource")
+	}
+}
+
+func TestSelectExportWorkspace(t *testing.T) {
+	workspaces := []*gql.Workspace{
+		{Id: "41000001", Label: "Default"},
+		{Id: "41000002", Label: "Staging"},
+	}
+
+	if workspace, err := selectExportWorkspace(workspaces, "Staging"); err != nil {
+		t.Fatal(err)
+	} else if workspace.Id != "41000002" {
+		t.Errorf("unexpected workspace %s", workspace.Id)
+	}
+
+	if workspace, err := selectExportWorkspace(workspaces[:1], ""); err != nil {
+		t.Fatal(err)
+	} else if workspace.Id != "41000001" {
+		t.Errorf("unexpected workspace %s", workspace.Id)
+	}
+
+	for _, name := range []string{"", "Missing"} {
+		if _, err := selectExportWorkspace(workspaces, name); err == nil {
+			t.Errorf("expected error selecting %q", name)
+		}
+	}
+	if _, err := selectExportWorkspace(nil, ""); err == nil {
+		t.Error("expected error without workspaces")
+	}
+}
@@ -209,6 +209,21 @@ func validateTimeDuration(i interface{}, path cty.Path) diag.Diagnostics {
 	return nil
 }
 
+func validatePositiveTimeDuration(i interface{}, path cty.Path) diag.Diagnostics {
+	if diags := validateTimeDuration(i, path); diags.HasError() {
+		return diags
+	}
+	if d, _ := time.ParseDuration(i.(string)); d <= 0 {
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid field",
+			Detail:        fmt.Sprintf("expected a positive duration, got %s", i),
+			AttributePath: path,
+		}}
+	}
+	return nil
+}
+
 func validateTimestamp(i interface{}, path cty.Path) diag.Diagnostics {
 	s := i.(string)
 	if _, err := time.Parse(time.RFC3339, s); err != nil {
@@ -222,6 +237,18 @@ func validateTimestamp(i interface{}, path cty.Path) diag.Diagnostics {
 	return nil
 }
 
+func diffSuppressTimestamp(k, prv, nxt string, d *schema.ResourceData) bool {
+	o, err := time.Parse(time.RFC3339, prv)
+	if err != nil {
+		return false
+	}
+	n, err := time.Parse(time.RFC3339, nxt)
+	if err != nil {
+		return false
+	}
+	return o.Equal(n)
+}
+
 func validateFlags(i interface{}, path cty.Path) diag.Diagnostics {
 	if _, err := convertFlags(i.(string)); err != nil {
 		return diag.FromErr(err)
@@ -243,6 +270,18 @@ func validateStringIsJSON(i interface{}, path cty.Path) diag.Diagnostics {
 	return nil
 }
 
+func validateRegexp(i interface{}, path cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", i)
+	}
+
+	if _, err := regexp.Compile(v); err != nil {
+		return diag.Errorf("%q is not a valid regular expression: %s", v, err)
+	}
+	return nil
+}
+
 // input is a list of comma separated lowercase identifiers which may be negated, e.g. "feature-a,!enable-b"
 func convertFlags(s string) (map[string]bool, error) {
 	flags := make(map[string]bool)
@@ -313,6 +352,9 @@ func diffSuppressJSON(k, prv, nxt string, d *schema.ResourceData) bool {
 }
 
 func diffSuppressStageQueryInput(k, prv, nxt string, d *schema.ResourceData) bool {
+	if diffSuppressNormalizedJSON(k, prv, nxt, d) {
+		return true
+	}
 	prvValue := make([]gql.StageQueryInput, 0)
 	nxtValue := make([]gql.StageQueryInput, 0)
 	if err := json.Unmarshal([]byte(prv), &prvValue); err != nil {
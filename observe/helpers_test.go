@@ -252,6 +252,33 @@ func TestValidateUID(t *testing.T) {
 	}
 }
 
+func TestValidatePositiveTimeDuration(t *testing.T) {
+	testcases := []struct {
+		input string
+		valid bool
+	}{
+		{input: "15s", valid: true},
+		{input: "1h30m", valid: true},
+		{input: "0s", valid: false},
+		{input: "0", valid: false},
+		{input: "-1m", valid: false},
+		{input: "soon", valid: false},
+	}
+
+	for _, tt := range testcases {
+		diags := validatePositiveTimeDuration(tt.input, make(cty.Path, 0))
+		if tt.valid {
+			if len(diags) != 0 {
+				t.Fatalf("should have no validation errors: %v. test: %v", diags, tt)
+			}
+		} else {
+			if len(diags) != 1 {
+				t.Fatalf("should have one validation error: %v. test: %v", diags, tt)
+			}
+		}
+	}
+}
+
 // newMultilineErrorRegexp creates a regexp that matches the given string,
 // allowing for any whitespace (including newlines) anywhere a space is present
 // in the input. The Terraform provider test framework executes the Terraform
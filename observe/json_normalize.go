@@ -0,0 +1,131 @@
+package observe
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+// serverGeneratedJSONKeys are populated by the API on read, and are never
+// meaningful in configuration.
+var serverGeneratedJSONKeys = map[string]bool{
+	"createdBy":   true,
+	"createdDate": true,
+	"updatedBy":   true,
+	"updatedDate": true,
+}
+
+// normalizeJSON canonicalizes a JSON document so that semantically equivalent
+// documents have identical representations. Object keys are sorted, and
+// server-generated defaults are stripped:
+//   - keys listed in serverGeneratedJSONKeys
+//   - empty identifiers, e.g. "stageId": ""
+//   - null values, unless they are the only key of an object, since
+//     {"string": null} denotes a typed null
+//   - empty arrays and objects
+func normalizeJSON(s string) (string, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return "", err
+	}
+
+	v, _ = normalizeJSONValue(v)
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// normalizeJSONValue recursively normalizes a decoded JSON value, returning
+// false if the value should be omitted from its parent
+func normalizeJSONValue(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			if serverGeneratedJSONKeys[key] || isEmptyJSONIdentifier(key, elem) {
+				continue
+			}
+			if elem == nil {
+				if len(v) == 1 {
+					result[key] = nil
+				}
+				continue
+			}
+			if elem, keep := normalizeJSONValue(elem); keep {
+				result[key] = elem
+			}
+		}
+		return result, len(result) > 0
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for _, elem := range v {
+			// preserve array positions
+			elem, _ = normalizeJSONValue(elem)
+			result = append(result, elem)
+		}
+		return result, len(result) > 0
+	default:
+		return v, true
+	}
+}
+
+func isEmptyJSONIdentifier(key string, v interface{}) bool {
+	if s, ok := v.(string); !ok || s != "" {
+		return false
+	}
+	return key == "id" || strings.HasSuffix(key, "Id") || strings.HasSuffix(key, "ID")
+}
+
+// normalizeJSONOrOriginal normalizes a JSON document, returning it unchanged
+// if it cannot be parsed
+func normalizeJSONOrOriginal(s string) string {
+	if normalized, err := normalizeJSON(s); err == nil {
+		return normalized
+	}
+	return s
+}
+
+// stateNormalizedJSON stores JSON attributes in normalized form. Since state
+// read back from the API is normalized too, plans only show keys which
+// actually changed, rather than the whole document. Resources still send the
+// configured document, since the SDK passes the original value to CRUD
+// functions.
+func stateNormalizedJSON(v interface{}) string {
+	return normalizeJSONOrOriginal(v.(string))
+}
+
+// diffSuppressNormalizedJSON suppresses diffs between JSON documents which
+// only differ in key order or server-generated defaults
+func diffSuppressNormalizedJSON(k, prv, nxt string, d *schema.ResourceData) bool {
+	o, err := normalizeJSON(prv)
+	if err != nil {
+		return false
+	}
+	n, err := normalizeJSON(nxt)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
+
+// denormalizeStageInputs restores stage inputs stripped by normalizeJSON,
+// since the API requires the list to be present
+func denormalizeStageInputs(stages []gql.StageQueryInput) {
+	for i := range stages {
+		if stages[i].Input == nil {
+			stages[i].Input = []gql.InputDefinitionInput{}
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package observe
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNormalizeJSON(t *testing.T) {
+	testcases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    `{"b": 1, "a": {"d": true, "c": "x"}}`,
+			Expected: `{"a":{"c":"x","d":true},"b":1}`,
+		},
+		{
+			// server-generated defaults are stripped
+			Input:    `{"id": "", "stageId": "", "label": "", "createdDate": "2023-01-01T00:00:00Z", "steps": [], "rollup": {}, "limit": null}`,
+			Expected: `{"label":""}`,
+		},
+		{
+			// typed nulls are preserved
+			Input:    `{"defaultValue": {"string": null}, "value": {"bool": null, "int64": "1"}}`,
+			Expected: `{"defaultValue":{"string":null},"value":{"int64":"1"}}`,
+		},
+		{
+			// array positions are preserved
+			Input:    `[{"a": null, "b": null}, 1.50, "<&>"]`,
+			Expected: `[{},1.50,"<&>"]`,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.Input, func(t *testing.T) {
+			got, err := normalizeJSON(tt.Input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.Expected, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestStateNormalizedJSON(t *testing.T) {
+	// configuration is stored in the same form as state read from the API
+	if got := stateNormalizedJSON(`{"pipeline": "filter true", "id": "stage-0", "stageId": ""}`); got != `{"id":"stage-0","pipeline":"filter true"}` {
+		t.Errorf("unexpected state: %s", got)
+	}
+	// invalid documents are stored as is, and reported by validation
+	if got := stateNormalizedJSON(`{`); got != `{` {
+		t.Errorf("unexpected state: %s", got)
+	}
+}
+
+// reverseKeysJSON re-encodes a JSON document with object keys in reverse order
+func reverseKeysJSON(t *testing.T, v interface{}) string {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		var parts []string
+		for i := len(keys) - 1; i >= 0; i-- {
+			k, _ := json.Marshal(keys[i])
+			parts = append(parts, string(k)+":"+reverseKeysJSON(t, v[keys[i]]))
+		}
+		return "{" + strings.Join(parts, ",") + "}"
+	case []interface{}:
+		var parts []string
+		for _, elem := range v {
+			parts = append(parts, reverseKeysJSON(t, elem))
+		}
+		return "[" + strings.Join(parts, ",") + "]"
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+}
+
+// checkNoUntypedNulls verifies null values only appear as typed nulls
+func checkNoUntypedNulls(t *testing.T, v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, elem := range v {
+			if elem == nil && len(v) > 1 {
+				t.Errorf("normalized document contains null value for %q", key)
+			}
+			checkNoUntypedNulls(t, elem)
+		}
+	case []interface{}:
+		for _, elem := range v {
+			checkNoUntypedNulls(t, elem)
+		}
+	}
+}
+
+// fixtureStages returns the stages of a dataset fixture, converted either from
+// or to GraphQL
+func fixtureStages(t *testing.T, file string) []interface{} {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v struct {
+		QueryInput struct {
+			Stages []interface{} `json:"stages"`
+		} `json:"queryInput"`
+		Transform struct {
+			Current struct {
+				Query struct {
+					Stages []interface{} `json:"stages"`
+				} `json:"query"`
+			} `json:"current"`
+		} `json:"transform"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.QueryInput.Stages != nil {
+		return v.QueryInput.Stages
+	}
+	return v.Transform.Current.Query.Stages
+}
+
+// renameJSONStrings replaces string values found in names, recursively
+func renameJSONStrings(v interface{}, names map[string]string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, elem := range v {
+			v[key] = renameJSONStrings(elem, names)
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = renameJSONStrings(elem, names)
+		}
+	case string:
+		if name, ok := names[v]; ok {
+			return name
+		}
+	}
+	return v
+}
+
+func TestNormalizeJSONFixtures(t *testing.T) {
+	files, err := filepath.Glob("../client/testdata/*/*json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no fixtures found")
+	}
+
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			normalized, err := normalizeJSON(string(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if again, err := normalizeJSON(normalized); err != nil {
+				t.Fatal(err)
+			} else if diff := cmp.Diff(normalized, again); diff != "" {
+				t.Errorf("normalization is not idempotent: %s", diff)
+			}
+
+			for _, s := range []string{`:[]`, `:{}`, `"stageId":""`} {
+				if strings.Contains(normalized, s) {
+					t.Errorf("normalized document contains %s", s)
+				}
+			}
+
+			var n interface{}
+			if err := json.Unmarshal([]byte(normalized), &n); err != nil {
+				t.Fatal(err)
+			}
+			checkNoUntypedNulls(t, n)
+
+			var v interface{}
+			if err := json.Unmarshal(data, &v); err != nil {
+				t.Fatal(err)
+			}
+			reordered := reverseKeysJSON(t, v)
+			if !diffSuppressNormalizedJSON("", string(data), reordered, nil) {
+				t.Error("expected reordered document to be equivalent")
+			}
+			if !diffSuppressNormalizedJSON("", string(data), normalized, nil) {
+				t.Error("expected normalized document to be equivalent")
+			}
+		})
+	}
+	// Fixtures converted from and to GraphQL are counterparts if they share
+	// terraform configuration. Stages read back from the API must normalize
+	// to the configured stages, once server-assigned stage IDs are mapped to
+	// the configured ones.
+	pairs := 0
+	for _, from := range files {
+		if filepath.Base(filepath.Dir(from)) != "fromgql" || filepath.Ext(from) != ".json" {
+			continue
+		}
+		for _, to := range files {
+			if filepath.Base(filepath.Dir(to)) != "togql" || filepath.Ext(to) != ".json" {
+				continue
+			}
+			fromConfig, err := os.ReadFile(from[:len(from)-len(".json")] + ".tfjson")
+			if err != nil {
+				t.Fatal(err)
+			}
+			toConfig, err := os.ReadFile(to[:len(to)-len(".json")] + ".tfjson")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !diffSuppressNormalizedJSON("", string(fromConfig), string(toConfig), nil) {
+				continue
+			}
+			pairs++
+
+			t.Run(from+"="+to, func(t *testing.T) {
+				fromStages, toStages := fixtureStages(t, from), fixtureStages(t, to)
+				if len(fromStages) != len(toStages) {
+					t.Fatalf("expected %d stages, got %d", len(toStages), len(fromStages))
+				}
+				names := make(map[string]string)
+				for i := range fromStages {
+					names[fromStages[i].(map[string]interface{})["id"].(string)] = toStages[i].(map[string]interface{})["id"].(string)
+				}
+
+				fromData, err := json.Marshal(renameJSONStrings(fromStages, names))
+				if err != nil {
+					t.Fatal(err)
+				}
+				toData, err := json.Marshal(toStages)
+				if err != nil {
+					t.Fatal(err)
+				}
+				fromNormalized, err := normalizeJSON(string(fromData))
+				if err != nil {
+					t.Fatal(err)
+				}
+				toNormalized, err := normalizeJSON(string(toData))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(toNormalized, fromNormalized); diff != "" {
+					t.Errorf("normalized stages differ: %s", diff)
+				}
+			})
+		}
+	}
+	if pairs == 0 {
+		t.Fatal("no fromgql fixture has a togql counterpart")
+	}
+}
@@ -0,0 +1,193 @@
+package observe
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+// PagerDuty and Slack actions have no dedicated destination in the API: they
+// are webhooks tagged with the corresponding action type. The typed blocks
+// below compile to a webhook with a well known body, which is parsed back on
+// read.
+//
+// The template dictionary has no documented variables for whether an alarm
+// is active or which level it was raised at, so PagerDuty events always
+// trigger with a fixed severity.
+
+const (
+	monitorV2PagerDutyEventsURL    = "https://events.pagerduty.com/v2/enqueue"
+	monitorV2PagerDutyEventTrigger = "trigger"
+	monitorV2NotificationText      = "{{monitor.name}}"
+)
+
+var (
+	monitorV2ActionDestinations = []string{"email", "webhook", "pagerduty", "slack"}
+
+	monitorV2PagerDutySeverities = []string{"critical", "error", "warning", "info"}
+)
+
+type monitorV2PagerDutyEvent struct {
+	RoutingKey  string                    `json:"routing_key"`
+	EventAction string                    `json:"event_action"`
+	DedupKey    string                    `json:"dedup_key,omitempty"`
+	Payload     monitorV2PagerDutyPayload `json:"payload"`
+}
+
+type monitorV2PagerDutyPayload struct {
+	Summary  string `json:"summary"`
+	Source   string `json:"source"`
+	Severity string `json:"severity"`
+}
+
+type monitorV2SlackMessage struct {
+	Channel string `json:"channel,omitempty"`
+	Text    string `json:"text"`
+}
+
+func monitorV2PagerDutyActionInput() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"routing_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"severity": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "critical",
+				ValidateDiagFunc: validateStringInSlice(monitorV2PagerDutySeverities, false),
+			},
+			"dedup_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func monitorV2SlackActionInput() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPS),
+			},
+			"channel": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// validateMonitorV2ActionDestination verifies the destination block agrees
+// with the action type. Raw webhooks remain valid for any non-email type.
+func validateMonitorV2ActionDestination(actionType string, isSet func(string) bool) error {
+	expected := map[string]gql.MonitorV2ActionType{
+		"email":     gql.MonitorV2ActionTypeEmail,
+		"pagerduty": gql.MonitorV2ActionTypePagerduty,
+		"slack":     gql.MonitorV2ActionTypeSlack,
+	}
+	for key, t := range expected {
+		if isSet(key) && !strings.EqualFold(actionType, toSnake(string(t))) {
+			return fmt.Errorf("%s block requires type to be %q, got %q", key, toSnake(string(t)), actionType)
+		}
+	}
+	if isSet("webhook") && strings.EqualFold(actionType, toSnake(string(gql.MonitorV2ActionTypeEmail))) {
+		return fmt.Errorf("webhook block cannot be used with type %q", actionType)
+	}
+	return nil
+}
+
+func newMonitorV2PagerDutyActionInput(data *schema.ResourceData, path string) (webhook *gql.MonitorV2WebhookActionInput, diags diag.Diagnostics) {
+	event := monitorV2PagerDutyEvent{
+		RoutingKey:  data.Get(fmt.Sprintf("%srouting_key", path)).(string),
+		EventAction: monitorV2PagerDutyEventTrigger,
+		DedupKey:    data.Get(fmt.Sprintf("%sdedup_key", path)).(string),
+		Payload: monitorV2PagerDutyPayload{
+			Summary:  monitorV2NotificationText,
+			Source:   "observe",
+			Severity: data.Get(fmt.Sprintf("%sseverity", path)).(string),
+		},
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	webhook = &gql.MonitorV2WebhookActionInput{
+		Url:    monitorV2PagerDutyEventsURL,
+		Method: gql.MonitorV2HttpTypePost,
+		Headers: []gql.MonitorV2WebhookHeaderInput{
+			{Header: "Content-Type", Value: "application/json"},
+		},
+		Body: string(body),
+	}
+	return webhook, diags
+}
+
+func newMonitorV2SlackActionInput(data *schema.ResourceData, path string) (webhook *gql.MonitorV2WebhookActionInput, diags diag.Diagnostics) {
+	message := monitorV2SlackMessage{
+		Channel: data.Get(fmt.Sprintf("%schannel", path)).(string),
+		Text:    monitorV2NotificationText,
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	webhook = &gql.MonitorV2WebhookActionInput{
+		Url:    data.Get(fmt.Sprintf("%surl", path)).(string),
+		Method: gql.MonitorV2HttpTypePost,
+		Headers: []gql.MonitorV2WebhookHeaderInput{
+			{Header: "Content-Type", Value: "application/json"},
+		},
+		Body: string(body),
+	}
+	return webhook, diags
+}
+
+// monitorV2FlattenPagerDutyAction returns false if the webhook was not
+// produced by a pagerduty block
+func monitorV2FlattenPagerDutyAction(gqlWebhook gql.MonitorV2WebhookAction) ([]interface{}, bool) {
+	var event monitorV2PagerDutyEvent
+	if gqlWebhook.Url != monitorV2PagerDutyEventsURL {
+		return nil, false
+	}
+	if err := json.Unmarshal([]byte(gqlWebhook.Body), &event); err != nil || event.RoutingKey == "" {
+		return nil, false
+	}
+
+	pagerduty := map[string]interface{}{
+		"routing_key": event.RoutingKey,
+		"severity":    event.Payload.Severity,
+		"dedup_key":   event.DedupKey,
+	}
+	return []interface{}{pagerduty}, true
+}
+
+// monitorV2FlattenSlackAction returns false if the webhook was not produced
+// by a slack block
+func monitorV2FlattenSlackAction(gqlWebhook gql.MonitorV2WebhookAction) ([]interface{}, bool) {
+	var message monitorV2SlackMessage
+	if err := json.Unmarshal([]byte(gqlWebhook.Body), &message); err != nil || message.Text == "" {
+		return nil, false
+	}
+
+	slack := map[string]interface{}{
+		"url":     gqlWebhook.Url,
+		"channel": message.Channel,
+	}
+	return []interface{}{slack}, true
+}
@@ -0,0 +1,125 @@
+package observe
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+func TestMonitorV2PagerDutyRoundTrip(t *testing.T) {
+	testcases := []map[string]interface{}{
+		{
+			"routing_key": "abc",
+			"dedup_key":   "{{monitor.name}}",
+			"severity":    "critical",
+		},
+		{
+			"routing_key": "abc",
+			"dedup_key":   "",
+			"severity":    "info",
+		},
+	}
+
+	for _, tc := range testcases {
+		data := schema.TestResourceDataRaw(t, resourceMonitorV2Action().Schema, map[string]interface{}{
+			"pagerduty": []interface{}{tc},
+		})
+
+		input, diags := newMonitorV2PagerDutyActionInput(data, "pagerduty.0.")
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		got, ok := monitorV2FlattenPagerDutyAction(gql.MonitorV2WebhookAction{
+			Url:  input.Url,
+			Body: input.Body,
+		})
+		if !ok {
+			t.Fatalf("failed to parse body %s", input.Body)
+		}
+		if !reflect.DeepEqual(got, []interface{}{tc}) {
+			t.Fatalf("expected %v, got %v", tc, got)
+		}
+	}
+}
+
+func TestMonitorV2PagerDutyBody(t *testing.T) {
+	data := schema.TestResourceDataRaw(t, resourceMonitorV2Action().Schema, map[string]interface{}{
+		"pagerduty": []interface{}{
+			map[string]interface{}{
+				"routing_key": "abc",
+				"severity":    "warning",
+			},
+		},
+	})
+
+	input, diags := newMonitorV2PagerDutyActionInput(data, "pagerduty.0.")
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := `{"routing_key":"abc","event_action":"trigger","payload":{"summary":"{{monitor.name}}","source":"observe","severity":"warning"}}`
+	if input.Body != expected {
+		t.Errorf("expected body %s, got %s", expected, input.Body)
+	}
+}
+
+func TestMonitorV2SlackRoundTrip(t *testing.T) {
+	expect := map[string]interface{}{
+		"url":     "https://hooks.slack.com/services/T000/B000/XXXX",
+		"channel": "#alerts",
+	}
+
+	data := schema.TestResourceDataRaw(t, resourceMonitorV2Action().Schema, map[string]interface{}{
+		"slack": []interface{}{expect},
+	})
+
+	input, diags := newMonitorV2SlackActionInput(data, "slack.0.")
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	got, ok := monitorV2FlattenSlackAction(gql.MonitorV2WebhookAction{
+		Url:  input.Url,
+		Body: input.Body,
+	})
+	if !ok {
+		t.Fatalf("failed to parse body %s", input.Body)
+	}
+	if !reflect.DeepEqual(got, []interface{}{expect}) {
+		t.Fatalf("expected %v, got %v", expect, got)
+	}
+
+	// hand-rolled webhooks must not be mistaken for slack blocks
+	if _, ok := monitorV2FlattenSlackAction(gql.MonitorV2WebhookAction{Body: "hello"}); ok {
+		t.Fatal("expected raw body not to parse")
+	}
+}
+
+func TestValidateMonitorV2ActionDestination(t *testing.T) {
+	testcases := []struct {
+		Type        string
+		Block       string
+		ExpectError bool
+	}{
+		{Type: "email", Block: "email"},
+		{Type: "pager_duty", Block: "pagerduty"},
+		{Type: "slack", Block: "slack"},
+		{Type: "slack", Block: "webhook"},
+		{Type: "pager_duty", Block: "webhook"},
+		{Type: "webhook", Block: "webhook"},
+		{Type: "email", Block: "webhook", ExpectError: true},
+		{Type: "webhook", Block: "slack", ExpectError: true},
+		{Type: "slack", Block: "pagerduty", ExpectError: true},
+		{Type: "pager_duty", Block: "email", ExpectError: true},
+	}
+
+	for _, tc := range testcases {
+		err := validateMonitorV2ActionDestination(tc.Type, func(key string) bool { return key == tc.Block })
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("type %s with %s block: unexpected result %v", tc.Type, tc.Block, err)
+		}
+	}
+}
@@ -16,6 +16,8 @@ import (
 
 var (
 	flagCacheClient       = "cache-client"
+	flagCheckQueries      = "check-queries"
+	flagForceDelete       = "force-delete"
 	tfSourceFormatDefault = "terraform/%s"
 )
 
@@ -88,7 +90,7 @@ func Provider() *schema.Provider {
 				DefaultFunc:      schema.EnvDefaultFunc("OBSERVE_FLAGS", ""),
 				ValidateDiagFunc: validateFlags,
 				Optional:         true,
-				Description:      "Toggle experimental features.",
+				Description:      "Toggle experimental features, as a comma separated list of flag names. Prefix a flag with `!` to disable it. Pipelines are compiled at plan time unless `!check-queries` is set, e.g. for offline plans.",
 			},
 			"http_client_timeout": {
 				Type:             schema.TypeString,
@@ -125,31 +127,50 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"observe_dataset":           dataSourceDataset(),
-			"observe_link":              dataSourceLink(),
-			"observe_workspace":         dataSourceWorkspace(),
-			"observe_query":             dataSourceQuery(),
-			"observe_board":             dataSourceBoard(),
-			"observe_monitor":           dataSourceMonitor(),
-			"observe_monitor_action":    dataSourceMonitorAction(),
-			"observe_datastream":        dataSourceDatastream(),
-			"observe_worksheet":         dataSourceWorksheet(),
-			"observe_dashboard":         dataSourceDashboard(),
-			"observe_folder":            dataSourceFolder(),
-			"observe_app":               dataSourceApp(),
-			"observe_app_version":       dataSourceAppVersion(),
-			"observe_default_dashboard": dataSourceDefaultDashboard(),
-			"observe_terraform":         dataSourceTerraform(),
-			"observe_oid":               dataSourceOID(),
-			"observe_rbac_group":        dataSourceRbacGroup(),
-			"observe_user":              dataSourceUser(),
-			"observe_ingest_info":       dataSourceIngestInfo(),
-			"observe_cloud_info":        dataSourceCloudInfo(),
-			"observe_monitor_v2":        dataSourceMonitorV2(),
-			"observe_monitor_v2_action": dataSourceMonitorV2Action(),
+			"observe_dataset":                     dataSourceDataset(),
+			"observe_data_connection":             dataSourceDataConnection(),
+			"observe_data_connection_version":     dataSourceDataConnectionVersion(),
+			"observe_datasource":                  dataSourceDatasource(),
+			"observe_link":                        dataSourceLink(),
+			"observe_workspace":                   dataSourceWorkspace(),
+			"observe_query":                       dataSourceQuery(),
+			"observe_board":                       dataSourceBoard(),
+			"observe_monitor":                     dataSourceMonitor(),
+			"observe_monitor_action":              dataSourceMonitorAction(),
+			"observe_datastream":                  dataSourceDatastream(),
+			"observe_worksheet":                   dataSourceWorksheet(),
+			"observe_dashboard":                   dataSourceDashboard(),
+			"observe_folder":                      dataSourceFolder(),
+			"observe_app":                         dataSourceApp(),
+			"observe_app_version":                 dataSourceAppVersion(),
+			"observe_default_dashboard":           dataSourceDefaultDashboard(),
+			"observe_terraform":                   dataSourceTerraform(),
+			"observe_oid":                         dataSourceOID(),
+			"observe_rbac_group":                  dataSourceRbacGroup(),
+			"observe_user":                        dataSourceUser(),
+			"observe_ingest_info":                 dataSourceIngestInfo(),
+			"observe_cloud_info":                  dataSourceCloudInfo(),
+			"observe_monitor_v2":                  dataSourceMonitorV2(),
+			"observe_monitor_v2_action":           dataSourceMonitorV2Action(),
+			"observe_monitor_mute_rule":           dataSourceMonitorMuteRule(),
+			"observe_investigation_notebook":      dataSourceInvestigationNotebook(),
+			"observe_api_tokens":                  dataSourceApiTokens(),
+			"observe_incident":                    dataSourceIncident(),
+			"observe_incidents":                   dataSourceIncidents(),
+			"observe_search":                      dataSourceSearch(),
+			"observe_datasets":                    dataSourceDatasets(),
+			"observe_dashboards":                  dataSourceDashboards(),
+			"observe_monitors_v2":                 dataSourceMonitorsV2(),
+			"observe_dataset_acceleration_status": dataSourceDatasetAccelerationStatus(),
+			"observe_dataset_lineage":             dataSourceDatasetLineage(),
+			"observe_dataset_health":              dataSourceDatasetHealth(),
+			"observe_monitor_v2_action_preview":   dataSourceMonitorV2ActionPreview(),
+			"observe_monitor_v2_alarms":           dataSourceMonitorV2Alarms(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"observe_dataset":                   resourceDataset(),
+			"observe_data_connection":           resourceDataConnection(),
+			"observe_datasource":                resourceDatasource(),
 			"observe_source_dataset":            resourceSourceDataset(),
 			"observe_link":                      resourceLink(),
 			"observe_workspace":                 resourceWorkspace(),
@@ -163,6 +184,7 @@ func Provider() *schema.Provider {
 			"observe_monitor":                   resourceMonitor(),
 			"observe_monitor_v2":                resourceMonitorV2(),
 			"observe_monitor_v2_action":         resourceMonitorV2Action(),
+			"observe_monitor_mute_rule":         resourceMonitorMuteRule(),
 			"observe_board":                     resourceBoard(),
 			"observe_poller":                    resourcePoller(),
 			"observe_datastream":                resourceDatastream(),
@@ -185,6 +207,11 @@ func Provider() *schema.Provider {
 			"observe_filedrop":                  resourceFiledrop(),
 			"observe_snowflake_outbound_share":  resourceSnowflakeOutboundShare(),
 			"observe_dataset_outbound_share":    resourceDatasetOutboundShare(),
+			"observe_reference_table":           resourceReferenceTable(),
+			"observe_investigation_notebook":    resourceInvestigationNotebook(),
+			"observe_api_token":                 resourceApiToken(),
+			"observe_incident":                  resourceIncident(),
+			"observe_acceleration_job":          resourceAccelerationJob(),
 		},
 		TerraformVersion: version.ProviderVersion,
 	}
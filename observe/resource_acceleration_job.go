@@ -0,0 +1,258 @@
+package observe
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+var accelerationJobPollInterval = 10 * time.Second
+
+func resourceAccelerationJob() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("acceleration_job", "description"),
+		CreateContext: resourceAccelerationJobCreate,
+		ReadContext:   resourceAccelerationJobRead,
+		DeleteContext: resourceAccelerationJobDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"datasets": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: descriptions.Get("acceleration_job", "schema", "datasets"),
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeDataset),
+				},
+			},
+			"start": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateTimestamp,
+				DiffSuppressFunc: diffSuppressTimestamp,
+				Description:      descriptions.Get("acceleration_job", "schema", "start"),
+			},
+			"end": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateTimestamp,
+				DiffSuppressFunc: diffSuppressTimestamp,
+				Description:      descriptions.Get("acceleration_job", "schema", "end"),
+			},
+			"context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "context"),
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "state"),
+			},
+			"progress": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "progress"),
+			},
+			"credits": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "credits"),
+			},
+			"created_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "created_date"),
+			},
+			"dataset_status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "dataset_status", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("acceleration_job", "schema", "dataset_status", "dataset"),
+						},
+						"direct": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("acceleration_job", "schema", "dataset_status", "direct"),
+						},
+						"progress": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: descriptions.Get("acceleration_job", "schema", "dataset_status", "progress"),
+						},
+						"credits": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: descriptions.Get("acceleration_job", "schema", "dataset_status", "credits"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newAccelerationJobInput(data *schema.ResourceData) (input *gql.AccelerationJobInput, diags diag.Diagnostics) {
+	start, err := time.Parse(time.RFC3339, data.Get("start").(string))
+	if err != nil {
+		return nil, diag.Errorf("failed to parse start: %s", err.Error())
+	}
+	end, err := time.Parse(time.RFC3339, data.Get("end").(string))
+	if err != nil {
+		return nil, diag.Errorf("failed to parse end: %s", err.Error())
+	}
+	if !end.After(start) {
+		return nil, diag.Errorf("end must be after start")
+	}
+
+	startTime, endTime := types.TimeScalar(start.UTC()), types.TimeScalar(end.UTC())
+	interval := gql.TimeRangeInput{
+		Start: &startTime,
+		End:   &endTime,
+	}
+
+	input = &gql.AccelerationJobInput{}
+	for _, v := range data.Get("datasets").(*schema.Set).List() {
+		id, _ := oid.NewOID(v.(string))
+		input.Requests = append(input.Requests, gql.AccelerationRequestInput{
+			DatasetId: id.Id,
+			Intervals: []gql.TimeRangeInput{interval},
+		})
+	}
+
+	if v, ok := data.GetOk("context"); ok {
+		input.Context = stringPtr(v.(string))
+	}
+	return input, nil
+}
+
+func resourceAccelerationJobCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	input, diags := newAccelerationJobInput(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	result, err := client.CreateAccelerationJob(ctx, input)
+	if err != nil {
+		return diag.Errorf("failed to create acceleration job: %s", err.Error())
+	}
+
+	data.SetId(result.JobId)
+
+	timeout := data.Timeout(schema.TimeoutCreate)
+	poller := &Poller{
+		Interval: &accelerationJobPollInterval,
+		Timeout:  &timeout,
+	}
+
+	err = poller.Run(ctx, func(ctx context.Context) error {
+		result, err = client.GetAccelerationJob(ctx, data.Id())
+		return err
+	}, func() bool {
+		return result.State != gql.AccelerationJobStateRunning
+	})
+
+	if err != nil {
+		diags = append(diags, diag.Errorf("failed waiting for acceleration job [id=%s] to complete: %s", data.Id(), err.Error())...)
+		return append(diags, resourceAccelerationJobRead(ctx, data, meta)...)
+	}
+
+	if result.State == gql.AccelerationJobStateCancelled {
+		diags = append(diags, diag.Errorf("acceleration job [id=%s] was cancelled", data.Id())...)
+	}
+
+	return append(diags, accelerationJobToResourceData(result, data)...)
+}
+
+func resourceAccelerationJobRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	result, err := client.GetAccelerationJob(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read acceleration job: %s", err.Error())
+	}
+	return accelerationJobToResourceData(result, data)
+}
+
+func resourceAccelerationJobDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	result, err := client.GetAccelerationJob(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			return nil
+		}
+		return diag.Errorf("failed to read acceleration job: %s", err.Error())
+	}
+
+	if result.State != gql.AccelerationJobStateRunning {
+		return nil
+	}
+
+	if _, err := client.CancelAccelerationJob(ctx, data.Id()); err != nil {
+		return diag.Errorf("failed to cancel acceleration job: %s", err.Error())
+	}
+	return diags
+}
+
+func accelerationJobToResourceData(j *gql.AccelerationJob, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if err := data.Set("state", strings.ToLower(string(j.State))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("progress", j.Progress); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if j.Credits != nil {
+		if err := data.Set("credits", *j.Credits); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := data.Set("created_date", j.CreatedDate.String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	statuses := make([]interface{}, 0, len(j.DatasetStatuses))
+	for _, s := range j.DatasetStatuses {
+		status := map[string]interface{}{
+			"dataset":  oid.DatasetOid(s.DatasetId).String(),
+			"direct":   s.IsDirect,
+			"progress": s.Progress,
+		}
+		if s.Credits != nil {
+			status["credits"] = *s.Credits
+		}
+		statuses = append(statuses, status)
+	}
+	if err := data.Set("dataset_status", statuses); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
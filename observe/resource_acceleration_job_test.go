@@ -0,0 +1,51 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveAccelerationJob(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	end := time.Now().UTC().Truncate(time.Hour)
+	start := end.Add(-time.Hour)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				resource "observe_dataset" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+
+					inputs = { "test" = observe_datastream.test.dataset }
+
+					stage {
+						pipeline = "filter true"
+					}
+				}
+
+				resource "observe_acceleration_job" "first" {
+					datasets = [observe_dataset.first.oid]
+					start    = "%[2]s"
+					end      = "%[3]s"
+					context  = "%[1]s"
+				}
+				`, randomPrefix, start.Format(time.RFC3339), end.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_acceleration_job.first", "state", "completed"),
+					resource.TestCheckResourceAttr("observe_acceleration_job.first", "progress", "1"),
+					resource.TestCheckResourceAttrSet("observe_acceleration_job.first", "created_date"),
+					resource.TestCheckResourceAttrSet("observe_acceleration_job.first", "dataset_status.0.dataset"),
+					resource.TestCheckResourceAttr("observe_acceleration_job.first", "dataset_status.0.direct", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,280 @@
+package observe
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceApiToken() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("api_token", "description"),
+		CreateContext: resourceApiTokenCreate,
+		ReadContext:   resourceApiTokenRead,
+		UpdateContext: resourceApiTokenUpdate,
+		DeleteContext: resourceApiTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if apiTokenNeedsRotation(d) {
+				if err := d.SetNewComputed("created_date"); err != nil {
+					return err
+				}
+				return d.ForceNew("created_date")
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("api_token", "schema", "name"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("api_token", "schema", "description"),
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions.Get("api_token", "schema", "disabled"),
+			},
+			"user": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(oid.TypeUser),
+				Description:      descriptions.Get("api_token", "schema", "user"),
+			},
+			"lifetime": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateTimeDuration,
+				DiffSuppressFunc: diffSuppressTimeDuration,
+				Description:      descriptions.Get("api_token", "schema", "lifetime"),
+			},
+			"extension": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "0s",
+				ValidateDiagFunc: validateTimeDuration,
+				DiffSuppressFunc: diffSuppressTimeDuration,
+				Description:      descriptions.Get("api_token", "schema", "extension"),
+			},
+			"rotate_after": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateTimeDuration,
+				DiffSuppressFunc: diffSuppressTimeDuration,
+				Description:      descriptions.Get("api_token", "schema", "rotate_after"),
+			},
+			// computed values
+			"secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: descriptions.Get("api_token", "schema", "secret"),
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("api_token", "schema", "expiration"),
+			},
+			"created_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("api_token", "schema", "created_date"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+		},
+	}
+}
+
+// apiTokenNeedsRotation returns true once an existing token is older than rotate_after
+func apiTokenNeedsRotation(d *schema.ResourceDiff) bool {
+	if d.Id() == "" {
+		return false
+	}
+	return apiTokenRotationDue(d.Get("rotate_after").(string), d.Get("created_date").(string), time.Now())
+}
+
+// apiTokenRotationDue returns true if a token created at createdDate is older
+// than rotateAfter at now. An unset or zero rotateAfter disables rotation.
+func apiTokenRotationDue(rotateAfter string, createdDate string, now time.Time) bool {
+	after, err := time.ParseDuration(rotateAfter)
+	if err != nil || after <= 0 {
+		return false
+	}
+	created, err := time.Parse(time.RFC3339, createdDate)
+	if err != nil {
+		return false
+	}
+	return now.After(created.Add(after))
+}
+
+// newApiTokenConfig builds the token input. Expiration is computed relative to
+// the creation time of the token, so that changing the lifetime of an existing
+// token does not also extend it from the time of the update.
+func newApiTokenConfig(data *schema.ResourceData, createdDate time.Time) (input *gql.AuthtokenInput, diags diag.Diagnostics) {
+	lifetime, _ := time.ParseDuration(data.Get("lifetime").(string))
+	extension, _ := time.ParseDuration(data.Get("extension").(string))
+
+	input = &gql.AuthtokenInput{
+		Name:             data.Get("name").(string),
+		Disabled:         data.Get("disabled").(bool),
+		Expiration:       types.TimeScalar(createdDate.Add(lifetime).UTC().Truncate(time.Second)),
+		ExtensionSeconds: types.Int64Scalar(extension / time.Second),
+	}
+
+	if v, ok := data.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	return input, diags
+}
+
+func resourceApiTokenCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newApiTokenConfig(data, time.Now())
+	if diags.HasError() {
+		return diags
+	}
+
+	var owningUser *types.UserIdScalar
+	if v, ok := data.GetOk("user"); ok {
+		userId, _ := oid.NewOID(v.(string))
+		uid, err := types.StringToUserIdScalar(userId.Id)
+		if err != nil {
+			return diag.Errorf("failed to parse user: %s", err.Error())
+		}
+		owningUser = &uid
+	}
+
+	result, secret, err := client.CreateApiToken(ctx, input, owningUser)
+	if err != nil {
+		return diag.Errorf("failed to create api token: %s", err.Error())
+	}
+
+	data.SetId(result.Id)
+
+	// the secret is only ever returned on creation
+	if err := data.Set("secret", secret); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceApiTokenRead(ctx, data, meta)...)
+}
+
+func resourceApiTokenUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	createdDate, err := time.Parse(time.RFC3339, data.Get("created_date").(string))
+	if err != nil {
+		return diag.Errorf("failed to parse created_date: %s", err.Error())
+	}
+
+	input, diags := newApiTokenConfig(data, createdDate)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err = client.UpdateApiToken(ctx, data.Id(), input)
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to update api token: %s", err.Error())
+	}
+
+	return append(diags, resourceApiTokenRead(ctx, data, meta)...)
+}
+
+func resourceApiTokenRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	token, err := client.GetApiToken(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read api token: %s", err.Error())
+	}
+
+	diags = apiTokenToResourceData(token, data)
+
+	// lifetime is only known locally, recover it from the token when importing
+	if _, ok := data.GetOk("lifetime"); !ok {
+		lifetime := time.Time(token.Expiration).Sub(time.Time(token.CreatedDate)).Round(time.Second)
+		if err := data.Set("lifetime", lifetime.String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+func apiTokenToResourceData(token *gql.ApiToken, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if err := data.Set("name", token.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("description", token.Description); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("disabled", token.Disabled); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if token.User != nil {
+		if err := data.Set("user", oid.UserOid(*token.User).String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	extension := time.Duration(token.ExtensionSeconds) * time.Second
+	if err := data.Set("extension", extension.String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("expiration", token.Expiration.String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("created_date", token.CreatedDate.String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oid", token.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceApiTokenDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if err := client.DeleteApiToken(ctx, data.Id()); err != nil {
+		return diag.Errorf("failed to delete api token: %s", err.Error())
+	}
+	return diags
+}
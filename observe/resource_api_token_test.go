@@ -0,0 +1,90 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestApiTokenRotationDue(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	testcases := []struct {
+		name        string
+		rotateAfter string
+		createdDate string
+		expected    bool
+	}{
+		{"not yet due", "720h", "2024-01-15T00:00:00Z", false},
+		{"due", "240h", "2024-01-15T00:00:00Z", true},
+		{"rotation disabled", "", "2024-01-01T00:00:00Z", false},
+		{"rotation disabled with zero duration", "0s", "2024-01-01T00:00:00Z", false},
+		{"unknown creation date", "1h", "", false},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := apiTokenRotationDue(tt.rotateAfter, tt.createdDate, now); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccObserveApiToken(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_api_token" "first" {
+						name        = "%[1]s"
+						description = "ci robot"
+						lifetime    = "24h"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_api_token.first", "oid"),
+					resource.TestCheckResourceAttrSet("observe_api_token.first", "secret"),
+					resource.TestCheckResourceAttrSet("observe_api_token.first", "expiration"),
+					resource.TestCheckResourceAttr("observe_api_token.first", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_api_token.first", "description", "ci robot"),
+					resource.TestCheckResourceAttr("observe_api_token.first", "disabled", "false"),
+					resource.TestCheckResourceAttr("observe_api_token.first", "extension", "0s"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_api_token" "first" {
+						name         = "%[1]s"
+						lifetime     = "48h"
+						extension    = "1h"
+						disabled     = true
+						rotate_after = "24h"
+					}
+
+					data "observe_api_tokens" "all" {
+						depends_on = [observe_api_token.first]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_api_token.first", "description", ""),
+					resource.TestCheckResourceAttr("observe_api_token.first", "disabled", "true"),
+					resource.TestCheckResourceAttr("observe_api_token.first", "extension", "1h0m0s"),
+					resource.TestCheckResourceAttrSet("data.observe_api_tokens.all", "api_tokens.0.expiration"),
+				),
+			},
+			{
+				ResourceName:            "observe_api_token.first",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret", "rotate_after"},
+			},
+		},
+	})
+}
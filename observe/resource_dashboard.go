@@ -20,7 +20,7 @@ const (
 	schemaDashboardNameDescription            = "Dashboard name. Must be unique within workspace."
 	schemaDashboardDescriptionDescription     = "Dashboard description."
 	schemaDashboardIconDescription            = "Icon image."
-	schemaDashboardJSONDescription            = "Dashboard stages in JSON format."
+	schemaDashboardJSONDescription            = "Dashboard stages in JSON format. Exactly one of `stages` or `panel` must be set."
 	schemaDashboardLayoutDescription          = "Dashboard layout in JSON format."
 	schemaDashboardOIDDescription             = "The Observe ID for dashboard."
 	schemaDashboardParametersDescription      = "Dashboard parameters in JSON format."
@@ -37,6 +37,12 @@ func resourceDashboard() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := customizeDiffValidateDashboardLayout(d); err != nil {
+				return err
+			}
+			return customizeDiffCheckDashboard(ctx, d, meta)
+		},
 		Schema: map[string]*schema.Schema{
 			"workspace": {
 				Type:             schema.TypeString,
@@ -61,16 +67,19 @@ func resourceDashboard() *schema.Resource {
 			},
 			"stages": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				ExactlyOneOf:     []string{"stages", "panel"},
 				ValidateDiagFunc: validateStringIsJSON,
 				DiffSuppressFunc: diffSuppressStageQueryInput,
+				StateFunc:        stateNormalizedJSON,
 				Description:      schemaDashboardJSONDescription,
 			},
 			"layout": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ValidateDiagFunc: validateStringIsJSON,
-				DiffSuppressFunc: diffSuppressJSON,
+				DiffSuppressFunc: diffSuppressNormalizedJSON,
+				StateFunc:        stateNormalizedJSON,
 				Description:      schemaDashboardLayoutDescription,
 			},
 			"parameters": {
@@ -87,6 +96,9 @@ func resourceDashboard() *schema.Resource {
 				DiffSuppressFunc: diffSuppressParameterValues,
 				Description:      schemaDashboardParameterValuesDescription,
 			},
+			"panel":     dashboardPanelSchema(),
+			"section":   dashboardSectionSchema(),
+			"parameter": dashboardParameterSchema(),
 			"oid": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -117,13 +129,30 @@ func newDashboardConfig(data *schema.ResourceData) (input *gql.DashboardInput, d
 			diagErr := fmt.Errorf("failed to parse 'stages' request field: %w", err)
 			diags = append(diags, diag.FromErr(diagErr)...)
 		}
+		denormalizeStageInputs(input.Stages)
+	} else if _, ok := data.GetOk("panel"); ok {
+		stages, err := newDashboardPanels(data)
+		if err != nil {
+			diagErr := fmt.Errorf("failed to parse 'panel' request field: %w", err)
+			diags = append(diags, diag.FromErr(diagErr)...)
+		}
+		input.Stages = stages
 	}
 
 	if v, ok := data.GetOk("layout"); ok {
 		input.Layout = types.JsonObject(v.(string)).Ptr()
+	} else if _, ok := data.GetOk("section"); ok {
+		input.Layout = newDashboardSections(data)
 	}
 
-	if v, ok := data.GetOk("parameters"); ok {
+	if _, ok := data.GetOk("parameter"); ok {
+		parameters, err := newDashboardParameters(data)
+		if err != nil {
+			diagErr := fmt.Errorf("failed to parse 'parameter' request field: %w", err)
+			diags = append(diags, diag.FromErr(diagErr)...)
+		}
+		input.Parameters = parameters
+	} else if v, ok := data.GetOk("parameters"); ok {
 		data := v.(string)
 		if err := json.Unmarshal([]byte(data), &input.Parameters); err != nil {
 			diagErr := fmt.Errorf("failed to parse 'parameters' request field: %w", err)
@@ -164,7 +193,33 @@ func dashboardToResourceData(ctx context.Context, d *gql.Dashboard, data *schema
 		}
 	}
 
-	if d.Stages != nil {
+	// structured blocks are read back from the JSON they compile to, so that
+	// changes made outside of terraform show up as drift
+	var hasPanel, hasSection, hasParameter bool
+	if !genBindings {
+		panels, representable := dashboardPanelsFromStages(d.Stages)
+		if hasPanel = useDashboardBlocks(data, "panel", "stages", representable); hasPanel {
+			if err := data.Set("panel", panels); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+
+		sections, representable := dashboardSectionsFromLayout(d.Layout)
+		if hasSection = useDashboardBlocks(data, "section", "layout", representable); hasSection {
+			if err := data.Set("section", sections); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+
+		parameters, representable := dashboardParametersFromSpecs(d.Parameters)
+		if hasParameter = useDashboardBlocks(data, "parameter", "parameters", representable); hasParameter {
+			if err := data.Set("parameter", parameters); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+	}
+
+	if d.Stages != nil && !hasPanel {
 		// Hack hack hack hack hack
 		for i, stage := range d.Stages {
 			if stage.Id != nil && *stage.Id == "" {
@@ -187,12 +242,12 @@ func dashboardToResourceData(ctx context.Context, d *gql.Dashboard, data *schema
 		} else if stagesRaw, err := gen.GenerateJson(stagesRaw); err != nil {
 			diagErr := fmt.Errorf("failed to generate bindings for 'stages' response field: %w", err)
 			diags = append(diags, diag.FromErr(diagErr)...)
-		} else if err := data.Set("stages", string(stagesRaw)); err != nil {
+		} else if err := data.Set("stages", normalizeJSONOrOriginal(string(stagesRaw))); err != nil {
 			diags = append(diags, diag.FromErr(err)...)
 		}
 	}
 
-	if d.Parameters != nil {
+	if d.Parameters != nil && !hasParameter {
 		if parametersRaw, err := json.Marshal(d.Parameters); err != nil {
 			diagErr := fmt.Errorf("failed to parse 'parameters' response field: %w", err)
 			diags = append(diags, diag.FromErr(diagErr)...)
@@ -216,7 +271,7 @@ func dashboardToResourceData(ctx context.Context, d *gql.Dashboard, data *schema
 		}
 	}
 
-	if d.Layout != nil || gen.Enabled {
+	if (d.Layout != nil || gen.Enabled) && !hasSection {
 		if d.Layout == nil {
 			empty := types.JsonObject("{}")
 			d.Layout = &empty
@@ -231,7 +286,7 @@ func dashboardToResourceData(ctx context.Context, d *gql.Dashboard, data *schema
 			layoutJson := types.JsonObject(string(layout))
 			if layout, err := gen.InsertBindingsObjectJson(&layoutJson); err != nil {
 				diags = append(diags, diag.FromErr(err)...)
-			} else if err := data.Set("layout", layout); err != nil {
+			} else if err := data.Set("layout", normalizeJSONOrOriginal(layout.String())); err != nil {
 				diags = append(diags, diag.FromErr(err)...)
 			}
 		}
@@ -245,6 +300,19 @@ func dashboardToResourceData(ctx context.Context, d *gql.Dashboard, data *schema
 	return diags
 }
 
+// useDashboardBlocks returns whether a dashboard attribute is read back as
+// blocks rather than JSON. Whichever form is configured is kept. When neither
+// is set, e.g. on import, blocks are used if they can express the dashboard.
+func useDashboardBlocks(data *schema.ResourceData, block string, attr string, representable bool) bool {
+	if _, ok := data.GetOk(block); ok {
+		return true
+	}
+	if _, ok := data.GetOk(attr); ok {
+		return false
+	}
+	return representable
+}
+
 func resourceDashboardCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*observe.Client)
 	config, diags := newDashboardConfig(data)
@@ -264,6 +332,7 @@ func resourceDashboardCreate(ctx context.Context, data *schema.ResourceData, met
 	}
 
 	data.SetId(result.Id)
+	diags = append(diags, checkDashboardWarnings(ctx, data, meta)...)
 	return append(diags, resourceDashboardRead(ctx, data, meta)...)
 }
 
@@ -303,7 +372,8 @@ func resourceDashboardUpdate(ctx context.Context, data *schema.ResourceData, met
 		return diags
 	}
 
-	return dashboardToResourceData(ctx, result, data, client, false)
+	diags = append(diags, checkDashboardWarnings(ctx, data, meta)...)
+	return append(diags, dashboardToResourceData(ctx, result, data, client, false)...)
 }
 
 func resourceDashboardDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
@@ -2,6 +2,7 @@ package observe
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -3139,3 +3140,65 @@ func TestAccObserveDashboard_DefaultValueInt64(t *testing.T) {
 		},
 	})
 }
+
+func TestAccObserveDashboardBlocks(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	config := configPreamble + datastreamConfigPreamble + `
+	resource "observe_dashboard" "first" {
+		workspace = data.observe_workspace.default.oid
+		name      = "%[1]s"
+
+		panel {
+			id       = "stage-logs"
+			label    = "Logs"
+			pipeline = "filter true"
+
+			input {
+				name    = "test"
+				dataset = observe_datastream.test.dataset
+			}
+		}
+
+		section {
+			title = "Overview"
+
+			card {
+				panel = "%[2]s"
+				width = 12
+			}
+		}
+
+		parameter {
+			id      = "limit"
+			type    = "INT64"
+			default = "10"
+		}
+	}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(config, randomPrefix, "stage-missing"),
+				ExpectError: regexp.MustCompile(`card references unknown panel "stage-missing"`),
+			},
+			{
+				Config: fmt.Sprintf(config, randomPrefix, "stage-logs"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_dashboard.first", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_dashboard.first", "panel.0.id", "stage-logs"),
+					resource.TestCheckResourceAttr("observe_dashboard.first", "section.0.card.0.width", "12"),
+					resource.TestCheckResourceAttr("observe_dashboard.first", "stages", ""),
+				),
+			},
+			{
+				// blocks are read back on import
+				ResourceName:      "observe_dashboard.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
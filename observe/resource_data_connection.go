@@ -0,0 +1,275 @@
+package observe
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceDataConnection() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("data_connection", "description"),
+		CreateContext: resourceDataConnectionCreate,
+		ReadContext:   resourceDataConnectionRead,
+		UpdateContext: resourceDataConnectionUpdate,
+		DeleteContext: resourceDataConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("data_connection", "schema", "folder"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("data_connection", "schema", "name"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("data_connection", "schema", "description"),
+			},
+			"module_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: descriptions.Get("data_connection", "schema", "module_id"),
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("data_connection", "schema", "version"),
+			},
+			"variables": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateMapValues(validateIsString()),
+				Description:      descriptions.Get("data_connection", "schema", "variables"),
+			},
+			// computed values
+			"outputs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_connection", "schema", "outputs"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+		},
+	}
+}
+
+func newDataConnectionConfig(data *schema.ResourceData) (input *gql.DataConnectionInput, diags diag.Diagnostics) {
+	input = &gql.DataConnectionInput{
+		Name:      data.Get("name").(string),
+		ModuleID:  data.Get("module_id").(string),
+		Version:   data.Get("version").(string),
+		Variables: makeDataVariableInputs(data.Get("variables").(map[string]interface{})),
+	}
+
+	if v, ok := data.GetOk("folder"); ok {
+		folderId, _ := oid.NewOID(v.(string))
+		input.FolderId = folderId.Version
+	}
+
+	if v, ok := data.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	return input, diags
+}
+
+func makeDataVariableInputs(in map[string]interface{}) []gql.DataVariableInput {
+	variables := make([]gql.DataVariableInput, 0)
+	for k, v := range makeStringMap(in) {
+		variables = append(variables, gql.DataVariableInput{
+			Name:  k,
+			Value: stringPtr(v),
+		})
+	}
+	return variables
+}
+
+// flattenDataVariables converts variables returned by the API into a map.
+// Sensitive values are not returned, so we retain whatever value is in state.
+func flattenDataVariables(variables []gql.DataVariable, prior map[string]interface{}) map[string]interface{} {
+	if len(variables) == 0 {
+		return nil
+	}
+	out := make(map[string]interface{}, len(variables))
+	for _, v := range variables {
+		switch {
+		case v.Value != nil:
+			out[v.Name] = *v.Value
+		case prior[v.Name] != nil:
+			out[v.Name] = prior[v.Name]
+		}
+	}
+	return out
+}
+
+// resolveDataConnectionVersion pins the data connection to the latest
+// available module version if none was provided
+func resolveDataConnectionVersion(ctx context.Context, client *observe.Client, workspaceId string, input *gql.DataConnectionInput) error {
+	if input.Version != "" {
+		return nil
+	}
+	moduleVersions, err := client.LookupDataConnectionModuleVersions(ctx, workspaceId, input.ModuleID)
+	if err != nil {
+		return err
+	}
+	input.Version, err = matchVersionString(input.ModuleID, moduleVersions, ">= 0", false)
+	return err
+}
+
+func resourceDataConnectionCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newDataConnectionConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	workspaceId, _ := oid.NewOID(data.Get("workspace").(string))
+	if err := resolveDataConnectionVersion(ctx, client, workspaceId.Id, input); err != nil {
+		return diag.Errorf("failed to resolve data connection version: %s", err.Error())
+	}
+
+	result, err := client.CreateDataConnection(ctx, workspaceId.Id, input)
+	if err != nil {
+		return diag.Errorf("failed to create data connection: %s", err.Error())
+	}
+
+	data.SetId(result.Id)
+	return append(diags, resourceDataConnectionRead(ctx, data, meta)...)
+}
+
+func resourceDataConnectionUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newDataConnectionConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := client.UpdateDataConnection(ctx, data.Id(), input)
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to update data connection: %s", err.Error())
+	}
+
+	return append(diags, resourceDataConnectionRead(ctx, data, meta)...)
+}
+
+func resourceDataConnectionRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	dataConnection, err := client.GetDataConnection(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read data connection: %s", err.Error())
+	}
+
+	return dataConnectionToResourceData(dataConnection, data)
+}
+
+func dataConnectionToResourceData(d *gql.DataConnection, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if err := data.Set("workspace", oid.WorkspaceOid(d.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("folder", oid.FolderOid(d.FolderId, d.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("name", d.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("icon_url", d.IconUrl); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("description", d.Description); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("module_id", d.ModuleID); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("version", d.Version); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	prior, _ := data.Get("variables").(map[string]interface{})
+	if err := data.Set("variables", flattenDataVariables(d.Variables, prior)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if d.Outputs != nil {
+		outputs := make(map[string]string, len(d.Outputs))
+		for _, o := range d.Outputs {
+			outputs[o.Name] = o.Target
+		}
+		out, err := json.Marshal(outputs)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if err := data.Set("outputs", string(out)); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := data.Set("oid", d.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceDataConnectionDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if err := client.DeleteDataConnection(ctx, data.Id()); err != nil {
+		return diag.Errorf("failed to delete data connection: %s", err.Error())
+	}
+	return diags
+}
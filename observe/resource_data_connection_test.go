@@ -0,0 +1,63 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveDataConnection(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					data "observe_data_connection_version" "host" {
+						workspace          = data.observe_workspace.default.oid
+						module_id          = "observeinc/host/observe"
+						version_constraint = ">= 0.1.0"
+					}
+
+					resource "observe_data_connection" "first" {
+						workspace   = data.observe_workspace.default.oid
+						name        = "%[1]s"
+						description = "host monitoring"
+						module_id   = "observeinc/host/observe"
+						version     = data.observe_data_connection_version.host.version
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_data_connection.first", "oid"),
+					resource.TestCheckResourceAttrSet("observe_data_connection.first", "folder"),
+					resource.TestCheckResourceAttr("observe_data_connection.first", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_data_connection.first", "description", "host monitoring"),
+					resource.TestCheckResourceAttrPair("observe_data_connection.first", "version", "data.observe_data_connection_version.host", "version"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_data_connection" "first" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-renamed"
+						module_id = "observeinc/host/observe"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_data_connection.first", "name", randomPrefix+"-renamed"),
+					resource.TestCheckResourceAttr("observe_data_connection.first", "description", ""),
+					resource.TestCheckResourceAttrSet("observe_data_connection.first", "version"),
+				),
+			},
+			{
+				ResourceName:      "observe_data_connection.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	observe "github.com/observeinc/terraform-provider-observe/client"
 	gql "github.com/observeinc/terraform-provider-observe/client/meta"
@@ -41,9 +43,14 @@ func resourceDataset() *schema.Resource {
 		},
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if datasetRecomputeOID(d) {
-				return d.SetNewComputed("oid")
+				if err := d.SetNewComputed("oid"); err != nil {
+					return err
+				}
 			}
-			return nil
+			if err := customizeDiffCheckQuery(ctx, d, meta); err != nil {
+				return err
+			}
+			return customizeDiffRematerializationEstimate(ctx, d, meta)
 		},
 		Schema: map[string]*schema.Schema{
 			"workspace": {
@@ -155,6 +162,47 @@ func resourceDataset() *schema.Resource {
 				ValidateDiagFunc: validateRematerializationMode,
 				Description:      descriptions.Get("dataset", "schema", "rematerialization_mode"),
 			},
+			"max_rematerialization_cost": {
+				Type:             schema.TypeFloat,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
+				Description:      descriptions.Get("dataset", "schema", "max_rematerialization_cost"),
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions.Get("dataset", "schema", "deletion_protection"),
+			},
+			"rematerialization_estimate": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("dataset", "schema", "rematerialization_estimate", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset", "schema", "rematerialization_estimate", "dataset"),
+						},
+						"absolute_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: descriptions.Get("dataset", "schema", "rematerialization_estimate", "absolute_cost"),
+						},
+						"additional_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: descriptions.Get("dataset", "schema", "rematerialization_estimate", "additional_cost"),
+						},
+						"confidence": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset", "schema", "rematerialization_estimate", "confidence"),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -376,6 +424,7 @@ func resourceDatasetCreate(ctx context.Context, data *schema.ResourceData, meta
 	}
 
 	data.SetId(result.Id)
+	diags = append(diags, checkQueryWarnings(ctx, data, meta)...)
 	return append(diags, resourceDatasetRead(ctx, data, meta)...)
 }
 
@@ -425,11 +474,15 @@ func resourceDatasetUpdate(ctx context.Context, data *schema.ResourceData, meta
 		return diags
 	}
 
-	return datasetToResourceData(result, data)
+	diags = append(diags, checkQueryWarnings(ctx, data, meta)...)
+	return append(diags, datasetToResourceData(result, data)...)
 }
 
 func resourceDatasetDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*observe.Client)
+	if diags := checkDatasetDeletionProtection(ctx, client, data); diags.HasError() {
+		return diags
+	}
 	if err := client.DeleteDataset(ctx, data.Id()); err != nil {
 		return diag.Errorf("failed to delete dataset: %s", err)
 	}
@@ -461,6 +514,71 @@ func diffSuppressVersion(k, old, new string, d *schema.ResourceData) bool {
 	return oldOID.Type == newOID.Type && oldOID.Id == newOID.Id
 }
 
+// customizeDiffRematerializationEstimate estimates the cost of rematerializing
+// an existing dataset whose query changes, and fails the plan if the estimate
+// exceeds max_rematerialization_cost
+func customizeDiffRematerializationEstimate(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || (!d.HasChange("inputs") && !d.HasChange("stage")) {
+		return nil
+	}
+
+	if d.Get("rematerialization_mode").(string) == rematerializationModeSkipRematerialization {
+		return d.SetNew("rematerialization_estimate", []interface{}{})
+	}
+
+	client, ok := meta.(*observe.Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	// GetOk reports zero as unset, but a limit of zero refuses any cost
+	maxCost := d.Get("max_rematerialization_cost").(float64)
+	hasMaxCost := rawConfigIsSet(d.GetRawConfig(), "max_rematerialization_cost")
+
+	estimates, err := client.EstimateRematerializationCost(ctx, d.Id())
+	if err != nil {
+		if hasMaxCost {
+			return fmt.Errorf("failed to estimate rematerialization cost: %w", err)
+		}
+		log.Printf("[WARN] failed to estimate rematerialization cost for dataset %s: %s", d.Id(), err)
+		return d.SetNewComputed("rematerialization_estimate")
+	}
+
+	sort.Slice(estimates, func(i, j int) bool {
+		return estimates[i].DatasetId < estimates[j].DatasetId
+	})
+
+	var total float64
+	result := make([]interface{}, 0, len(estimates))
+	for _, e := range estimates {
+		total += e.AdditionalCostEstimate
+		result = append(result, map[string]interface{}{
+			"dataset":         oid.DatasetOid(e.DatasetId).String(),
+			"absolute_cost":   e.AbsoluteCostEstimate,
+			"additional_cost": e.AdditionalCostEstimate,
+			"confidence":      string(e.ConfidenceAdditionalCostEstimate),
+		})
+	}
+
+	if hasMaxCost && total > maxCost {
+		return cty.GetAttrPath("max_rematerialization_cost").NewErrorf(
+			"estimated rematerialization cost of %.2f across %d datasets exceeds limit of %.2f",
+			total, len(estimates), maxCost)
+	}
+
+	return d.SetNew("rematerialization_estimate", result)
+}
+
+// rawConfigIsSet returns whether an attribute is set to a known value in the
+// raw configuration, including zero values
+func rawConfigIsSet(raw cty.Value, key string) bool {
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(key) {
+		return false
+	}
+	v := raw.GetAttr(key)
+	return v.IsKnown() && !v.IsNull()
+}
+
 func validateRematerializationMode(i interface{}, path cty.Path) diag.Diagnostics {
 	s := i.(string)
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -487,6 +488,168 @@ func TestAccObserveDatasetSchemaChange(t *testing.T) {
 	})
 }
 
+func TestRawConfigIsSet(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"zero":    cty.NumberFloatVal(0),
+		"null":    cty.NullVal(cty.Number),
+		"unknown": cty.UnknownVal(cty.Number),
+	})
+	testcases := map[string]bool{
+		"zero":    true,
+		"null":    false,
+		"unknown": false,
+		"missing": false,
+	}
+	for key, expected := range testcases {
+		if got := rawConfigIsSet(config, key); got != expected {
+			t.Errorf("%s: expected %t, got %t", key, expected, got)
+		}
+	}
+	if rawConfigIsSet(cty.NullVal(config.Type()), "zero") {
+		t.Error("expected null config to have no attributes set")
+	}
+}
+
+// Verify rematerialization cost is estimated for changed datasets
+func TestAccObserveDatasetRematerializationEstimate(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	config := configPreamble + datastreamConfigPreamble + `
+	resource "observe_dataset" "first" {
+		workspace = data.observe_workspace.default.oid
+		name 	  = "%[1]s-1"
+
+		inputs = { "test" = observe_datastream.test.dataset }
+
+		max_rematerialization_cost = 1000000
+
+		stage {
+		  pipeline = "%[2]s"
+		}
+	}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, randomPrefix, "filter true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_dataset.first", "rematerialization_estimate.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, randomPrefix, "filter false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_dataset.first", "rematerialization_estimate.0.dataset"),
+					resource.TestCheckResourceAttrSet("observe_dataset.first", "rematerialization_estimate.0.additional_cost"),
+				),
+			},
+		},
+	})
+}
+
+// Verify deleting a protected dataset fails while other datasets depend on it
+func TestAccObserveDatasetDeletionProtection(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	secondConfig := `
+				data "observe_dataset" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-1"
+				}
+
+				resource "observe_dataset" "second" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-2"
+
+					inputs = { "first" = data.observe_dataset.first.oid }
+
+					stage {
+						pipeline = "filter true"
+					}
+				}
+	`
+
+	firstConfig := func(protected bool) string {
+		return fmt.Sprintf(`
+				resource "observe_dataset" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%%[1]s-1"
+
+					inputs = { "test" = observe_datastream.test.dataset }
+
+					stage {
+						pipeline = "filter true"
+					}
+
+					deletion_protection = %t
+				}
+		`, protected)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+firstConfig(true), randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_dataset.first", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+firstConfig(true)+secondConfig, randomPrefix),
+			},
+			{
+				Config:      fmt.Sprintf(configPreamble+datastreamConfigPreamble+secondConfig, randomPrefix),
+				ExpectError: regexp.MustCompile(`deletion protection is enabled and the dataset has dependents`),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+firstConfig(false)+secondConfig, randomPrefix),
+			},
+		},
+	})
+}
+
+// Verify pipelines are compiled at plan time
+func TestAccObserveDatasetCompileError(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble, randomPrefix),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				resource "observe_dataset" "first" {
+					workspace = data.observe_workspace.default.oid
+					name 	  = "%[1]s-1"
+
+					inputs = { "test" = observe_datastream.test.dataset }
+
+					stage {
+					  pipeline = <<-EOF
+					  	filter true
+					  EOF
+					}
+
+					stage {
+					  pipeline = <<-EOF
+					  	notaverb
+					  EOF
+					}
+				}`, randomPrefix),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`stage.1.pipeline failed to compile`),
+			},
+		},
+	})
+}
+
 // Verify configuration errors
 func TestAccObserveDatasetErrors(t *testing.T) {
 	randomPrefix := acctest.RandomWithPrefix("tf")
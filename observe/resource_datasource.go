@@ -0,0 +1,254 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("datasource", "description"),
+		CreateContext: resourceDatasourceCreate,
+		ReadContext:   resourceDatasourceRead,
+		UpdateContext: resourceDatasourceUpdate,
+		DeleteContext: resourceDatasourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("datasource", "schema", "folder"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("datasource", "schema", "name"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("datasource", "schema", "description"),
+			},
+			"data_connection": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataConnection),
+				Description:      descriptions.Get("datasource", "schema", "data_connection"),
+			},
+			"datastream": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDatastream),
+				Description:      descriptions.Get("datasource", "schema", "datastream"),
+			},
+			"datastream_token": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateID(),
+				Description:      descriptions.Get("datasource", "schema", "datastream_token"),
+			},
+			"variables": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateMapValues(validateIsString()),
+				Description:      descriptions.Get("datasource", "schema", "variables"),
+			},
+			"client_stack_attributes": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ValidateDiagFunc: validateMapValues(validateIsString()),
+				Description:      descriptions.Get("datasource", "schema", "client_stack_attributes"),
+			},
+			// computed values
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("datasource", "schema", "type"),
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("datasource", "schema", "state"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+		},
+	}
+}
+
+func newDatasourceConfig(data *schema.ResourceData) (input *gql.DatasourceInput, diags diag.Diagnostics) {
+	dataConnectionId, _ := oid.NewOID(data.Get("data_connection").(string))
+	datastreamId, _ := oid.NewOID(data.Get("datastream").(string))
+
+	input = &gql.DatasourceInput{
+		Name:                  data.Get("name").(string),
+		DataConnectionID:      dataConnectionId.Id,
+		DatastreamID:          datastreamId.Id,
+		DatastreamTokenID:     data.Get("datastream_token").(string),
+		Variables:             makeDataVariableInputs(data.Get("variables").(map[string]interface{})),
+		ClientStackAttributes: makeDataVariableInputs(data.Get("client_stack_attributes").(map[string]interface{})),
+	}
+
+	if v, ok := data.GetOk("folder"); ok {
+		folderId, _ := oid.NewOID(v.(string))
+		input.FolderId = folderId.Version
+	}
+
+	if v, ok := data.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	return input, diags
+}
+
+func resourceDatasourceCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newDatasourceConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	workspaceId, _ := oid.NewOID(data.Get("workspace").(string))
+	result, err := client.CreateDatasource(ctx, workspaceId.Id, input)
+	if err != nil {
+		return diag.Errorf("failed to create datasource: %s", err.Error())
+	}
+
+	data.SetId(result.Id)
+	return append(diags, resourceDatasourceRead(ctx, data, meta)...)
+}
+
+func resourceDatasourceUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newDatasourceConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := client.UpdateDatasource(ctx, data.Id(), input)
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to update datasource: %s", err.Error())
+	}
+
+	return append(diags, resourceDatasourceRead(ctx, data, meta)...)
+}
+
+func resourceDatasourceRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	datasource, err := client.GetDatasource(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read datasource: %s", err.Error())
+	}
+
+	return datasourceToResourceData(datasource, data)
+}
+
+func datasourceToResourceData(d *gql.Datasource, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if err := data.Set("workspace", oid.WorkspaceOid(d.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("folder", oid.FolderOid(d.FolderId, d.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("name", d.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("icon_url", d.IconUrl); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("description", d.Description); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("data_connection", oid.DataConnectionOid(d.DataConnectionID).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("datastream", oid.DatastreamOid(d.DatastreamID).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("datastream_token", d.DatastreamTokenID); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	prior, _ := data.Get("variables").(map[string]interface{})
+	if err := data.Set("variables", flattenDataVariables(d.Variables, prior)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	prior, _ = data.Get("client_stack_attributes").(map[string]interface{})
+	if err := data.Set("client_stack_attributes", flattenDataVariables(d.ClientStackAttributes, prior)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("type", d.Type); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("state", toSnake(string(d.Status.State))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oid", d.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceDatasourceDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if err := client.DeleteDatasource(ctx, data.Id()); err != nil {
+		return diag.Errorf("failed to delete datasource: %s", err.Error())
+	}
+	return diags
+}
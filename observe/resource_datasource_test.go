@@ -0,0 +1,73 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveDatasource(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					resource "observe_data_connection" "example" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+						module_id = "observeinc/host/observe"
+					}
+
+					resource "observe_datasource" "first" {
+						workspace       = data.observe_workspace.default.oid
+						name            = "%[1]s"
+						data_connection = observe_data_connection.example.oid
+						datastream      = observe_datastream.test.oid
+
+						client_stack_attributes = {
+							os = "linux"
+						}
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_datasource.first", "oid"),
+					resource.TestCheckResourceAttrSet("observe_datasource.first", "datastream_token"),
+					resource.TestCheckResourceAttrSet("observe_datasource.first", "state"),
+					resource.TestCheckResourceAttr("observe_datasource.first", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_datasource.first", "client_stack_attributes.os", "linux"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					resource "observe_data_connection" "example" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+						module_id = "observeinc/host/observe"
+					}
+
+					resource "observe_datasource" "first" {
+						workspace       = data.observe_workspace.default.oid
+						name            = "%[1]s"
+						description     = "updated"
+						data_connection = observe_data_connection.example.oid
+						datastream      = observe_datastream.test.oid
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_datasource.first", "description", "updated"),
+					resource.TestCheckNoResourceAttr("observe_datasource.first", "client_stack_attributes.os"),
+				),
+			},
+			{
+				ResourceName:      "observe_datasource.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	observe "github.com/observeinc/terraform-provider-observe/client"
 	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	"github.com/observeinc/terraform-provider-observe/client/oid"
 )
 
@@ -41,6 +42,21 @@ func resourceFolder() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"settings": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Settings applied to the folder, and inherited by the objects it contains.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scanner_power_level": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Power level of the scanner for objects in the folder.",
+						},
+					},
+				},
+			},
 			"oid": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -66,6 +82,18 @@ func newFolderConfig(data *schema.ResourceData) (input *gql.FolderInput, diags d
 	return
 }
 
+func newFolderSettings(data *schema.ResourceData) *gql.LayeredFolderInput {
+	input := &gql.LayeredFolderInput{
+		Scanner: &gql.LayeredFolderScannerInput{},
+	}
+
+	if v, ok := data.GetOk("settings.0.scanner_power_level"); ok {
+		input.Scanner.PowerLevel = types.Int64Scalar(v.(int)).Ptr()
+	}
+
+	return input
+}
+
 func resourceFolderCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*observe.Client)
 
@@ -81,6 +109,13 @@ func resourceFolderCreate(ctx context.Context, data *schema.ResourceData, meta i
 	}
 
 	data.SetId(result.Id)
+
+	if _, ok := data.GetOk("settings"); ok {
+		if err := client.SaveFolderSettings(ctx, id.Id, result.Id, newFolderSettings(data)); err != nil {
+			return diag.Errorf("failed to save folder settings: %s", err.Error())
+		}
+	}
+
 	return append(diags, resourceFolderRead(ctx, data, meta)...)
 }
 
@@ -97,6 +132,13 @@ func resourceFolderUpdate(ctx context.Context, data *schema.ResourceData, meta i
 		return diag.Errorf("failed to update folder: %s", err.Error())
 	}
 
+	if data.HasChange("settings") {
+		id, _ := oid.NewOID(data.Get("workspace").(string))
+		if err := client.SaveFolderSettings(ctx, id.Id, data.Id(), newFolderSettings(data)); err != nil {
+			return diag.Errorf("failed to save folder settings: %s", err.Error())
+		}
+	}
+
 	return append(diags, resourceFolderRead(ctx, data, meta)...)
 }
 
@@ -132,6 +174,18 @@ func folderToResourceData(folder *gql.Folder, data *schema.ResourceData) (diags
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
+	// effective settings include inherited values, so only track them if
+	// they were configured
+	if _, ok := data.GetOk("settings"); ok {
+		settings := map[string]interface{}{}
+		if v := folder.EffectiveSettings.Scanner.PowerLevel; v != nil {
+			settings["scanner_power_level"] = int(*v)
+		}
+		if err := data.Set("settings", []interface{}{settings}); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
 	if err := data.Set("oid", folder.Oid().String()); err != nil {
 		return diag.FromErr(err)
 	}
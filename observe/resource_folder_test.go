@@ -42,6 +42,24 @@ func TestAccObserveFolderCreate(t *testing.T) {
 					resource.TestCheckResourceAttr("observe_folder.example", "name", randomPrefix+"-1"),
 					resource.TestCheckResourceAttr("observe_folder.example", "icon_url", "test"),
 					resource.TestCheckResourceAttr("observe_folder.example", "description", "a description"),
+					resource.TestCheckResourceAttr("observe_folder.example", "settings.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_folder" "example" {
+				  workspace    = data.observe_workspace.default.oid
+				  name         = "%[1]s-1"
+				  icon_url     = "test"
+				  description  = "a description"
+
+				  settings {
+				    scanner_power_level = 3
+				  }
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_folder.example", "settings.0.scanner_power_level", "3"),
 				),
 			},
 		},
@@ -0,0 +1,398 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceIncident() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("incident", "description"),
+		CreateContext: resourceIncidentCreate,
+		ReadContext:   resourceIncidentRead,
+		UpdateContext: resourceIncidentUpdate,
+		DeleteContext: resourceIncidentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("incident", "schema", "folder"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("incident", "schema", "name"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("incident", "schema", "description"),
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          toSnake(string(gql.IncidentStatusActive)),
+				ValidateDiagFunc: validateEnums(gql.AllIncidentStatuses),
+				DiffSuppressFunc: diffSuppressEnums,
+				Description:      descriptions.Get("incident", "schema", "status"),
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeUser),
+				},
+				Description: descriptions.Get("incident", "schema", "users"),
+			},
+			"worksheets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeWorksheet),
+				},
+				Description: descriptions.Get("incident", "schema", "worksheets"),
+			},
+			"dashboards": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeDashboard),
+				},
+				Description: descriptions.Get("incident", "schema", "dashboards"),
+			},
+			"slack_channel": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: descriptions.Get("incident", "schema", "slack_channel", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("incident", "schema", "slack_channel", "connection_id"),
+						},
+						"channel_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("incident", "schema", "slack_channel", "channel_id"),
+						},
+					},
+				},
+			},
+			// computed values
+			"inactive_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "inactive_time"),
+			},
+			"closed_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "closed_time"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+		},
+	}
+}
+
+func newIncidentConfig(data *schema.ResourceData) (input *gql.IncidentInput, diags diag.Diagnostics) {
+	input = &gql.IncidentInput{
+		Name:   data.Get("name").(string),
+		Status: gql.IncidentStatus(toCamel(data.Get("status").(string))),
+	}
+
+	if v, ok := data.GetOk("folder"); ok {
+		folderId, _ := oid.NewOID(v.(string))
+		input.FolderId = folderId.Version
+	}
+
+	if v, ok := data.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	return input, diags
+}
+
+// incidentSetChanges returns the elements added to and removed from a set attribute
+func incidentSetChanges(data *schema.ResourceData, key string) (added []interface{}, removed []interface{}) {
+	o, n := data.GetChange(key)
+	prv, nxt := o.(*schema.Set), n.(*schema.Set)
+	return nxt.Difference(prv).List(), prv.Difference(nxt).List()
+}
+
+func incidentObjectIds(oids []interface{}) []string {
+	ids := make([]string, 0, len(oids))
+	for _, v := range oids {
+		id, _ := oid.NewOID(v.(string))
+		ids = append(ids, id.Id)
+	}
+	return ids
+}
+
+func incidentUserIds(oids []interface{}) []types.UserIdScalar {
+	uids := make([]types.UserIdScalar, 0, len(oids))
+	for _, v := range oids {
+		id, _ := oid.NewOID(v.(string))
+		uids = append(uids, *oid.OidToUserId(*id))
+	}
+	return uids
+}
+
+func incidentSlackChannels(channels []interface{}) []gql.IncidentSlackchannelInput {
+	inputs := make([]gql.IncidentSlackchannelInput, 0, len(channels))
+	for _, v := range channels {
+		channel := v.(map[string]interface{})
+		inputs = append(inputs, gql.IncidentSlackchannelInput{
+			ConnectionID:   channel["connection_id"].(string),
+			SlackchannelID: channel["channel_id"].(string),
+		})
+	}
+	return inputs
+}
+
+// updateIncidentAttachments converts changes in the set-valued attributes
+// into the corresponding add and remove calls
+func updateIncidentAttachments(ctx context.Context, client *observe.Client, data *schema.ResourceData) error {
+	id := data.Id()
+
+	if added, removed := incidentSetChanges(data, "users"); len(added)+len(removed) > 0 {
+		if len(removed) > 0 {
+			if _, err := client.RemoveIncidentUsers(ctx, id, incidentUserIds(removed)); err != nil {
+				return err
+			}
+		}
+		if len(added) > 0 {
+			if _, err := client.AddIncidentUsers(ctx, id, incidentUserIds(added)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if added, removed := incidentSetChanges(data, "worksheets"); len(added)+len(removed) > 0 {
+		if len(removed) > 0 {
+			if _, err := client.RemoveIncidentWorksheets(ctx, id, incidentObjectIds(removed)); err != nil {
+				return err
+			}
+		}
+		if len(added) > 0 {
+			if _, err := client.AddIncidentWorksheets(ctx, id, incidentObjectIds(added)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if added, removed := incidentSetChanges(data, "dashboards"); len(added)+len(removed) > 0 {
+		if len(removed) > 0 {
+			if _, err := client.RemoveIncidentDashboards(ctx, id, incidentObjectIds(removed)); err != nil {
+				return err
+			}
+		}
+		if len(added) > 0 {
+			if _, err := client.AddIncidentDashboards(ctx, id, incidentObjectIds(added)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if added, removed := incidentSetChanges(data, "slack_channel"); len(added)+len(removed) > 0 {
+		if len(removed) > 0 {
+			if _, err := client.RemoveIncidentSlackChannels(ctx, id, incidentSlackChannels(removed)); err != nil {
+				return err
+			}
+		}
+		if len(added) > 0 {
+			if _, err := client.AddIncidentSlackChannels(ctx, id, incidentSlackChannels(added)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceIncidentCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newIncidentConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	workspaceId, _ := oid.NewOID(data.Get("workspace").(string))
+	result, err := client.CreateIncident(ctx, workspaceId.Id, input)
+	if err != nil {
+		return diag.Errorf("failed to create incident: %s", err.Error())
+	}
+
+	data.SetId(result.Id)
+
+	if err := updateIncidentAttachments(ctx, client, data); err != nil {
+		diags = append(diags, diag.Errorf("failed to update incident attachments: %s", err.Error())...)
+	}
+
+	return append(diags, resourceIncidentRead(ctx, data, meta)...)
+}
+
+func resourceIncidentUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newIncidentConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := client.UpdateIncident(ctx, data.Id(), input)
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to update incident: %s", err.Error())
+	}
+
+	if err := updateIncidentAttachments(ctx, client, data); err != nil {
+		diags = append(diags, diag.Errorf("failed to update incident attachments: %s", err.Error())...)
+	}
+
+	return append(diags, resourceIncidentRead(ctx, data, meta)...)
+}
+
+func resourceIncidentRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	incident, err := client.GetIncident(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read incident: %s", err.Error())
+	}
+
+	return incidentToResourceData(incident, data)
+}
+
+func incidentToResourceData(incident *gql.Incident, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if err := data.Set("workspace", oid.WorkspaceOid(incident.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("folder", oid.FolderOid(incident.FolderId, incident.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("name", incident.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("icon_url", incident.IconUrl); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("description", incident.Description); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("status", toSnake(string(incident.Status))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	users := make([]interface{}, 0, len(incident.Users))
+	for _, u := range incident.Users {
+		users = append(users, oid.UserOid(u.UserId).String())
+	}
+	if err := data.Set("users", users); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	worksheets := make([]interface{}, 0, len(incident.Worksheets))
+	for _, id := range incident.Worksheets {
+		worksheets = append(worksheets, oid.WorksheetOid(id).String())
+	}
+	if err := data.Set("worksheets", worksheets); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	dashboards := make([]interface{}, 0, len(incident.Dashboards))
+	for _, id := range incident.Dashboards {
+		dashboards = append(dashboards, oid.DashboardOid(id).String())
+	}
+	if err := data.Set("dashboards", dashboards); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	channels := make([]interface{}, 0, len(incident.SlackChannels))
+	for _, c := range incident.SlackChannels {
+		channels = append(channels, map[string]interface{}{
+			"connection_id": c.ConnectionID,
+			"channel_id":    c.SlackchannelID,
+		})
+	}
+	if err := data.Set("slack_channel", channels); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var inactiveTime, closedTime string
+	if incident.InactiveTime != nil {
+		inactiveTime = incident.InactiveTime.String()
+	}
+	if incident.ClosedTime != nil {
+		closedTime = incident.ClosedTime.String()
+	}
+	if err := data.Set("inactive_time", inactiveTime); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := data.Set("closed_time", closedTime); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oid", incident.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceIncidentDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if err := client.DeleteIncident(ctx, data.Id()); err != nil {
+		return diag.Errorf("failed to delete incident: %s", err.Error())
+	}
+	return diags
+}
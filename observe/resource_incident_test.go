@@ -0,0 +1,84 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveIncident(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_dashboard" "first" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-first"
+						stages    = "[]"
+					}
+
+					resource "observe_incident" "first" {
+						workspace   = data.observe_workspace.default.oid
+						name        = "%[1]s"
+						description = "game day"
+						dashboards  = [observe_dashboard.first.oid]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_incident.first", "oid"),
+					resource.TestCheckResourceAttr("observe_incident.first", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_incident.first", "description", "game day"),
+					resource.TestCheckResourceAttr("observe_incident.first", "status", "active"),
+					resource.TestCheckResourceAttr("observe_incident.first", "dashboards.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("observe_incident.first", "dashboards.*", "observe_dashboard.first", "oid"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_dashboard" "first" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-first"
+						stages    = "[]"
+					}
+
+					resource "observe_dashboard" "second" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-second"
+						stages    = "[]"
+					}
+
+					resource "observe_incident" "first" {
+						workspace  = data.observe_workspace.default.oid
+						name       = "%[1]s"
+						status     = "inactive"
+						dashboards = [observe_dashboard.second.oid]
+					}
+
+					data "observe_incident" "lookup" {
+						id = observe_incident.first.id
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_incident.first", "description", ""),
+					resource.TestCheckResourceAttr("observe_incident.first", "status", "inactive"),
+					resource.TestCheckResourceAttr("observe_incident.first", "dashboards.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("observe_incident.first", "dashboards.*", "observe_dashboard.second", "oid"),
+					resource.TestCheckResourceAttrSet("observe_incident.first", "inactive_time"),
+					resource.TestCheckResourceAttrPair("data.observe_incident.lookup", "name", "observe_incident.first", "name"),
+					resource.TestCheckResourceAttr("data.observe_incident.lookup", "status", "inactive"),
+				),
+			},
+			{
+				ResourceName:      "observe_incident.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
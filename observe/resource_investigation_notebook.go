@@ -0,0 +1,631 @@
+package observe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+var notebookBlockKinds = []string{
+	"markdown",
+	"query",
+	"image",
+	"ping",
+	"ticket",
+	"raise_incident",
+	"o11y_placeholder",
+}
+
+func resourceInvestigationNotebook() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("investigation_notebook", "description"),
+		CreateContext: resourceInvestigationNotebookCreate,
+		ReadContext:   resourceInvestigationNotebookRead,
+		UpdateContext: resourceInvestigationNotebookUpdate,
+		DeleteContext: resourceInvestigationNotebookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("investigation_notebook", "schema", "folder"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "name"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "description"),
+			},
+			"runbook_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "runbook_url"),
+			},
+			"incident": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateID(),
+				Description:      descriptions.Get("investigation_notebook", "schema", "incident"),
+			},
+			"block": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "block", "description"),
+				Elem:        notebookBlockResource(),
+			},
+			// computed values
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+		},
+	}
+}
+
+func notebookBlockResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "block", "id"),
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "block", "type"),
+			},
+			"markdown": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions.Get("investigation_notebook", "schema", "block", "markdown", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"text": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "markdown", "text"),
+						},
+					},
+				},
+			},
+			"query": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions.Get("investigation_notebook", "schema", "block", "query", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "query", "description_text"),
+						},
+						"stages": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateStringIsJSON,
+							DiffSuppressFunc: diffSuppressStageQueryInput,
+							Description:      descriptions.Get("investigation_notebook", "schema", "block", "query", "stages"),
+						},
+						"output_stage": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "query", "output_stage"),
+						},
+					},
+				},
+			},
+			"image": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions.Get("investigation_notebook", "schema", "block", "image", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base64": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "image", "base64"),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "image", "description_text"),
+						},
+					},
+				},
+			},
+			"ping": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions.Get("investigation_notebook", "schema", "block", "ping", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preview":      notebookActionPreviewSchema(),
+						"confirmation": notebookActionConfirmationSchema(),
+						"user": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "ping", "user"),
+						},
+					},
+				},
+			},
+			"ticket": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions.Get("investigation_notebook", "schema", "block", "ticket", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preview":      notebookActionPreviewSchema(),
+						"confirmation": notebookActionConfirmationSchema(),
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "ticket", "name"),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "ticket", "description_text"),
+						},
+						"priority": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "ticket", "priority"),
+						},
+					},
+				},
+			},
+			"raise_incident": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions.Get("investigation_notebook", "schema", "block", "raise_incident", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preview":      notebookActionPreviewSchema(),
+						"confirmation": notebookActionConfirmationSchema(),
+						"summary": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "raise_incident", "summary"),
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "raise_incident", "severity"),
+						},
+						"slack_channel": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "raise_incident", "slack_channel"),
+						},
+						"teams": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "raise_incident", "teams"),
+						},
+						"incident_owner": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "raise_incident", "incident_owner"),
+						},
+					},
+				},
+			},
+			"o11y_placeholder": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions.Get("investigation_notebook", "schema", "block", "o11y_placeholder", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"text": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "o11y_placeholder", "text"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func notebookActionPreviewSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: descriptions.Get("investigation_notebook", "schema", "block", "preview"),
+	}
+}
+
+func notebookActionConfirmationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          toSnake(string(gql.NotebookActionConfirmationNo)),
+		ValidateDiagFunc: validateEnums(gql.AllNotebookActionConfirmations),
+		DiffSuppressFunc: diffSuppressEnums,
+		Description:      descriptions.Get("investigation_notebook", "schema", "block", "confirmation"),
+	}
+}
+
+func newInvestigationNotebookConfig(data *schema.ResourceData) (input *gql.InvestigationNotebookInput, diags diag.Diagnostics) {
+	input = &gql.InvestigationNotebookInput{
+		Name:   data.Get("name").(string),
+		Blocks: make([]gql.NotebookBlockInput, 0),
+	}
+
+	if v, ok := data.GetOk("folder"); ok {
+		folderId, _ := oid.NewOID(v.(string))
+		input.FolderId = folderId.Version
+	}
+
+	if v, ok := data.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("runbook_url"); ok {
+		input.Runbook = &gql.NotebookRunbookInfoInput{Url: v.(string)}
+	}
+
+	if v, ok := data.GetOk("incident"); ok {
+		input.IncidentID = stringPtr(v.(string))
+	}
+
+	for i := range data.Get("block").([]interface{}) {
+		block, diags := newNotebookBlockInput(fmt.Sprintf("block.%d.", i), data)
+		if diags.HasError() {
+			return nil, diags
+		}
+		input.Blocks = append(input.Blocks, *block)
+	}
+
+	return input, diags
+}
+
+func newNotebookBlockInput(path string, data *schema.ResourceData) (block *gql.NotebookBlockInput, diags diag.Diagnostics) {
+	var kinds []string
+	for _, kind := range notebookBlockKinds {
+		if _, ok := data.GetOk(path + kind); ok {
+			kinds = append(kinds, kind)
+		}
+	}
+	if len(kinds) != 1 {
+		return nil, diag.Errorf("%s: exactly one of %v must be set", path[:len(path)-1], notebookBlockKinds)
+	}
+
+	block = &gql.NotebookBlockInput{}
+	if v, ok := data.GetOk(path + "id"); ok {
+		block.Id = stringPtr(v.(string))
+	}
+
+	path = path + kinds[0] + ".0."
+	props := &block.Properties
+
+	switch kinds[0] {
+	case "markdown":
+		block.Type = gql.NotebookBlockTypeContentmarkdown
+		props.Markdown = &gql.NotebookMarkdownInput{
+			Text: data.Get(path + "text").(string),
+		}
+	case "query":
+		block.Type = gql.NotebookBlockTypeContentquery
+		query, diags := newNotebookQueryInput(path, data)
+		if diags.HasError() {
+			return nil, diags
+		}
+		props.Query = &gql.NotebookQueryInput{
+			Query:       *query,
+			Description: data.Get(path + "description").(string),
+		}
+	case "image":
+		block.Type = gql.NotebookBlockTypeContentimage
+		props.Image = &gql.NotebookImageInput{
+			Base64:      data.Get(path + "base64").(string),
+			Description: data.Get(path + "description").(string),
+		}
+	case "ping":
+		block.Type = gql.NotebookBlockTypeActionping
+		props.Ping = &gql.NotebookPingActionInput{
+			Preview:      gql.NotebookActionPreviewInput{Text: data.Get(path + "preview").(string)},
+			User:         data.Get(path + "user").(string),
+			Confirmation: gql.NotebookActionConfirmation(toCamel(data.Get(path + "confirmation").(string))),
+		}
+	case "ticket":
+		block.Type = gql.NotebookBlockTypeActionticket
+		props.Ticket = &gql.NotebookTicketActionInput{
+			Preview:      gql.NotebookActionPreviewInput{Text: data.Get(path + "preview").(string)},
+			Name:         data.Get(path + "name").(string),
+			Description:  data.Get(path + "description").(string),
+			Priority:     data.Get(path + "priority").(string),
+			Confirmation: gql.NotebookActionConfirmation(toCamel(data.Get(path + "confirmation").(string))),
+		}
+	case "raise_incident":
+		block.Type = gql.NotebookBlockTypeActionraiseincident
+		teams := make([]string, 0)
+		for _, v := range data.Get(path + "teams").([]interface{}) {
+			teams = append(teams, v.(string))
+		}
+		props.RaiseIncident = &gql.NotebookRaiseIncidentActionInput{
+			Preview:       gql.NotebookActionPreviewInput{Text: data.Get(path + "preview").(string)},
+			Summary:       data.Get(path + "summary").(string),
+			Severity:      data.Get(path + "severity").(string),
+			Slack:         gql.NotebookSlackInfoInput{ChannelName: data.Get(path + "slack_channel").(string)},
+			Teams:         teams,
+			IncidentOwner: data.Get(path + "incident_owner").(string),
+			Confirmation:  gql.NotebookActionConfirmation(toCamel(data.Get(path + "confirmation").(string))),
+		}
+	case "o11y_placeholder":
+		block.Type = gql.NotebookBlockTypeO11yplaceholder
+		props.O11yPlaceholder = &gql.NotebookO11yPlaceholderInput{
+			Text: data.Get(path + "text").(string),
+		}
+	}
+
+	return block, diags
+}
+
+func newNotebookQueryInput(path string, data *schema.ResourceData) (query *gql.MultiStageQueryInput, diags diag.Diagnostics) {
+	query = &gql.MultiStageQueryInput{}
+	if err := json.Unmarshal([]byte(data.Get(path+"stages").(string)), &query.Stages); err != nil {
+		return nil, diag.Errorf("failed to parse 'stages' request field: %s", err.Error())
+	}
+	if len(query.Stages) == 0 {
+		return nil, diag.FromErr(errStagesMissing)
+	}
+
+	// every stage needs an ID so that the output stage can be referenced
+	for i := range query.Stages {
+		if query.Stages[i].Id == nil {
+			query.Stages[i].Id = stringPtr(fmt.Sprintf("stage-%d", i))
+		}
+	}
+
+	if v, ok := data.GetOk(path + "output_stage"); ok {
+		query.OutputStage = v.(string)
+	} else {
+		query.OutputStage = *query.Stages[len(query.Stages)-1].Id
+	}
+	return query, diags
+}
+
+func resourceInvestigationNotebookCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newInvestigationNotebookConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	workspaceId, _ := oid.NewOID(data.Get("workspace").(string))
+	result, err := client.CreateInvestigationNotebook(ctx, workspaceId.Id, input)
+	if err != nil {
+		return diag.Errorf("failed to create notebook: %s", err.Error())
+	}
+
+	data.SetId(result.Id)
+	return append(diags, resourceInvestigationNotebookRead(ctx, data, meta)...)
+}
+
+func resourceInvestigationNotebookUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newInvestigationNotebookConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := client.UpdateInvestigationNotebook(ctx, data.Id(), input)
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to update notebook: %s", err.Error())
+	}
+
+	return append(diags, resourceInvestigationNotebookRead(ctx, data, meta)...)
+}
+
+func resourceInvestigationNotebookRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	notebook, err := client.GetInvestigationNotebook(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read notebook: %s", err.Error())
+	}
+
+	diags = investigationNotebookToResourceData(notebook, data)
+
+	blocks, err := flattenNotebookBlocks(notebook.Blocks)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else if err := data.Set("block", blocks); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func investigationNotebookToResourceData(notebook *gql.InvestigationNotebook, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if err := data.Set("workspace", oid.WorkspaceOid(notebook.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("folder", oid.FolderOid(notebook.FolderId, notebook.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("name", notebook.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("icon_url", notebook.IconUrl); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("description", notebook.Description); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var runbookUrl string
+	if notebook.Runbook != nil {
+		runbookUrl = notebook.Runbook.Url
+	}
+	if err := data.Set("runbook_url", runbookUrl); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("incident", notebook.IncidentID); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oid", notebook.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func flattenNotebookBlocks(gqlBlocks []gql.NotebookBlock) ([]interface{}, error) {
+	blocks := make([]interface{}, 0, len(gqlBlocks))
+
+	for _, gqlBlock := range gqlBlocks {
+		block := map[string]interface{}{
+			"type": toSnake(string(gqlBlock.Type)),
+		}
+		if gqlBlock.Id != nil {
+			block["id"] = *gqlBlock.Id
+		}
+
+		props := gqlBlock.Properties
+		switch {
+		case props.Markdown != nil:
+			block["markdown"] = []interface{}{
+				map[string]interface{}{
+					"text": props.Markdown.Text,
+				},
+			}
+		case props.Query != nil:
+			// drop stage IDs we generated in newNotebookQueryInput
+			for i, stage := range props.Query.Query.Stages {
+				if stage.Id != nil && *stage.Id == fmt.Sprintf("stage-%d", i) {
+					props.Query.Query.Stages[i].Id = nil
+				}
+			}
+			stages, err := stageQueriesToJSON(props.Query.Query.Stages)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse 'stages' response field: %w", err)
+			}
+			block["query"] = []interface{}{
+				map[string]interface{}{
+					"description":  props.Query.Description,
+					"stages":       stages,
+					"output_stage": props.Query.Query.OutputStage,
+				},
+			}
+		case props.Image != nil:
+			block["image"] = []interface{}{
+				map[string]interface{}{
+					"base64":      props.Image.Base64,
+					"description": props.Image.Description,
+				},
+			}
+		case props.Ping != nil:
+			block["ping"] = []interface{}{
+				map[string]interface{}{
+					"preview":      props.Ping.Preview.Text,
+					"user":         props.Ping.User,
+					"confirmation": toSnake(string(props.Ping.Confirmation)),
+				},
+			}
+		case props.Ticket != nil:
+			block["ticket"] = []interface{}{
+				map[string]interface{}{
+					"preview":      props.Ticket.Preview.Text,
+					"name":         props.Ticket.Name,
+					"description":  props.Ticket.Description,
+					"priority":     props.Ticket.Priority,
+					"confirmation": toSnake(string(props.Ticket.Confirmation)),
+				},
+			}
+		case props.RaiseIncident != nil:
+			block["raise_incident"] = []interface{}{
+				map[string]interface{}{
+					"preview":        props.RaiseIncident.Preview.Text,
+					"summary":        props.RaiseIncident.Summary,
+					"severity":       props.RaiseIncident.Severity,
+					"slack_channel":  props.RaiseIncident.Slack.ChannelName,
+					"teams":          props.RaiseIncident.Teams,
+					"incident_owner": props.RaiseIncident.IncidentOwner,
+					"confirmation":   toSnake(string(props.RaiseIncident.Confirmation)),
+				},
+			}
+		case props.O11yPlaceholder != nil:
+			block["o11y_placeholder"] = []interface{}{
+				map[string]interface{}{
+					"text": props.O11yPlaceholder.Text,
+				},
+			}
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
+func resourceInvestigationNotebookDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if err := client.DeleteInvestigationNotebook(ctx, data.Id()); err != nil {
+		return diag.Errorf("failed to delete notebook: %s", err.Error())
+	}
+	return diags
+}
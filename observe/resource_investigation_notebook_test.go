@@ -0,0 +1,89 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveInvestigationNotebook(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_folder" "example" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+					}
+
+					resource "observe_investigation_notebook" "example" {
+						workspace   = data.observe_workspace.default.oid
+						folder      = observe_folder.example.oid
+						name        = "%[1]s"
+						description = "database failover"
+						runbook_url = "https://example.com/runbooks/db"
+
+						block {
+							markdown {
+								text = "# Check replication lag"
+							}
+						}
+
+						block {
+							ping {
+								preview = "Page the database on-call"
+								user    = "dba-oncall"
+							}
+						}
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("observe_investigation_notebook.example", "folder", "observe_folder.example", "oid"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.example", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.example", "runbook_url", "https://example.com/runbooks/db"),
+					resource.TestCheckResourceAttrSet("observe_investigation_notebook.example", "incident"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.example", "block.#", "2"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.example", "block.0.type", "content_markdown"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.example", "block.0.markdown.0.text", "# Check replication lag"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.example", "block.1.type", "action_ping"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.example", "block.1.ping.0.confirmation", "no"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_investigation_notebook" "example" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+
+						block {
+							raise_incident {
+								summary       = "Database unavailable"
+								severity      = "SEV1"
+								slack_channel = "#incidents"
+								teams         = ["dba", "sre"]
+							}
+						}
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_investigation_notebook.example", "description", ""),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.example", "runbook_url", ""),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.example", "block.#", "1"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.example", "block.0.type", "action_raise_incident"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.example", "block.0.raise_incident.0.teams.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "observe_investigation_notebook.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
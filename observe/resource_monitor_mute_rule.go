@@ -0,0 +1,421 @@
+package observe
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceMonitorMuteRule() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("monitor_mute_rule", "description"),
+		CreateContext: resourceMonitorMuteRuleCreate,
+		ReadContext:   resourceMonitorMuteRuleRead,
+		UpdateContext: resourceMonitorMuteRuleUpdate,
+		DeleteContext: resourceMonitorMuteRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("monitor_mute_rule", "schema", "name"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("monitor_mute_rule", "schema", "description"),
+			},
+			"start_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateTimestamp,
+				DiffSuppressFunc: diffSuppressTimestamp,
+				Description:      descriptions.Get("monitor_mute_rule", "schema", "start_date"),
+			},
+			"duration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateTimeDuration,
+				DiffSuppressFunc: diffSuppressTimeDuration,
+				Description:      descriptions.Get("monitor_mute_rule", "schema", "duration"),
+			},
+			"monitors": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateMonitorMuteRuleMonitor,
+				},
+				Description: descriptions.Get("monitor_mute_rule", "schema", "monitors"),
+			},
+			"filter_per_column": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"filter_per_resource"},
+				Description:   descriptions.Get("monitor_mute_rule", "schema", "filter_per_column", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_column", "column"),
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_column", "values"),
+						},
+					},
+				},
+			},
+			"filter_per_resource": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"filter_per_column"},
+				Description:   descriptions.Get("monitor_mute_rule", "schema", "filter_per_resource", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_resource", "resource", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dataset": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validateOID(oid.TypeDataset),
+										DiffSuppressFunc: diffSuppressOIDVersion,
+										Description:      descriptions.Get("monitor_mute_rule", "schema", "filter_per_resource", "resource", "dataset"),
+									},
+									"primary_key_value": {
+										Type:        schema.TypeList,
+										Required:    true,
+										MinItems:    1,
+										Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_resource", "resource", "primary_key_value", "description"),
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_resource", "resource", "primary_key_value", "name"),
+												},
+												"value": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: descriptions.Get("monitor_mute_rule", "schema", "filter_per_resource", "resource", "primary_key_value", "value"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			// computed values
+			"filter_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_mute_rule", "schema", "filter_type"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+		},
+	}
+}
+
+// validateMonitorMuteRuleMonitor only accepts monitor OIDs, pointing users of
+// monitor v2 to its own mute schedules
+func validateMonitorMuteRuleMonitor(i interface{}, path cty.Path) diag.Diagnostics {
+	if id, err := oid.NewOID(i.(string)); err == nil && id.Type == oid.TypeMonitorV2 {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "wrong type",
+			Detail:        "mute rules only apply to observe_monitor, use mute_schedule to mute an observe_monitor_v2",
+			AttributePath: path,
+		}}
+	}
+	return validateOID(oid.TypeMonitor)(i, path)
+}
+
+func newMonitorMuteRuleConfig(data *schema.ResourceData) (input *gql.MonitorMuteRuleInput, diags diag.Diagnostics) {
+	workspaceId, _ := oid.NewOID(data.Get("workspace").(string))
+
+	input = &gql.MonitorMuteRuleInput{
+		WorkspaceId: workspaceId.Id,
+		Name:        data.Get("name").(string),
+	}
+
+	if v, ok := data.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("start_date"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		startDate := types.TimeScalar(t.UTC())
+		input.StartDate = &startDate
+	}
+
+	if v, ok := data.GetOk("duration"); ok {
+		input.Duration, _ = types.ParseDurationScalar(v.(string))
+	}
+
+	if _, ok := data.GetOk("filter_per_column"); ok {
+		input.FilterPerColumn = &gql.MonitorMuteRuleFilterPerColumnInput{
+			ColumnID: data.Get("filter_per_column.0.column").(string),
+		}
+		for _, v := range data.Get("filter_per_column.0.values").([]interface{}) {
+			input.FilterPerColumn.Values = append(input.FilterPerColumn.Values, v.(string))
+		}
+	}
+
+	if _, ok := data.GetOk("filter_per_resource"); ok {
+		input.FilterPerResource = &gql.MonitorMuteRuleFilterPerResourceInput{
+			ResourceIds: expandMonitorMuteRuleResources(data.Get("filter_per_resource.0.resource").([]interface{})),
+		}
+	}
+
+	return input, diags
+}
+
+func expandMonitorMuteRuleResources(in []interface{}) []gql.ResourceIdInput {
+	out := make([]gql.ResourceIdInput, 0)
+
+	for _, v := range in {
+		r := v.(map[string]interface{})
+		datasetId, _ := oid.NewOID(r["dataset"].(string))
+
+		resourceId := gql.ResourceIdInput{
+			DatasetId:       datasetId.Id,
+			PrimaryKeyValue: make([]gql.ColumnAndValueInput, 0),
+		}
+		for _, pk := range r["primary_key_value"].([]interface{}) {
+			pk := pk.(map[string]interface{})
+			columnAndValue := gql.ColumnAndValueInput{
+				Name: pk["name"].(string),
+			}
+			if s := pk["value"].(string); s != "" {
+				columnAndValue.Value = stringPtr(s)
+			}
+			resourceId.PrimaryKeyValue = append(resourceId.PrimaryKeyValue, columnAndValue)
+		}
+		out = append(out, resourceId)
+	}
+
+	return out
+}
+
+func monitorMuteRuleMonitors(data *schema.ResourceData) (monitorIds []string) {
+	monitorIds = make([]string, 0)
+	for _, v := range data.Get("monitors").(*schema.Set).List() {
+		id, _ := oid.NewOID(v.(string))
+		monitorIds = append(monitorIds, id.Id)
+	}
+	return monitorIds
+}
+
+func resourceMonitorMuteRuleCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newMonitorMuteRuleConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	result, err := client.CreateMonitorMuteRule(ctx, input, monitorMuteRuleMonitors(data))
+	if err != nil {
+		return diag.Errorf("failed to create monitor mute rule: %s", err.Error())
+	}
+
+	data.SetId(result.Id)
+	return append(diags, resourceMonitorMuteRuleRead(ctx, data, meta)...)
+}
+
+func resourceMonitorMuteRuleUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newMonitorMuteRuleConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	// only replace monitor attachments if they were changed, since they
+	// cannot be read back from the mute rule
+	var monitorIds []string
+	if data.HasChange("monitors") {
+		monitorIds = monitorMuteRuleMonitors(data)
+	}
+
+	_, err := client.UpdateMonitorMuteRule(ctx, data.Id(), input, monitorIds)
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to update monitor mute rule: %s", err.Error())
+	}
+
+	return append(diags, resourceMonitorMuteRuleRead(ctx, data, meta)...)
+}
+
+func resourceMonitorMuteRuleRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	muteRule, err := client.GetMonitorMuteRule(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read monitor mute rule: %s", err.Error())
+	}
+
+	return monitorMuteRuleToResourceData(muteRule, data)
+}
+
+func monitorMuteRuleToResourceData(muteRule *gql.MonitorMuteRule, data *schema.ResourceData) (diags diag.Diagnostics) {
+	if err := data.Set("workspace", oid.WorkspaceOid(muteRule.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("name", muteRule.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("icon_url", muteRule.IconUrl); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("description", muteRule.Description); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if muteRule.StartDate != nil {
+		if err := data.Set("start_date", muteRule.StartDate.String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if muteRule.Duration != nil {
+		if err := data.Set("duration", muteRule.Duration.String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	} else if err := data.Set("duration", nil); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var (
+		filterType        = gql.MonitorMuteRuleFilterTypeFilterall
+		filterPerColumn   []interface{}
+		filterPerResource []interface{}
+	)
+
+	// a rule without a filter mutes every alarm, like filter_all
+	if muteRule.Filter != nil {
+		switch filter := (*muteRule.Filter).(type) {
+		case *gql.MonitorMuteRuleFilterMonitorMuteRuleFilterPerColumn:
+			filterType = filter.FilterType
+			filterPerColumn = []interface{}{
+				map[string]interface{}{
+					"column": filter.ColumnID,
+					"values": filter.Values,
+				},
+			}
+		case *gql.MonitorMuteRuleFilterMonitorMuteRuleFilterPerResource:
+			filterType = filter.FilterType
+			filterPerResource = []interface{}{
+				map[string]interface{}{
+					"resource": flattenMonitorMuteRuleResources(filter.ResourceIds),
+				},
+			}
+		}
+	}
+
+	if err := data.Set("filter_type", toSnake(string(filterType))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("filter_per_column", filterPerColumn); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("filter_per_resource", filterPerResource); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oid", muteRule.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func flattenMonitorMuteRuleResources(resourceIds []gql.MonitorMuteRuleResourceId) []interface{} {
+	var out []interface{}
+
+	for _, resourceId := range resourceIds {
+		var primaryKeyValues []interface{}
+		for _, pk := range resourceId.PrimaryKeyValue {
+			primaryKeyValue := map[string]interface{}{
+				"name": pk.Name,
+			}
+			if pk.Value != nil {
+				primaryKeyValue["value"] = *pk.Value
+			}
+			primaryKeyValues = append(primaryKeyValues, primaryKeyValue)
+		}
+		out = append(out, map[string]interface{}{
+			"dataset":           oid.DatasetOid(resourceId.DatasetId).String(),
+			"primary_key_value": primaryKeyValues,
+		})
+	}
+
+	return out
+}
+
+func resourceMonitorMuteRuleDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if err := client.DeleteMonitorMuteRule(ctx, data.Id()); err != nil {
+		return diag.Errorf("failed to delete monitor mute rule: %s", err.Error())
+	}
+	return diags
+}
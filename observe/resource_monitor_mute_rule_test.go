@@ -0,0 +1,103 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+func TestAccObserveMonitorMuteRule(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_monitor_mute_rule" "first" {
+						workspace   = data.observe_workspace.default.oid
+						name        = "%[1]s"
+						description = "muted for maintenance"
+						start_date  = "2030-01-01T00:00:00Z"
+						duration    = "2h"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_monitor_mute_rule.first", "oid"),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.first", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.first", "description", "muted for maintenance"),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.first", "start_date", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.first", "duration", "2h0m0s"),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.first", "filter_type", "filter_all"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_monitor_mute_rule" "first" {
+						workspace  = data.observe_workspace.default.oid
+						name       = "%[1]s"
+						start_date = "2030-01-01T00:00:00Z"
+
+						filter_per_column {
+							column = "host"
+							values = ["a", "b"]
+						}
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.first", "description", ""),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.first", "duration", ""),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.first", "filter_type", "filter_per_column"),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.first", "filter_per_column.0.column", "host"),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.first", "filter_per_column.0.values.#", "2"),
+					resource.TestCheckResourceAttr("observe_monitor_mute_rule.first", "filter_per_column.0.values.1", "b"),
+				),
+			},
+			{
+				ResourceName:            "observe_monitor_mute_rule.first",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"monitors"},
+			},
+		},
+	})
+}
+
+func TestMonitorMuteRuleToResourceDataWithoutFilter(t *testing.T) {
+	data := schema.TestResourceDataRaw(t, resourceMonitorMuteRule().Schema, map[string]interface{}{})
+
+	diags := monitorMuteRuleToResourceData(&gql.MonitorMuteRule{
+		Id:          "1",
+		WorkspaceId: "2",
+		Name:        "test",
+	}, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := data.Get("filter_type").(string); got != "filter_all" {
+		t.Fatalf("expected filter_all, got %q", got)
+	}
+}
+
+func TestValidateMonitorMuteRuleMonitor(t *testing.T) {
+	for _, tt := range []struct {
+		input string
+		valid bool
+	}{
+		{input: "o:::monitor:41000001", valid: true},
+		{input: "o:::monitorv2:41000001", valid: false},
+		{input: "o:::dataset:41000001", valid: false},
+	} {
+		diags := validateMonitorMuteRuleMonitor(tt.input, cty.Path{})
+		if diags.HasError() == tt.valid {
+			t.Errorf("unexpected validation result for %s: %v", tt.input, diags)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package observe
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -26,6 +27,7 @@ func resourceMonitorV2() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeDiffCheckQuery,
 		Schema: map[string]*schema.Schema{
 			// needed as input to MonitorV2Create, also part of MonitorV2 struct
 			"workspace": { // ObjectId!
@@ -274,6 +276,56 @@ func resourceMonitorV2() *schema.Resource {
 				},
 				Description: descriptions.Get("monitorv2", "schema", "actions", "description"),
 			},
+			// the following field describes mute rules bound to this monitor.
+			"mute_schedule": { // [MonitorV2MuteRuleInput!]
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": { // String!
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: descriptions.Get("monitorv2", "schema", "mute_schedule", "name"),
+						},
+						"description": { // String
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("monitorv2", "schema", "mute_schedule", "mute_description"),
+						},
+						"one_time": { // MonitorV2OneTimeMuteScheduleInput
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_time": { // Time!
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validateTimestamp,
+										DiffSuppressFunc: diffSuppressTimestamp,
+										Description:      descriptions.Get("monitorv2", "schema", "mute_schedule", "one_time", "start_time"),
+									},
+									"end_time": { // Time
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: validateTimestamp,
+										DiffSuppressFunc: diffSuppressTimestamp,
+										Description:      descriptions.Get("monitorv2", "schema", "mute_schedule", "one_time", "end_time"),
+									},
+								},
+							},
+							Description: descriptions.Get("monitorv2", "schema", "mute_schedule", "one_time", "description"),
+						},
+						"oid": { // ObjectId!
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitorv2", "schema", "mute_schedule", "oid"),
+						},
+					},
+				},
+				Description: descriptions.Get("monitorv2", "schema", "mute_schedule", "description"),
+			},
 			// the following fields are those that aren't given as input to CU ops, but can be read by R ops.
 			"oid": { // ObjectId!
 				Type:     schema.TypeString,
@@ -437,6 +489,11 @@ func resourceMonitorV2Create(ctx context.Context, data *schema.ResourceData, met
 	}
 
 	data.SetId(result.Id)
+
+	if err := saveMonitorV2MuteSchedules(ctx, result.Id, data, client); err != nil {
+		return append(diags, diag.Errorf("failed to create monitor mute schedule: %s", err.Error())...)
+	}
+	diags = append(diags, checkQueryWarnings(ctx, data, meta)...)
 	return append(diags, resourceMonitorV2Read(ctx, data, meta)...)
 }
 
@@ -465,6 +522,11 @@ func resourceMonitorV2Update(ctx context.Context, data *schema.ResourceData, met
 		return diag.Errorf("failed to update monitor: %s", err.Error())
 	}
 
+	if err := saveMonitorV2MuteSchedules(ctx, data.Id(), data, client); err != nil {
+		return diag.Errorf("failed to update monitor mute schedule: %s", err.Error())
+	}
+
+	diags = append(diags, checkQueryWarnings(ctx, data, meta)...)
 	return append(diags, resourceMonitorV2Read(ctx, data, meta)...)
 }
 
@@ -542,11 +604,26 @@ func resourceMonitorV2Read(ctx context.Context, data *schema.ResourceData, meta
 		}
 	}
 
+	if len(monitor.Mutes) > 0 || len(data.Get("mute_schedule").([]interface{})) > 0 {
+		if err := data.Set("mute_schedule", monitorV2FlattenMuteSchedules(monitor.Mutes, data)); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
 	return diags
 }
 
 func resourceMonitorV2Delete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*observe.Client)
+	for _, v := range data.Get("mute_schedule").([]interface{}) {
+		muteOID, err := oid.NewOID(v.(map[string]interface{})["oid"].(string))
+		if err != nil {
+			continue
+		}
+		if err := client.DeleteMonitorV2MuteRule(ctx, muteOID.Id); err != nil && !gql.HasErrorCode(err, "NOT_FOUND") {
+			return diag.Errorf("failed to delete monitor mute schedule: %s", err.Error())
+		}
+	}
 	if err := client.DeleteMonitorV2(ctx, data.Id()); err != nil {
 		return diag.Errorf("failed to delete monitor: %s", err.Error())
 	}
@@ -1195,6 +1272,147 @@ func relateMonitorV2ToActions(ctx context.Context, monitorId string, data *schem
 	return client.SaveMonitorV2Relations(ctx, monitorId, actionRelations)
 }
 
+// saveMonitorV2MuteSchedules reconciles the mute rules bound to a monitor with
+// the configured mute_schedule blocks. Blocks which already have an oid are
+// updated in place, new blocks are created, and rules which are no longer
+// configured are deleted. On failure, rules created by this call are deleted
+// again, since their IDs would otherwise never be recorded in state.
+func saveMonitorV2MuteSchedules(ctx context.Context, monitorId string, data *schema.ResourceData, client *observe.Client) (err error) {
+	workspaceID, _ := oid.NewOID(data.Get("workspace").(string))
+
+	var created []string
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, id := range created {
+			if deleteErr := client.DeleteMonitorV2MuteRule(ctx, id); deleteErr != nil && !gql.HasErrorCode(deleteErr, "NOT_FOUND") {
+				err = fmt.Errorf("%w (failed to clean up mute rule %s: %s)", err, id, deleteErr)
+			}
+		}
+	}()
+
+	keep := make(map[string]bool)
+	for i := range data.Get("mute_schedule").([]interface{}) {
+		path := fmt.Sprintf("mute_schedule.%d.", i)
+		input, err := newMonitorV2MuteRuleInput(path, monitorId, data)
+		if err != nil {
+			return err
+		}
+
+		if muteOID, err := oid.NewOID(data.Get(path + "oid").(string)); err == nil {
+			if _, err := client.UpdateMonitorV2MuteRule(ctx, muteOID.Id, input); err == nil {
+				keep[muteOID.Id] = true
+				continue
+			} else if !gql.HasErrorCode(err, "NOT_FOUND") {
+				return err
+			}
+		}
+
+		result, err := client.CreateMonitorV2MuteRule(ctx, workspaceID.Id, input)
+		if err != nil {
+			return err
+		}
+		created = append(created, result.Id)
+		keep[result.Id] = true
+	}
+
+	prv, _ := data.GetChange("mute_schedule")
+	for _, v := range prv.([]interface{}) {
+		muteOID, err := oid.NewOID(v.(map[string]interface{})["oid"].(string))
+		if err != nil || keep[muteOID.Id] {
+			continue
+		}
+		if err := client.DeleteMonitorV2MuteRule(ctx, muteOID.Id); err != nil && !gql.HasErrorCode(err, "NOT_FOUND") {
+			return err
+		}
+	}
+	return nil
+}
+
+func newMonitorV2MuteRuleInput(path string, monitorId string, data *schema.ResourceData) (*gql.MonitorV2MuteRuleInput, error) {
+	// required
+	startTime, err := time.Parse(time.RFC3339, data.Get(path+"one_time.0.start_time").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	name := data.Get(path + "name").(string)
+	if name == "" {
+		name = fmt.Sprintf("%s (%s)", data.Get("name").(string), startTime.Format(time.RFC3339))
+	}
+
+	// instantiation
+	mute := &gql.MonitorV2MuteRuleInput{
+		Name:      name,
+		MonitorID: &monitorId,
+		Schedule: gql.MonitorV2MuteRuleScheduleInput{
+			Type: gql.MonitorV2MuteScheduleTypeOnetime,
+			OneTime: &gql.MonitorV2OneTimeMuteScheduleInput{
+				StartTime: types.TimeScalar(startTime),
+			},
+		},
+	}
+
+	// optional
+	if v, ok := data.GetOk(path + "one_time.0.end_time"); ok {
+		endTime, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, err
+		}
+		mute.Schedule.OneTime.EndTime = (*types.TimeScalar)(&endTime)
+	}
+	if v, ok := data.GetOk(path + "description"); ok {
+		mute.Description = stringPtr(v.(string))
+	}
+
+	return mute, nil
+}
+
+// monitorV2FlattenMuteSchedules preserves the order of mute rules already in
+// state, appending any others in the order returned by the API.
+func monitorV2FlattenMuteSchedules(gqlMutes []gql.MonitorV2MuteRule, data *schema.ResourceData) []interface{} {
+	position := make(map[string]int)
+	for i, v := range data.Get("mute_schedule").([]interface{}) {
+		if m, ok := v.(map[string]interface{}); ok {
+			position[m["oid"].(string)] = i
+		}
+	}
+
+	sorted := make([]gql.MonitorV2MuteRule, len(gqlMutes))
+	copy(sorted, gqlMutes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		pi, iok := position[sorted[i].Oid().String()]
+		pj, jok := position[sorted[j].Oid().String()]
+		if iok && jok {
+			return pi < pj
+		}
+		return iok && !jok
+	})
+
+	mutes := make([]interface{}, 0, len(sorted))
+	for _, gqlMute := range sorted {
+		mute := map[string]interface{}{
+			"name": gqlMute.Name,
+			"oid":  gqlMute.Oid().String(),
+		}
+		if gqlMute.Description != nil {
+			mute["description"] = *gqlMute.Description
+		}
+		if oneTime := gqlMute.Schedule.OneTime; oneTime != nil {
+			schedule := map[string]interface{}{
+				"start_time": oneTime.StartTime.String(),
+			}
+			if oneTime.EndTime != nil {
+				schedule["end_time"] = oneTime.EndTime.String()
+			}
+			mute["one_time"] = []interface{}{schedule}
+		}
+		mutes = append(mutes, mute)
+	}
+	return mutes
+}
+
 func newMonitorV2ActionRuleInput(path string, data *schema.ResourceData) (*gql.MonitorV2ActionRuleInput, error) {
 	// required
 	actOID, err := oid.NewOID(data.Get(fmt.Sprintf("%soid", path)).(string))
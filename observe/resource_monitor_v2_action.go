@@ -22,6 +22,12 @@ func resourceMonitorV2Action() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validateMonitorV2ActionDestination(d.Get("type").(string), func(key string) bool {
+				_, ok := d.GetOk(key)
+				return ok
+			})
+		},
 		Schema: map[string]*schema.Schema{
 			// needed as input to CreateMonitorV2Action
 			"workspace": { // ObjectId!
@@ -40,16 +46,30 @@ func resourceMonitorV2Action() *schema.Resource {
 				Type:         schema.TypeList,
 				MaxItems:     1,
 				Optional:     true,
-				ExactlyOneOf: []string{"email", "webhook"},
+				ExactlyOneOf: monitorV2ActionDestinations,
 				Elem:         monitorV2EmailActionInput(),
 			},
 			"webhook": { // MonitorV2WebhookDestinationInput
 				Type:         schema.TypeList,
 				MaxItems:     1,
 				Optional:     true,
-				ExactlyOneOf: []string{"email", "webhook"},
+				ExactlyOneOf: monitorV2ActionDestinations,
 				Elem:         monitorV2WebhookActionInput(),
 			},
+			"pagerduty": { // compiled to MonitorV2WebhookDestinationInput
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: monitorV2ActionDestinations,
+				Elem:         monitorV2PagerDutyActionInput(),
+			},
+			"slack": { // compiled to MonitorV2WebhookDestinationInput
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: monitorV2ActionDestinations,
+				Elem:         monitorV2SlackActionInput(),
+			},
 			"name": { // String!
 				Type:     schema.TypeString,
 				Required: true,
@@ -232,7 +252,22 @@ func resourceMonitorV2ActionRead(ctx context.Context, data *schema.ResourceData,
 	}
 
 	if action.Webhook != nil {
-		if err := data.Set("webhook", monitorV2FlattenWebhookAction(*action.Webhook)); err != nil {
+		key, value := "webhook", monitorV2FlattenWebhookAction(*action.Webhook)
+		// typed destinations are stored as webhooks, so only surface them as
+		// such if the configuration is not already using a raw webhook block
+		if _, ok := data.GetOk("webhook"); !ok {
+			switch action.Type {
+			case gql.MonitorV2ActionTypePagerduty:
+				if pagerduty, ok := monitorV2FlattenPagerDutyAction(*action.Webhook); ok {
+					key, value = "pagerduty", pagerduty
+				}
+			case gql.MonitorV2ActionTypeSlack:
+				if slack, ok := monitorV2FlattenSlackAction(*action.Webhook); ok {
+					key, value = "slack", slack
+				}
+			}
+		}
+		if err := data.Set(key, value); err != nil {
 			diags = append(diags, diag.FromErr(err)...)
 		}
 	}
@@ -334,6 +369,20 @@ func newMonitorV2ActionInput(data *schema.ResourceData) (input *gql.MonitorV2Act
 		}
 		input.Webhook = webhook
 	}
+	if _, ok := data.GetOk("pagerduty"); ok {
+		webhook, diags := newMonitorV2PagerDutyActionInput(data, "pagerduty.0.")
+		if diags.HasError() {
+			return nil, diags
+		}
+		input.Webhook = webhook
+	}
+	if _, ok := data.GetOk("slack"); ok {
+		webhook, diags := newMonitorV2SlackActionInput(data, "slack.0.")
+		if diags.HasError() {
+			return nil, diags
+		}
+		input.Webhook = webhook
+	}
 	if v, ok := data.GetOk("description"); ok {
 		description := v.(string)
 		input.Description = &description
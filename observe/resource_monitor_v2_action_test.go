@@ -2,6 +2,7 @@ package observe
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -176,6 +177,64 @@ func TestAccObserveMonitorV2ActionWebhook(t *testing.T) {
 	})
 }
 
+func TestAccObserveMonitorV2ActionPagerDutySlack(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(monitorV2ConfigPreamble+`
+					resource "observe_monitor_v2_action" "pagerduty" {
+						workspace = data.observe_workspace.default.oid
+						type = "pager_duty"
+						pagerduty {
+							routing_key = "R0UT1NGK3Y"
+							severity = "warning"
+							dedup_key = "{{monitor.name}}"
+						}
+						name = "%[1]s-pagerduty"
+					}
+
+					resource "observe_monitor_v2_action" "slack" {
+						workspace = data.observe_workspace.default.oid
+						type = "slack"
+						slack {
+							url = "https://hooks.slack.com/services/T000/B000/XXXX"
+							channel = "#alerts"
+						}
+						name = "%[1]s-slack"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_monitor_v2_action.pagerduty", "type", "pager_duty"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_action.pagerduty", "pagerduty.0.routing_key", "R0UT1NGK3Y"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_action.pagerduty", "pagerduty.0.severity", "warning"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_action.pagerduty", "pagerduty.0.dedup_key", "{{monitor.name}}"),
+					resource.TestCheckNoResourceAttr("observe_monitor_v2_action.pagerduty", "webhook.0.url"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_action.slack", "type", "slack"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_action.slack", "slack.0.url", "https://hooks.slack.com/services/T000/B000/XXXX"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_action.slack", "slack.0.channel", "#alerts"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(monitorV2ConfigPreamble+`
+					resource "observe_monitor_v2_action" "pagerduty" {
+						workspace = data.observe_workspace.default.oid
+						type = "slack"
+						pagerduty {
+							routing_key = "R0UT1NGK3Y"
+						}
+						name = "%[1]s-pagerduty"
+					}
+				`, randomPrefix),
+				ExpectError: regexp.MustCompile(`pagerduty block requires type to be "pager_duty"`),
+			},
+		},
+	})
+}
+
 func TestAccObserveMonitorV2MultipleActionsEmail(t *testing.T) {
 	randomPrefix := acctest.RandomWithPrefix("tf")
 
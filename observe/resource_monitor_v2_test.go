@@ -182,3 +182,94 @@ func TestAccObserveMonitorV2Promote(t *testing.T) {
 		},
 	})
 }
+
+func TestAccObserveMonitorV2MuteSchedule(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	config := monitorV2ConfigPreamble + `
+		resource "observe_monitor_v2" "first" {
+			workspace = data.observe_workspace.default.oid
+			rule_kind = "count"
+			name = "%[1]s"
+			lookback_time = "30m"
+			inputs = {
+				"test" = observe_datastream.test.dataset
+			}
+			stage {
+				pipeline = <<-EOF
+					filter true
+				EOF
+			}
+			rules {
+				level = "informational"
+				count {
+					compare_values {
+						compare_fn = "greater"
+						value_int64 = [0]
+					}
+				}
+			}
+			scheduling {
+				transform {
+					freshness_goal = "15m"
+				}
+			}
+			%[2]s
+		}
+	`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, randomPrefix, `
+					mute_schedule {
+						description = "maintenance"
+						one_time {
+							start_time = "2030-01-01T00:00:00Z"
+							end_time   = "2030-01-01T02:00:00Z"
+						}
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_monitor_v2.first", "mute_schedule.#", "1"),
+					resource.TestCheckResourceAttr("observe_monitor_v2.first", "mute_schedule.0.name", randomPrefix+" (2030-01-01T00:00:00Z)"),
+					resource.TestCheckResourceAttr("observe_monitor_v2.first", "mute_schedule.0.description", "maintenance"),
+					resource.TestCheckResourceAttr("observe_monitor_v2.first", "mute_schedule.0.one_time.0.start_time", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("observe_monitor_v2.first", "mute_schedule.0.one_time.0.end_time", "2030-01-01T02:00:00Z"),
+					resource.TestCheckResourceAttrSet("observe_monitor_v2.first", "mute_schedule.0.oid"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, randomPrefix, `
+					mute_schedule {
+						name = "%[1]s-window"
+						one_time {
+							start_time = "2030-01-01T00:00:00Z"
+							end_time   = "2030-01-01T04:00:00Z"
+						}
+					}
+					mute_schedule {
+						one_time {
+							start_time = "2030-02-01T00:00:00Z"
+						}
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_monitor_v2.first", "mute_schedule.#", "2"),
+					resource.TestCheckResourceAttr("observe_monitor_v2.first", "mute_schedule.0.name", randomPrefix+"-window"),
+					resource.TestCheckResourceAttr("observe_monitor_v2.first", "mute_schedule.0.one_time.0.end_time", "2030-01-01T04:00:00Z"),
+					resource.TestCheckResourceAttr("observe_monitor_v2.first", "mute_schedule.1.one_time.0.start_time", "2030-02-01T00:00:00Z"),
+					resource.TestCheckNoResourceAttr("observe_monitor_v2.first", "mute_schedule.1.one_time.0.end_time"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(config, randomPrefix, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_monitor_v2.first", "mute_schedule.#", "0"),
+				),
+			},
+		},
+	})
+}
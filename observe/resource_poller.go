@@ -22,6 +22,7 @@ var pollerBlockTypes = []string{
 	"http",
 	"gcp_monitoring",
 	"mongodbatlas",
+	"confluent_cloud",
 	"cloudwatch_metrics",
 	"aws_snapshot",
 }
@@ -384,6 +385,29 @@ func resourcePoller() *schema.Resource {
 					},
 				},
 			},
+			"confluent_cloud": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: pollerBlockTypes,
+				RequiredWith: []string{"interval", "datastream"},
+				Description:  descriptions.Get("poller", "schema", "confluent_cloud", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("poller", "schema", "confluent_cloud", "key"),
+						},
+						"secret": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: descriptions.Get("poller", "schema", "confluent_cloud", "secret"),
+						},
+					},
+				},
+			},
 			"cloudwatch_metrics": {
 				Type:         schema.TypeList,
 				Optional:     true,
@@ -643,6 +667,12 @@ func newPollerConfig(data *schema.ResourceData) (input *gql.PollerInput, diags d
 			ExcludeGroups: makeStrSlice(data.Get("mongodbatlas.0.exclude_groups").([]interface{})),
 		}
 	}
+	if data.Get("confluent_cloud.#") == 1 {
+		input.ConfluentCloudConfig = &gql.PollerConfluentCloudInput{
+			Key:    data.Get("confluent_cloud.0.key").(string),
+			Secret: data.Get("confluent_cloud.0.secret").(string),
+		}
+	}
 	if data.Get("cloudwatch_metrics.#") == 1 {
 		m := &gql.PollerCloudWatchMetricsInput{
 			Region:        data.Get("cloudwatch_metrics.0.region").(string),
@@ -901,6 +931,15 @@ func resourcePollerRead(ctx context.Context, data *schema.ResourceData, meta int
 			diags = append(diags, diag.FromErr(err)...)
 		}
 	}
+	if confluentCloudConfig, ok := config.(*gql.PollerConfigPollerConfluentCloudConfig); ok {
+		cfg := map[string]interface{}{
+			"key":    confluentCloudConfig.Key,
+			"secret": confluentCloudConfig.Secret,
+		}
+		if err := data.Set("confluent_cloud", []interface{}{cfg}); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
 	if cloudWatchMetricsConfig, ok := config.(*gql.PollerConfigPollerCloudWatchMetricsConfig); ok {
 		var queries []map[string]any
 		for _, q := range cloudWatchMetricsConfig.Queries {
@@ -258,6 +258,75 @@ func TestAccObservePollerMongoDB(t *testing.T) {
 	})
 }
 
+func TestAccObservePollerConfluentCloud(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_datastream" "example" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%s-%s"
+					icon_url  = "test"
+				}
+				resource "observe_poller" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%s"
+					interval  = "1m"
+					datastream = observe_datastream.example.oid
+					skip_external_validation = true
+
+					tags = {
+						"k1"   = "v1"
+					}
+					confluent_cloud {
+						key    = "test"
+						secret = "test"
+					}
+				}`, randomPrefix, "pollers", randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_poller.first", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_poller.first", "kind", "ConfluentCloud"),
+					resource.TestCheckResourceAttr("observe_poller.first", "interval", "1m0s"),
+					resource.TestCheckResourceAttr("observe_poller.first", "tags.k1", "v1"),
+					resource.TestCheckResourceAttr("observe_poller.first", "confluent_cloud.0.key", "test"),
+					resource.TestCheckResourceAttr("observe_poller.first", "confluent_cloud.0.secret", "test"),
+					resource.TestCheckResourceAttr("observe_poller.first", "mongodbatlas.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_datastream" "example" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%s-%s"
+					icon_url  = "test"
+				}
+				resource "observe_poller" "first" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%s"
+					interval  = "5m"
+					datastream = observe_datastream.example.oid
+					skip_external_validation = true
+
+					confluent_cloud {
+						key    = "test-2"
+						secret = "test-2"
+					}
+				}`, randomPrefix, "pollers", randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_poller.first", "kind", "ConfluentCloud"),
+					resource.TestCheckResourceAttr("observe_poller.first", "interval", "5m0s"),
+					resource.TestCheckResourceAttr("observe_poller.first", "confluent_cloud.0.key", "test-2"),
+					resource.TestCheckResourceAttr("observe_poller.first", "confluent_cloud.0.secret", "test-2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccObservePollerHTTP(t *testing.T) {
 	randomPrefix := acctest.RandomWithPrefix("tf")
 
@@ -0,0 +1,243 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceReferenceTable() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("reference_table", "description"),
+		CreateContext: resourceReferenceTableCreate,
+		ReadContext:   resourceReferenceTableRead,
+		UpdateContext: resourceReferenceTableUpdate,
+		DeleteContext: resourceReferenceTableDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceReferenceTableImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("reference_table", "schema", "name"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("reference_table", "schema", "description"),
+			},
+			"source_file": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("reference_table", "schema", "source_file"),
+			},
+			"checksum": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("reference_table", "schema", "checksum"),
+			},
+			"primary_key": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("reference_table", "schema", "primary_key"),
+			},
+			"schema": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: descriptions.Get("reference_table", "schema", "schema", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("reference_table", "schema", "schema", "name"),
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions.Get("reference_table", "schema", "schema", "type"),
+						},
+					},
+				},
+			},
+			// computed values
+			"dataset": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("reference_table", "schema", "dataset"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+		},
+	}
+}
+
+func newReferenceTableConfig(data *schema.ResourceData, upload bool) (input *gql.ReferenceTableInput, diags diag.Diagnostics) {
+	input = &gql.ReferenceTableInput{
+		Name: stringPtr(data.Get("name").(string)),
+	}
+
+	if v, ok := data.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	if !upload {
+		return input, diags
+	}
+
+	path := data.Get("source_file").(string)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, diag.Errorf("failed to read source file: %s", err.Error())
+	}
+	input.Upload = &types.UploadScalar{
+		Filename: filepath.Base(path),
+		Content:  content,
+	}
+
+	for _, v := range data.Get("primary_key").([]interface{}) {
+		input.PrimaryKey = append(input.PrimaryKey, v.(string))
+	}
+
+	for _, v := range data.Get("schema").([]interface{}) {
+		field := v.(map[string]interface{})
+		input.Schema = append(input.Schema, gql.DatasetFieldDefInput{
+			Name: field["name"].(string),
+			Type: gql.DatasetFieldTypeInput{
+				Rep: field["type"].(string),
+			},
+		})
+	}
+
+	return input, diags
+}
+
+func resourceReferenceTableCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newReferenceTableConfig(data, true)
+	if diags.HasError() {
+		return diags
+	}
+
+	workspaceId, _ := oid.NewOID(data.Get("workspace").(string))
+	result, err := client.CreateReferenceTable(ctx, workspaceId.Id, input)
+	if err != nil {
+		return diag.Errorf("failed to create reference table: %s", err.Error())
+	}
+
+	data.SetId(result.Id)
+	return append(diags, resourceReferenceTableRead(ctx, data, meta)...)
+}
+
+func resourceReferenceTableUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	// only upload the file again if the table contents may have changed
+	upload := data.HasChanges("source_file", "checksum", "primary_key", "schema")
+
+	input, diags := newReferenceTableConfig(data, upload)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := client.UpdateReferenceTable(ctx, data.Id(), input)
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to update reference table: %s", err.Error())
+	}
+
+	return append(diags, resourceReferenceTableRead(ctx, data, meta)...)
+}
+
+func resourceReferenceTableRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	table, err := client.GetReferenceTable(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read reference table: %s", err.Error())
+	}
+
+	if err := data.Set("workspace", oid.WorkspaceOid(table.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("name", table.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("icon_url", table.IconUrl); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("description", table.Description); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("dataset", oid.DatasetOid(table.DatasetID).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oid", table.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceReferenceTableDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if err := client.DeleteReferenceTable(ctx, data.Id()); err != nil {
+		return diag.Errorf("failed to delete reference table: %s", err.Error())
+	}
+	return diags
+}
+
+// resourceReferenceTableImport accepts either a reference table ID or OID
+func resourceReferenceTableImport(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if id, err := oid.NewOID(data.Id()); err == nil {
+		if id.Type != oid.TypeReferenceTable {
+			return nil, fmt.Errorf("expected OID of type %q, got %q", oid.TypeReferenceTable, id.Type)
+		}
+		data.SetId(id.Id)
+	}
+	return []*schema.ResourceData{data}, nil
+}
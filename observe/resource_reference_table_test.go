@@ -0,0 +1,81 @@
+package observe
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccObserveReferenceTable(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.csv")
+	second := filepath.Join(dir, "second.csv")
+	if err := os.WriteFile(first, []byte("service,owner\napi,alice\nweb,bob\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(second, []byte("service,owner\napi,alice\nweb,carol\ndb,dave\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(path string) string {
+		return fmt.Sprintf(configPreamble+`
+			resource "observe_reference_table" "example" {
+				workspace   = data.observe_workspace.default.oid
+				name        = "%[1]s"
+				description = "service owners"
+				source_file = "%[2]s"
+				checksum    = filemd5("%[2]s")
+				primary_key = ["service"]
+
+				schema {
+					name = "service"
+					type = "string"
+				}
+
+				schema {
+					name = "owner"
+					type = "string"
+				}
+			}
+		`, randomPrefix, path)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config(first),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_reference_table.example", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_reference_table.example", "description", "service owners"),
+					resource.TestCheckResourceAttrSet("observe_reference_table.example", "dataset"),
+					resource.TestCheckResourceAttrSet("observe_reference_table.example", "oid"),
+				),
+			},
+			{
+				Config: config(second),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_reference_table.example", "source_file", second),
+					resource.TestCheckResourceAttrSet("observe_reference_table.example", "dataset"),
+				),
+			},
+			{
+				ResourceName: "observe_reference_table.example",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["observe_reference_table.example"].Primary.Attributes["oid"], nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_file", "checksum", "primary_key", "schema"},
+			},
+		},
+	})
+}
@@ -11,6 +11,7 @@ import (
 	gql "github.com/observeinc/terraform-provider-observe/client/meta"
 	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
 )
 
 var sourceDatasetFieldResource = &schema.Resource{
@@ -131,6 +132,12 @@ func resourceSourceDataset() *schema.Resource {
 				ValidateDiagFunc: validateTimeDuration,
 				DiffSuppressFunc: diffSuppressDuration,
 			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions.Get("dataset", "schema", "deletion_protection"),
+			},
 		},
 	}
 }
@@ -381,6 +388,9 @@ func resourceSourceDatasetUpdate(ctx context.Context, data *schema.ResourceData,
 
 func resourceSourceDatasetDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*observe.Client)
+	if diags := checkDatasetDeletionProtection(ctx, client, data); diags.HasError() {
+		return diags
+	}
 	if err := client.DeleteDataset(ctx, data.Id()); err != nil {
 		return diag.Errorf("failed to delete dataset: %s", err)
 	}
@@ -54,6 +54,7 @@ func resourceWorksheet() *schema.Resource {
 				Required:         true,
 				ValidateDiagFunc: validateStringIsJSON,
 				DiffSuppressFunc: diffSuppressStageQueryInput,
+				StateFunc:        stateNormalizedJSON,
 				Description:      schemaWorksheetJSONDescription,
 			},
 			"oid": {
@@ -80,6 +81,7 @@ func newWorksheetConfig(data *schema.ResourceData) (input *gql.WorksheetInput, d
 			diagErr := fmt.Errorf("failed to parse 'queries' request field: %w", err)
 			diags = append(diags, diag.FromErr(diagErr)...)
 		}
+		denormalizeStageInputs(input.Stages)
 	}
 	return input, diags
 }
@@ -96,26 +98,10 @@ func worksheetToResourceData(d *gql.Worksheet, data *schema.ResourceData) (diags
 	}
 
 	if d.Stages != nil {
-		// Hack hack hack hack hack
-		for i, stage := range d.Stages {
-			if stage.Id != nil && *stage.Id == "" {
-				d.Stages[i].Id = nil
-			}
-			for j, input := range stage.Input {
-				if input.StageId != nil && *input.StageId == "" {
-					d.Stages[i].Input[j].StageId = nil
-				}
-			}
-			if stage.Params != nil && *stage.Params == types.JsonObject("null") {
-				d.Stages[i].Params = nil
-			} else if stage.Params != nil && string(*stage.Params) == "" {
-				d.Stages[i].Params = nil
-			}
-		}
-		if stagesRaw, err := json.Marshal(d.Stages); err != nil {
+		if stagesRaw, err := stageQueriesToJSON(d.Stages); err != nil {
 			diagErr := fmt.Errorf("failed to parse 'stages' response field: %w", err)
 			diags = append(diags, diag.FromErr(diagErr)...)
-		} else if err := data.Set("queries", string(stagesRaw)); err != nil {
+		} else if err := data.Set("queries", normalizeJSONOrOriginal(stagesRaw)); err != nil {
 			diags = append(diags, diag.FromErr(err)...)
 		}
 	}
@@ -195,3 +181,29 @@ func resourceWorksheetDelete(ctx context.Context, data *schema.ResourceData, met
 	}
 	return diags
 }
+
+// stageQueriesToJSON serializes stages returned by the API so that they can be
+// compared against user provided StageQueryInput JSON
+func stageQueriesToJSON(stages []gql.StageQuery) (string, error) {
+	// Hack hack hack hack hack
+	for i, stage := range stages {
+		if stage.Id != nil && *stage.Id == "" {
+			stages[i].Id = nil
+		}
+		for j, input := range stage.Input {
+			if input.StageId != nil && *input.StageId == "" {
+				stages[i].Input[j].StageId = nil
+			}
+		}
+		if stage.Params != nil && *stage.Params == types.JsonObject("null") {
+			stages[i].Params = nil
+		} else if stage.Params != nil && string(*stage.Params) == "" {
+			stages[i].Params = nil
+		}
+	}
+	stagesRaw, err := json.Marshal(stages)
+	if err != nil {
+		return "", err
+	}
+	return string(stagesRaw), nil
+}
@@ -0,0 +1,316 @@
+package observe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/binding"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type terraformExporter struct {
+	resourceType string
+	resource     func() *schema.Resource
+	dataSource   func() *schema.Resource
+}
+
+// terraformExporters lists object types for which terraform configuration is
+// generated client side, since the terraform API does not support them.
+var terraformExporters = map[oid.Type]terraformExporter{
+	oid.TypeWorksheet:       {"observe_worksheet", resourceWorksheet, dataSourceWorksheet},
+	oid.TypeMonitorV2:       {"observe_monitor_v2", resourceMonitorV2, dataSourceMonitorV2},
+	oid.TypeMonitorV2Action: {"observe_monitor_v2_action", resourceMonitorV2Action, dataSourceMonitorV2Action},
+	oid.TypePoller:          {"observe_poller", resourcePoller, nil},
+	oid.TypeDatastream:      {"observe_datastream", resourceDatastream, dataSourceDatastream},
+	oid.TypeRbacStatement:   {"observe_rbac_statement", resourceRbacStatement, nil},
+}
+
+// exportTerraform generates terraform configuration for an object by reading
+// it through the matching resource, and rendering the resulting state as HCL.
+// Computed and sensitive attributes are omitted, as are attributes left at
+// their default values. If gen is nil, references to other objects are bound to
+// local variables as configured for the client.
+func exportTerraform(ctx context.Context, client *observe.Client, target *oid.OID, gen *binding.Generator) (*gql.TerraformDefinition, *binding.Generator, error) {
+	object, err := readTerraformObject(ctx, client, target)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if gen == nil {
+		bindFor := binding.NewKindSet(binding.KindDataset, binding.KindWorksheet, binding.KindWorkspace, binding.KindUser)
+		g, err := binding.NewGenerator(ctx, true, strings.TrimPrefix(object.exporter.resourceType, "observe_"), object.label, client, bindFor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to initialize binding generator: %w", err)
+		}
+		gen = &g
+	}
+
+	return object.render(gen), gen, nil
+}
+
+// terraformObject holds the state of an object read through its resource, so
+// that it can be rendered more than once without further API calls
+type terraformObject struct {
+	exporter terraformExporter
+	target   *oid.OID
+	label    string
+	schema   map[string]*schema.Schema
+	values   map[string]interface{}
+}
+
+func readTerraformObject(ctx context.Context, client *observe.Client, target *oid.OID) (*terraformObject, error) {
+	exporter, ok := terraformExporters[target.Type]
+	if !ok {
+		return nil, fmt.Errorf("terraform export is not supported for %s", target.Type)
+	}
+
+	resource := exporter.resource()
+	data := resource.Data(nil)
+	data.SetId(target.Id)
+	if diags := resource.ReadContext(ctx, data, client); diags.HasError() {
+		for _, d := range diags {
+			if d.Severity == diag.Error {
+				return nil, fmt.Errorf("failed to read %s: %s", target, d.Summary)
+			}
+		}
+	}
+	if data.Id() == "" {
+		return nil, fmt.Errorf("%s not found", target)
+	}
+
+	label := target.Id
+	if name, ok := data.Get("name").(string); ok && name != "" {
+		label = name
+	}
+
+	values := make(map[string]interface{}, len(resource.Schema))
+	for k := range resource.Schema {
+		values[k] = data.Get(k)
+	}
+
+	return &terraformObject{
+		exporter: exporter,
+		target:   target,
+		label:    label,
+		schema:   resource.Schema,
+		values:   values,
+	}, nil
+}
+
+func (o *terraformObject) render(gen *binding.Generator) *gql.TerraformDefinition {
+	resourceName := binding.SanitizeIdentifier(o.label)
+
+	f := hclwrite.NewEmptyFile()
+	block := f.Body().AppendNewBlock("resource", []string{o.exporter.resourceType, resourceName})
+	writeTerraformBody(block.Body(), o.schema, o.values, gen)
+
+	definition := &gql.TerraformDefinition{
+		Resource:   stringPtr(string(hclwrite.Format(f.Bytes()))),
+		ImportId:   stringPtr(o.target.Id),
+		ImportName: stringPtr(o.exporter.resourceType + "." + resourceName),
+	}
+
+	if o.exporter.dataSource != nil {
+		f := hclwrite.NewEmptyFile()
+		block := f.Body().AppendNewBlock("data", []string{o.exporter.resourceType, resourceName})
+		for _, k := range sortedSchemaKeys(o.exporter.dataSource().Schema) {
+			s := o.exporter.dataSource().Schema[k]
+			switch {
+			case k == "id" && (s.Optional || s.Required):
+				block.Body().SetAttributeValue("id", cty.StringVal(o.target.Id))
+			case s.Required && o.values[k] != nil:
+				block.Body().SetAttributeRaw(k, terraformTokensForString(bindTerraformString(gen, o.values[k].(string))))
+			}
+		}
+		definition.DataSource = stringPtr(string(hclwrite.Format(f.Bytes())))
+	}
+
+	return definition
+}
+
+func sortedSchemaKeys(m map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func writeTerraformBody(body *hclwrite.Body, schemaMap map[string]*schema.Schema, values map[string]interface{}, gen *binding.Generator) {
+	var blocks []string
+	for _, k := range sortedSchemaKeys(schemaMap) {
+		s := schemaMap[k]
+		if (s.Computed && !s.Optional) || s.Sensitive || s.Deprecated != "" {
+			continue
+		}
+		v := values[k]
+		if set, ok := v.(*schema.Set); ok {
+			v = set.List()
+		}
+		if isDefaultTerraformValue(s, v) {
+			continue
+		}
+		if _, ok := s.Elem.(*schema.Resource); ok {
+			// nested blocks are written after attributes
+			blocks = append(blocks, k)
+			continue
+		}
+		body.SetAttributeRaw(k, terraformTokensForValue(s, v, gen))
+	}
+
+	for _, k := range blocks {
+		elem := schemaMap[k].Elem.(*schema.Resource)
+		v := values[k]
+		if set, ok := v.(*schema.Set); ok {
+			v = set.List()
+		}
+		for _, item := range v.([]interface{}) {
+			nested, _ := item.(map[string]interface{})
+			body.AppendNewline()
+			writeTerraformBody(body.AppendNewBlock(k, nil).Body(), elem.Schema, nested, gen)
+		}
+	}
+}
+
+func isDefaultTerraformValue(s *schema.Schema, v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	if s.Default != nil {
+		return reflect.DeepEqual(s.Default, v)
+	}
+	switch v := v.(type) {
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return reflect.ValueOf(v).IsZero()
+}
+
+func terraformTokensForValue(s *schema.Schema, v interface{}, gen *binding.Generator) hclwrite.Tokens {
+	switch v := v.(type) {
+	case string:
+		return terraformTokensForString(bindTerraformString(gen, v))
+	case bool:
+		return hclwrite.TokensForValue(cty.BoolVal(v))
+	case int:
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(v)))
+	case float64:
+		return hclwrite.TokensForValue(cty.NumberFloatVal(v))
+	case []interface{}:
+		elemSchema, _ := s.Elem.(*schema.Schema)
+		elems := make([]hclwrite.Tokens, 0, len(v))
+		for _, e := range v {
+			elems = append(elems, terraformTokensForValue(elemSchema, e, gen))
+		}
+		return hclwrite.TokensForTuple(elems)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		attrs := make([]hclwrite.ObjectAttrTokens, 0, len(v))
+		for _, k := range keys {
+			name := hclwrite.TokensForValue(cty.StringVal(k))
+			if hclsyntax.ValidIdentifier(k) {
+				name = hclwrite.TokensForIdentifier(k)
+			}
+			attrs = append(attrs, hclwrite.ObjectAttrTokens{
+				Name:  name,
+				Value: terraformTokensForValue(nil, v[k], gen),
+			})
+		}
+		return hclwrite.TokensForObject(attrs)
+	}
+	return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType))
+}
+
+var (
+	// terraformReferenceRegex matches a string consisting solely of a
+	// reference to another observe resource or data source
+	terraformReferenceRegex = regexp.MustCompile(`^\$\{((?:data\.)?observe_[a-z0-9_]+\.[a-zA-Z0-9_-]+\.(?:id|oid))\}$`)
+
+	terraformReferenceUnescaper = strings.NewReplacer(
+		"$${local.binding__", "${local.binding__",
+		"$${observe_", "${observe_",
+		"$${data.observe_", "${data.observe_",
+	)
+)
+
+// terraformTokensForString quotes a string, preserving any binding references
+// which would otherwise be escaped. Strings which consist of a single resource
+// reference are written as a bare traversal.
+func terraformTokensForString(s string) hclwrite.Tokens {
+	if m := terraformReferenceRegex.FindStringSubmatch(s); m != nil {
+		var traversal hcl.Traversal
+		for i, name := range strings.Split(m[1], ".") {
+			if i == 0 {
+				traversal = append(traversal, hcl.TraverseRoot{Name: name})
+			} else {
+				traversal = append(traversal, hcl.TraverseAttr{Name: name})
+			}
+		}
+		return hclwrite.TokensForTraversal(traversal)
+	}
+
+	tokens := hclwrite.TokensForValue(cty.StringVal(s))
+	for _, t := range tokens {
+		if t.Type == hclsyntax.TokenQuotedLit {
+			t.Bytes = []byte(terraformReferenceUnescaper.Replace(string(t.Bytes)))
+		}
+	}
+	return tokens
+}
+
+// bindTerraformString replaces references to other objects with local variable
+// bindings or resource references, if enabled
+func bindTerraformString(gen *binding.Generator, s string) string {
+	if !gen.Enabled {
+		return s
+	}
+
+	if id, err := oid.NewOID(s); err == nil {
+		var kind binding.Kind
+		var key string
+		switch id.Type {
+		case oid.TypeWorkspace:
+			kind, key = binding.KindWorkspace, oid.WorkspaceOid(id.Id).String()
+		case oid.TypeDataset:
+			kind, key = binding.KindDataset, id.Id
+		case oid.TypeWorksheet:
+			kind, key = binding.KindWorksheet, id.Id
+		case oid.TypeUser:
+			kind, key = binding.KindUser, oid.OidToUserId(*id).String()
+		default:
+			return s
+		}
+		if bound := gen.TryBindOid(kind, key); bound != key {
+			return bound
+		}
+		return s
+	}
+
+	if trimmed := strings.TrimSpace(s); (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) && json.Valid([]byte(s)) {
+		if bound, err := gen.GenerateJson([]byte(s)); err == nil {
+			return string(bound)
+		}
+	}
+	return s
+}
@@ -0,0 +1,86 @@
+package observe
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/observeinc/terraform-provider-observe/client/binding"
+)
+
+func TestWriteTerraformBody(t *testing.T) {
+	schemaMap := map[string]*schema.Schema{
+		"name":     {Type: schema.TypeString, Required: true},
+		"disabled": {Type: schema.TypeBool, Optional: true, Default: false},
+		"interval": {Type: schema.TypeString, Optional: true, Default: "1m"},
+		"retries":  {Type: schema.TypeInt, Optional: true},
+		"oid":      {Type: schema.TypeString, Computed: true},
+		"password": {Type: schema.TypeString, Optional: true, Sensitive: true},
+		"tags":     {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"headers":  {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"rule": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"pipeline": {Type: schema.TypeString, Required: true},
+				},
+			},
+		},
+	}
+
+	values := map[string]interface{}{
+		"name":     "example ${x}",
+		"disabled": false,
+		"interval": "5m",
+		"retries":  3,
+		"oid":      "o:::poller:1",
+		"password": "secret",
+		"tags":     []interface{}{"a", "b"},
+		"headers":  map[string]interface{}{"Content-Type": "application/json", "a": "b"},
+		"rule": []interface{}{
+			map[string]interface{}{"pipeline": "filter true\n"},
+		},
+	}
+
+	f := hclwrite.NewEmptyFile()
+	writeTerraformBody(f.Body().AppendNewBlock("resource", []string{"observe_poller", "example"}).Body(), schemaMap, values, &binding.Generator{})
+
+	expected := `resource "observe_poller" "example" {
+  headers = {
+    Content-Type = "application/json"
+    a            = "b"
+  }
+  interval = "5m"
+  name     = "example $${x}"
+  retries  = 3
+  tags     = ["a", "b"]
+
+  rule {
+    pipeline = "filter true\n"
+  }
+}
+`
+	if s := string(hclwrite.Format(f.Bytes())); s != expected {
+		t.Fatalf("unexpected output: %s", cmp.Diff(expected, s))
+	}
+}
+
+func TestTerraformTokensForString(t *testing.T) {
+	testcases := map[string]string{
+		"plain":                            `"plain"`,
+		"${local.binding__a__b}":           `"${local.binding__a__b}"`,
+		"${var.x}":                         `"$${var.x}"`,
+		"a\"b":                             `"a\"b"`,
+		"{\"id\":\"${local.binding__x}\"}": `"{\"id\":\"${local.binding__x}\"}"`,
+		"${observe_dataset.a.oid}":         `observe_dataset.a.oid`,
+		"${data.observe_workspace.b.oid}":  `data.observe_workspace.b.oid`,
+		"${observe_dataset.a.oid}/x":       `"${observe_dataset.a.oid}/x"`,
+	}
+	for input, expected := range testcases {
+		if s := string(terraformTokensForString(input).Bytes()); s != expected {
+			t.Errorf("unexpected output for %q: got %s, want %s", input, s, expected)
+		}
+	}
+}